@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+const recipeYAML = `name: Dark 70
+description: A dark bar
+instructions: |
+  Roast the beans.
+  Conche for 24 hours.
+ingredients:
+  - name: Cacao nibs
+    iscacao: true
+    quantity: {amount: 700, unit: g}
+  - name: Cane sugar
+    quantity: {amount: 300, unit: g}
+`
+
+// fakeAPI records the requests it receives and answers them like the Recipe API
+type fakeAPI struct {
+	t        *testing.T
+	recipe   *recipe.Recipe
+	requests []string
+	bodies   []string
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI()+" "+r.Header.Get("X-User"))
+	f.bodies = append(f.bodies, string(body))
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/recipe":
+		json.NewEncoder(w).Encode(&recipe.Page{Recipes: []*recipe.Recipe{f.recipe}, NextCursor: "bmV4dA", Total: 3})
+	case r.Method == http.MethodGet && r.URL.Path == "/recipe/missing":
+		w.WriteHeader(404)
+		w.Write([]byte(`{"error": "Recipe not found"}`))
+	case r.Method == http.MethodGet && r.URL.Path == "/recipe/"+f.recipe.ID:
+		json.NewEncoder(w).Encode(f.recipe)
+	case r.Method == http.MethodPost && r.URL.Path == "/recipe":
+		w.WriteHeader(201)
+		json.NewEncoder(w).Encode(f.recipe)
+	case r.Method == http.MethodPut || r.Method == http.MethodDelete:
+		w.WriteHeader(204)
+	case r.Method == http.MethodPost && r.URL.Path == "/recipe/import":
+		json.NewEncoder(w).Encode(&bulk.Report{Total: 2, Succeeded: 1, Failed: 1, Results: []bulk.Result{
+			{Line: 1, Name: "Dark 70", ID: "abc"},
+			{Line: 2, Error: "Name is required"},
+		}})
+	case r.Method == http.MethodGet && r.URL.Path == "/recipe/export":
+		w.Header().Set("Content-Type", bulk.MIMECSV)
+		w.Write([]byte("recipe_id,name\n"))
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		w.WriteHeader(500)
+	}
+}
+
+func testApp(t *testing.T) (*app, *fakeAPI, *bytes.Buffer) {
+	t.Helper()
+	rcp, err := recipe.NewRecipe("Dark 70", "A dark bar", []recipe.Ingredient{
+		{Name: "Cacao nibs", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}},
+		{Name: "Cane sugar", Quantity: recipe.Quantity{Amount: 300, Unit: recipe.Gram}},
+	}, "Roast the beans.\nConche for 24 hours.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rcp.ID = "65f1c0ffee"
+	rcp.UpdatedAt = time.Date(2025, 3, 1, 9, 30, 0, 0, time.Local)
+
+	api := &fakeAPI{t: t, recipe: rcp}
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	t.Setenv("CHOCOLATE_API", srv.URL)
+	t.Setenv("CHOCOLATE_USER", "alice")
+
+	var stdout bytes.Buffer
+	return &app{stdin: strings.NewReader(""), stdout: &stdout, stderr: io.Discard}, api, &stdout
+}
+
+func TestList(t *testing.T) {
+	a, api, stdout := testApp(t)
+	var stderr bytes.Buffer
+	a.stderr = &stderr
+	if err := a.run(context.Background(), []string{"list", "-status", "approved", "-sort", "-name"}); err != nil {
+		t.Fatal(err)
+	}
+	if api.requests[0] != "GET /recipe?limit=20&sort=-name&status=approved alice" {
+		t.Errorf("unexpected request %s", api.requests[0])
+	}
+	want := "ID          NAME     STATUS  REV  CACAO  YIELD       UPDATED\n" +
+		"65f1c0ffee  Dark 70  draft   1    70.0%  1000 grams  2025-03-01 09:30\n"
+	if stdout.String() != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, stdout.String())
+	}
+	if stderr.String() != "\n1 of 3 recipes, list the next page with -cursor bmV4dA\n" {
+		t.Errorf("expected the cursor of the next page, got %q", stderr.String())
+	}
+
+	if err := a.run(context.Background(), []string{"list", "-sort", "price"}); err != recipe.ErrInvalidSort {
+		t.Errorf("expected an invalid sort error, got %v", err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	a, api, stdout := testApp(t)
+	if err := a.run(context.Background(), []string{"get", "65f1c0ffee", "--yield", "500"}); err != nil {
+		t.Fatal(err)
+	}
+	if api.requests[0] != "GET /recipe/65f1c0ffee?yield=500 alice" {
+		t.Errorf("unexpected request %s", api.requests[0])
+	}
+	if !strings.HasPrefix(stdout.String(), "DARK 70\n=======\n") {
+		t.Errorf("expected a recipe card, got\n%s", stdout.String())
+	}
+
+	stdout.Reset()
+	if err := a.run(context.Background(), []string{"-o", "json", "get", "65f1c0ffee"}); err != nil {
+		t.Fatal(err)
+	}
+	var rcp recipe.Recipe
+	if err := json.Unmarshal(stdout.Bytes(), &rcp); err != nil || rcp.ID != "65f1c0ffee" {
+		t.Errorf("expected the recipe as JSON, got %s", stdout.String())
+	}
+
+	err := a.run(context.Background(), []string{"get", "missing"})
+	if err == nil || err.Error() != "Recipe not found (404 Not Found)" {
+		t.Errorf("expected the error of the API, got %v", err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	a, api, stdout := testApp(t)
+	a.stdin = strings.NewReader(recipeYAML)
+	if err := a.run(context.Background(), []string{"create", "-f", "-"}); err != nil {
+		t.Fatal(err)
+	}
+	var req struct {
+		Name        string
+		Ingredients []recipe.Ingredient
+	}
+	if err := json.Unmarshal([]byte(api.bodies[0]), &req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Name != "Dark 70" || len(req.Ingredients) != 2 || !req.Ingredients[0].IsCacao || req.Ingredients[1].Quantity.Amount != 300 {
+		t.Errorf("unexpected request body %s", api.bodies[0])
+	}
+	if stdout.String() != "Created recipe 65f1c0ffee (Dark 70)\n" {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+}
+
+func TestEdit(t *testing.T) {
+	a, api, stdout := testApp(t)
+	a.editor = "sed -i s/Cane/Coconut/"
+	if err := a.run(context.Background(), []string{"edit", "65f1c0ffee"}); err != nil {
+		t.Fatal(err)
+	}
+	if api.requests[1] != "PUT /recipe/65f1c0ffee alice" {
+		t.Fatalf("unexpected request %s", api.requests[1])
+	}
+	if !strings.Contains(api.bodies[1], `"Name":"Coconut sugar"`) {
+		t.Errorf("expected the edited ingredient in %s", api.bodies[1])
+	}
+	if stdout.String() != "Updated recipe 65f1c0ffee\n" {
+		t.Errorf("unexpected output %q", stdout.String())
+	}
+
+	// Quitting the editor without changes does not update the recipe
+	a.editor = "true"
+	if err := a.run(context.Background(), []string{"edit", "65f1c0ffee"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.requests) != 3 {
+		t.Errorf("expected no update, got %v", api.requests[3:])
+	}
+}
+
+func TestDeleteExportImport(t *testing.T) {
+	a, api, stdout := testApp(t)
+	if err := a.run(context.Background(), []string{"delete", "65f1c0ffee"}); err != nil {
+		t.Fatal(err)
+	}
+	if api.requests[0] != "DELETE /recipe/65f1c0ffee alice" {
+		t.Errorf("unexpected request %s", api.requests[0])
+	}
+
+	out := filepath.Join(t.TempDir(), "recipes.csv")
+	if err := a.run(context.Background(), []string{"export", "-format", "csv", "-out", out}); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(out); string(data) != "recipe_id,name\n" {
+		t.Errorf("unexpected export %q", data)
+	}
+
+	stdout.Reset()
+	err := a.run(context.Background(), []string{"import", "-dry-run", out})
+	if err == nil || err.Error() != "1 of 2 recipes failed" {
+		t.Errorf("expected the failed records to be reported, got %v", err)
+	}
+	if api.requests[2] != "POST /recipe/import?dry_run=true&format=csv alice" {
+		t.Errorf("unexpected request %s", api.requests[2])
+	}
+	if !strings.Contains(stdout.String(), "2                   Name is required\n") || !strings.HasSuffix(stdout.String(), "2 recipes, 1 imported, 1 failed\n") {
+		t.Errorf("unexpected report\n%s", stdout.String())
+	}
+}
+
+func TestScale(t *testing.T) {
+	a, api, stdout := testApp(t)
+	path := filepath.Join(t.TempDir(), "dark.yaml")
+	if err := os.WriteFile(path, []byte(recipeYAML), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.run(context.Background(), []string{"-o", "json", "scale", "-f", path, "-yield", "250"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.requests) != 0 {
+		t.Errorf("expected scaling to work offline, got %v", api.requests)
+	}
+	var scaled recipe.Recipe
+	if err := json.Unmarshal(stdout.Bytes(), &scaled); err != nil {
+		t.Fatal(err)
+	}
+	if scaled.Yield.Amount != 250 || scaled.Ingredients[0].Quantity.Amount != 175 {
+		t.Errorf("expected 175 g of nibs for 250 g, got %+v", scaled.Ingredients)
+	}
+
+	if err := a.run(context.Background(), []string{"scale", "-f", path}); err != errUsage {
+		t.Errorf("expected a usage error without a yield, got %v", err)
+	}
+}
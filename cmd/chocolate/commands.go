@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/command"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/client"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/render"
+)
+
+func runList(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("list", "")
+	limit := fs.Int("limit", 20, "maximum number of recipes, at most 100")
+	cursor := fs.String("cursor", "", "cursor of the page to list, printed after the previous page")
+	sortOrder := fs.String("sort", "", "sort by name, created, updated or cacao, prefixed with - for descending order")
+	name := fs.String("name", "", "only list recipes with this in their name")
+	status := fs.String("status", "", "only list recipes with this status: draft, in_review, approved or archived")
+	country := fs.String("origin-country", "", "only list recipes with cacao from this country")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	sort, err := recipe.ParseSort(*sortOrder)
+	if err != nil {
+		return err
+	}
+
+	filter := recipe.Filter{Name: *name, Status: recipe.Status(*status), OriginCountry: *country}
+	page, err := a.api.ListRecipes(ctx, filter, sort, int64(*limit), *cursor)
+	if err != nil {
+		return err
+	}
+
+	if a.output == outputJSON {
+		return a.printJSON(page)
+	}
+	if err := a.printRecipes(page.Recipes); err != nil {
+		return err
+	}
+	if page.NextCursor != "" {
+		fmt.Fprintf(a.stderr, "\n%d of %d recipes, list the next page with -cursor %s\n", len(page.Recipes), page.Total, page.NextCursor)
+	}
+	return nil
+}
+
+func runGet(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("get", "<id>")
+	yield := fs.Float64("yield", 0, "scale the recipe to this yield in grams")
+	format := fs.String("format", string(render.Text), "format of the recipe card in table mode: text, markdown or yaml")
+	positional, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	rcp, err := a.api.ScaleRecipe(ctx, positional[0], client.Scale{Yield: *yield})
+	if err != nil {
+		return err
+	}
+
+	if a.output == outputJSON {
+		return a.printJSON(rcp)
+	}
+	return render.Recipe(a.stdout, render.Format(*format), rcp)
+}
+
+func runCreate(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("create", "")
+	file := fs.String("f", "", "YAML or JSON file with the recipe, - for standard input")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	body, err := a.readFile(*file)
+	if err != nil {
+		return err
+	}
+	rcp, err := fileRecipe(body)
+	if err != nil {
+		return err
+	}
+	created, err := a.api.CreateRecipe(ctx, rcp)
+	if err != nil {
+		return err
+	}
+
+	if a.output == outputJSON {
+		return a.printJSON(created)
+	}
+	fmt.Fprintf(a.stdout, "Created recipe %s (%s)\n", created.ID, created.Name)
+	return nil
+}
+
+// editHeader is written at the top of the file opened in the editor
+const editHeader = "# Editing recipe %s. Save and quit to update it, or quit without saving to cancel.\n"
+
+func runEdit(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("edit", "<id>")
+	positional, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	id := positional[0]
+
+	rcp, err := a.api.GetRecipe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Edit the fields that can be updated, in the YAML form create accepts
+	var original bytes.Buffer
+	fmt.Fprintf(&original, editHeader, id)
+	if err := render.ValueYAML(&original, editableRecipe(rcp)); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp("", "chocolate-"+id+"-*.yaml")
+	if err != nil {
+		return err
+	}
+	path := f.Name()
+	_, err = f.Write(original.Bytes())
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	editor := strings.Fields(a.editor)
+	cmd := exec.CommandContext(ctx, editor[0], append(editor[1:], path)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = a.stdin, a.stdout, a.stderr
+	if err := cmd.Run(); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("running editor %s: %w", a.editor, err)
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(edited, original.Bytes()) {
+		os.Remove(path)
+		fmt.Fprintln(a.stderr, "No changes, recipe not updated")
+		return nil
+	}
+
+	var revision *recipe.Recipe
+	body, err := decodeRecipeFile(edited)
+	if err == nil {
+		rcp, err = fileRecipe(body)
+	}
+	if err == nil {
+		rcp.ID = id
+		revision, err = a.api.UpdateRecipe(ctx, rcp)
+	}
+	if err != nil {
+		// Keep the file so the changes are not lost
+		return fmt.Errorf("%w\nyour changes are saved in %s", err, path)
+	}
+	os.Remove(path)
+
+	if revision != nil {
+		if a.output == outputJSON {
+			return a.printJSON(revision)
+		}
+		fmt.Fprintf(a.stdout, "Recipe %s is approved and locked, saved the changes as draft revision %s\n", id, revision.ID)
+		return nil
+	}
+	if a.output == outputJSON {
+		return a.printJSON(map[string]string{"ID": id})
+	}
+	fmt.Fprintf(a.stdout, "Updated recipe %s\n", id)
+	return nil
+}
+
+// editableRecipe returns the fields of a recipe that can be updated, as they are sent to the API
+func editableRecipe(rcp *recipe.Recipe) *command.RecipeRequest {
+	return &command.RecipeRequest{
+		Name:           rcp.Name,
+		Description:    rcp.Description,
+		Ingredients:    rcp.Ingredients,
+		Instructions:   rcp.Instructions,
+		Losses:         rcp.Losses,
+		Classification: rcp.Classification,
+		Tempering:      rcp.Tempering,
+		Process:        rcp.Process,
+		Nutrition:      rcp.Nutrition,
+	}
+}
+
+func runDelete(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("delete", "<id>")
+	positional, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	if err := a.api.DeleteRecipe(ctx, positional[0]); err != nil {
+		return err
+	}
+	if a.output == outputJSON {
+		return a.printJSON(map[string]string{"ID": positional[0]})
+	}
+	fmt.Fprintf(a.stdout, "Moved recipe %s to the trash\n", positional[0])
+	return nil
+}
+
+func runExport(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("export", "")
+	format := fs.String("format", string(bulk.JSONLines), "format of the export: jsonl or csv")
+	status := fs.String("status", "", "only export recipes with this status")
+	out := fs.String("out", "-", "file to write the export to, - for standard output")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+
+	filter := recipe.Filter{Status: recipe.Status(*status)}
+	if *out == "-" {
+		return a.api.ExportRecipes(ctx, bulk.Format(*format), filter, a.stdout)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := a.api.ExportRecipes(ctx, bulk.Format(*format), filter, f); err != nil {
+		f.Close()
+		os.Remove(*out)
+		return err
+	}
+	return f.Close()
+}
+
+func runImport(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("import", "<file>")
+	format := fs.String("format", "", "format of the file: jsonl or csv, by default taken from the file extension")
+	dryRun := fs.Bool("dry-run", false, "only validate the recipes")
+	positional, err := parse(fs, args, 1)
+	if err != nil {
+		return err
+	}
+
+	path := positional[0]
+	if *format == "" {
+		*format = string(bulk.JSONLines)
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			*format = string(bulk.CSV)
+		}
+	}
+	var in io.Reader = a.stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	report, err := a.api.ImportRecipes(ctx, bulk.Format(*format), in, *dryRun)
+	if err != nil {
+		// A file that cannot be read is rejected with the report of the records read so far
+		var apiErr *client.Error
+		if errors.As(err, &apiErr) && apiErr.Report != nil {
+			a.printImport(apiErr.Report)
+		}
+		return err
+	}
+
+	if err := a.printImport(report); err != nil {
+		return err
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d recipes failed", report.Failed, report.Total)
+	}
+	return nil
+}
+
+func runScale(ctx context.Context, a *app, args []string) error {
+	fs := a.flags("scale", "")
+	file := fs.String("f", "", "YAML or JSON file with the recipe, - for standard input")
+	yield := fs.Float64("yield", 0, "yield in grams to scale the recipe to")
+	format := fs.String("format", string(render.Text), "format of the recipe card in table mode: text, markdown or yaml")
+	if _, err := parse(fs, args, 0); err != nil {
+		return err
+	}
+	if *file == "" || *yield <= 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	body, err := a.readFile(*file)
+	if err != nil {
+		return err
+	}
+	rcp, err := localRecipe(body)
+	if err != nil {
+		return err
+	}
+	scaled := rcp.ToTemplate().ToRecipe(*yield)
+	if scaled == nil {
+		return errors.New("the recipe cannot be scaled to this yield")
+	}
+
+	if a.output == outputJSON {
+		return a.printJSON(scaled)
+	}
+	return render.Recipe(a.stdout, render.Format(*format), scaled)
+}
+
+// fileRecipe reads the fields of a recipe from a file in the format the API accepts
+func fileRecipe(body json.RawMessage) (*recipe.Recipe, error) {
+	var req command.RecipeRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, fmt.Errorf("reading recipe: %w", err)
+	}
+	return &recipe.Recipe{
+		Name:           req.Name,
+		Description:    req.Description,
+		Ingredients:    req.Ingredients,
+		Instructions:   req.Instructions,
+		Losses:         req.Losses,
+		Classification: req.Classification,
+		Tempering:      req.Tempering,
+		Process:        req.Process,
+		Nutrition:      req.Nutrition,
+	}, nil
+}
+
+// localRecipe builds a recipe from a file in the format the API accepts, validating it like the API does
+func localRecipe(body json.RawMessage) (*recipe.Recipe, error) {
+	fields, err := fileRecipe(body)
+	if err != nil {
+		return nil, err
+	}
+	rcp, err := recipe.NewRecipe(fields.Name, fields.Description, fields.Ingredients, fields.Instructions)
+	if err != nil {
+		return nil, err
+	}
+	if err := rcp.SetLosses(fields.Losses); err != nil {
+		return nil, err
+	}
+	rcp.Classification = fields.Classification
+	rcp.Tempering = fields.Tempering
+	if err := rcp.ValidateTempering(); err != nil {
+		return nil, err
+	}
+	if fields.Process != nil {
+		if err := fields.Process.Validate(); err != nil {
+			return nil, err
+		}
+		rcp.Process = fields.Process
+	}
+	if fields.Nutrition != nil {
+		if err := fields.Nutrition.Validate(); err != nil {
+			return nil, err
+		}
+		rcp.Nutrition = fields.Nutrition
+	}
+	return rcp, nil
+}
@@ -0,0 +1,173 @@
+// Command chocolate manages recipes through the Recipe API from the command line.
+//
+// Usage:
+//
+//	chocolate [global flags] <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	list     list recipes
+//	get      show a recipe, optionally scaled to a yield
+//	create   create a recipe from a YAML or JSON file
+//	edit     edit a recipe in $EDITOR
+//	delete   move a recipe to the trash
+//	export   export recipes as JSON lines or CSV
+//	import   import recipes from JSON lines or CSV
+//	scale    scale a recipe from a local file without the API
+//
+// Run "chocolate <command> -h" for the flags of a command.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/client"
+)
+
+// Output modes
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// app holds the global options and the standard streams of the CLI
+type app struct {
+	api    *client.Client
+	output string
+	editor string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// subcommand is a command of the CLI
+type subcommand struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, a *app, args []string) error
+}
+
+var commands = []subcommand{
+	{"list", "list recipes", runList},
+	{"get", "show a recipe, optionally scaled to a yield", runGet},
+	{"create", "create a recipe from a YAML or JSON file", runCreate},
+	{"edit", "edit a recipe in $EDITOR", runEdit},
+	{"delete", "move a recipe to the trash", runDelete},
+	{"export", "export recipes as JSON lines or CSV", runExport},
+	{"import", "import recipes from JSON lines or CSV", runImport},
+	{"scale", "scale a recipe from a local file without the API", runScale},
+}
+
+// errUsage is returned when the command line is invalid, after the usage has been printed
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	a := &app{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	if err := a.run(ctx, os.Args[1:]); err != nil {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, "chocolate:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// run parses the global flags and runs the command
+func (a *app) run(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("chocolate", flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	apiURL := fs.String("api", envOr("CHOCOLATE_API", "http://localhost:8080"), "URL of the Recipe API, or $CHOCOLATE_API")
+	user := fs.String("user", os.Getenv("CHOCOLATE_USER"), "user to act as, or $CHOCOLATE_USER")
+	roles := fs.String("roles", os.Getenv("CHOCOLATE_ROLES"), "comma separated roles of the user, or $CHOCOLATE_ROLES")
+	fs.StringVar(&a.output, "o", outputTable, "output mode: table or json")
+	fs.Usage = func() { a.usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if a.output != outputTable && a.output != outputJSON {
+		fmt.Fprintf(a.stderr, "invalid output mode %q, expected table or json\n", a.output)
+		return errUsage
+	}
+	var roleList []string
+	if *roles != "" {
+		roleList = strings.Split(*roles, ",")
+	}
+	// Imports and exports of large libraries take a while
+	a.api = client.New(*apiURL, client.WithUser(*user, roleList...), client.WithHTTPClient(&http.Client{Timeout: 5 * time.Minute}))
+	if a.editor == "" {
+		a.editor = envOr("VISUAL", envOr("EDITOR", "vi"))
+	}
+
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errUsage
+	}
+	name := fs.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd.run(ctx, a, fs.Args()[1:])
+		}
+	}
+	fmt.Fprintf(a.stderr, "unknown command %q\n", name)
+	fs.Usage()
+	return errUsage
+}
+
+func (a *app) usage(fs *flag.FlagSet) {
+	fmt.Fprint(a.stderr, "Usage: chocolate [global flags] <command> [flags] [arguments]\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(a.stderr, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprint(a.stderr, "\nGlobal flags:\n")
+	fs.PrintDefaults()
+}
+
+// flags returns the flag set of a command, printing errors and usage to stderr
+func (a *app) flags(name, arguments string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(a.stderr, "Usage: chocolate %s [flags] %s\n", name, arguments)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses the flags of a command, which may be mixed with its arguments, and checks the number of arguments
+func parse(fs *flag.FlagSet, args []string, nargs int) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, errUsage
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(positional) != nargs {
+		fs.Usage()
+		return nil, errUsage
+	}
+	return positional, nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
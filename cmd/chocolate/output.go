@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// printJSON writes v as indented JSON
+func (a *app) printJSON(v any) error {
+	enc := json.NewEncoder(a.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// table returns a writer that aligns tab separated columns
+func (a *app) table() *tabwriter.Writer {
+	return tabwriter.NewWriter(a.stdout, 0, 4, 2, ' ', 0)
+}
+
+// printRecipes writes a table with a row per recipe
+func (a *app) printRecipes(recipes []*recipe.Recipe) error {
+	tw := a.table()
+	fmt.Fprintln(tw, "ID\tNAME\tSTATUS\tREV\tCACAO\tYIELD\tUPDATED")
+	for _, r := range recipes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s%%\t%s %s\t%s\n",
+			r.ID, r.Name, r.Status, r.Revision,
+			strconv.FormatFloat(r.CacaoPercentage, 'f', 1, 64),
+			strconv.FormatFloat(r.Yield.Amount, 'f', -1, 64), r.Yield.Unit,
+			r.UpdatedAt.Local().Format("2006-01-02 15:04"))
+	}
+	return tw.Flush()
+}
+
+// printImport writes the report of an import, as a table with a row per recipe followed by a summary
+func (a *app) printImport(report *bulk.Report) error {
+	if a.output == outputJSON {
+		return a.printJSON(report)
+	}
+	tw := a.table()
+	fmt.Fprintln(tw, "LINE\tNAME\tID\tERROR")
+	for _, r := range report.Results {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", r.Line, r.Name, r.ID, r.Error)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	verb := "imported"
+	if report.DryRun {
+		verb = "valid (dry run)"
+	}
+	_, err := fmt.Fprintf(a.stdout, "\n%d recipes, %d %s, %d failed\n", report.Total, report.Succeeded, verb, report.Failed)
+	return err
+}
+
+// readFile reads a YAML or JSON recipe file, - for standard input, and returns it as JSON
+func (a *app) readFile(path string) (json.RawMessage, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(a.stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeRecipeFile(data)
+}
+
+// decodeRecipeFile converts a YAML or JSON document to JSON.
+// JSON is valid YAML, so every file is read as YAML.
+func decodeRecipeFile(data []byte) (json.RawMessage, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("reading recipe: %w", err)
+	}
+	if _, ok := doc.(map[string]any); !ok {
+		return nil, fmt.Errorf("reading recipe: expected a YAML or JSON object")
+	}
+	return json.Marshal(doc)
+}
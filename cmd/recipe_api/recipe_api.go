@@ -2,16 +2,34 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
-	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/config"
+	"github.com/onasunnymorning/go-make-chocolate/internal/interface/graph"
 	"github.com/onasunnymorning/go-make-chocolate/internal/interface/rest"
+	"github.com/onasunnymorning/go-make-chocolate/internal/interface/rpc"
 	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipepb"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/reflection"
 
 	_ "github.com/onasunnymorning/go-make-chocolate/cmd/recipe_api/docs"
 	swaggerFiles "github.com/swaggo/files"     // swagger embed files
@@ -31,18 +49,31 @@ import (
 // @license.url   http://www.apache.org/licenses/LICENSE-2.0.html
 
 // @host      localhost:8080
-// @BasePath  /recipe
+// @BasePath  /
 
 // @securityDefinitions.basic  BasicAuth
 
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 func main() {
+	cfg, err := config.Load(os.Args[0], os.Args[1:], os.Getenv, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
 	// create a new logger
-	logger, err := zap.NewProduction()
+	logConfig := zap.NewProductionConfig()
+	level, _ := zapcore.ParseLevel(cfg.Log.Level)
+	logConfig.Level = zap.NewAtomicLevelAt(level)
+	logger, err := logConfig.Build()
 	if err != nil {
 		log.Fatalf("Failed to create logger: %s", err)
 	}
+	logger.Info("Loaded configuration", zap.Any("config", cfg.Redacted().Map()))
+
 	// Create a new Gin router
 	r := gin.New()
 	// Use ginzap middleware to log requests with Zap
@@ -51,17 +82,76 @@ func main() {
 	// Use ginzap recovery middleware to catch panics and log with Zap
 	r.Use(ginzap.RecoveryWithZap(logger, true))
 
-	mongoClient, err := mongo.NewClient(os.Getenv("MONGODB_URI"))
+	// Let browsers on the allowed origins call the API, before requests without credentials are rejected
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		r.Use(rest.CORS(rest.CORSConfig{
+			AllowedOrigins: cfg.CORS.AllowedOrigins,
+			AllowedMethods: cfg.CORS.AllowedMethods,
+			AllowedHeaders: cfg.CORS.AllowedHeaders,
+			MaxAge:         cfg.CORS.MaxAge,
+		}))
+	}
+
+	// Identify the user making the request, and let services read it from the gin.Context
+	r.ContextWithFallback = true
+	policy := actor.Policy{ProxySecret: cfg.Auth.ProxySecret, RequireUser: cfg.Auth.RequireUser, TrustIdentity: cfg.Auth.TrustIdentity}
+	r.Use(rest.RequestID(), rest.Identity(policy), rest.Authenticate(policy, "/health", "/ready", "/swagger/*any"))
+
+	stores, err := openStores(cfg.Database, logger)
 	if err != nil {
-		log.Fatalf("Failed to connect to MongoDB: %v", err)
+		log.Fatalf("Failed to open the %s database: %v", cfg.Database.Backend, err)
 	}
-	defer mongoClient.Disconnect(context.Background())
 
-	// Initialize recipe store and service
-	db := mongoClient.Database("recipe_db")
-	recipeStore := mongo.NewMongoDBRecipeStore(db)
-	recipeService := service.NewRecipeService(recipeStore)
-	recipeController := rest.NewRecipeController(recipeService)
+	// Initialize the services and controllers
+	webhookService := service.NewWebhookService(stores.webhook, stores.delivery)
+	webhookController := rest.NewWebhookController(webhookService)
+	auditService := service.NewAuditService(stores.audit)
+	auditController := rest.NewAuditController(auditService)
+	recipeService := service.NewRecipeService(stores.recipe, stores.cacao, stores.audit, stores.outbox, stores.tx)
+	cacaoService := service.NewCacaoService(stores.cacao)
+	moldService := service.NewMoldService(stores.mold)
+	recipeController := rest.NewRecipeController(recipeService, moldService)
+	moldController := rest.NewMoldController(moldService)
+	cacaoController := rest.NewCacaoController(cacaoService)
+	qualityService, err := service.NewQualityService(stores.beanLot, cfg.Quality.Thresholds())
+	if err != nil {
+		log.Fatalf("Failed to create quality service: %v", err)
+	}
+	beanLotController := rest.NewBeanLotController(qualityService)
+	batchService := service.NewBatchService(stores.batch, stores.recipe, stores.beanLot)
+	batchController := rest.NewBatchController(batchService)
+	tastingService := service.NewTastingService(stores.tasting, stores.batch)
+	tastingController := rest.NewTastingController(tastingService)
+	experimentService := service.NewExperimentService(stores.experiment, stores.recipe, stores.tasting)
+	experimentController := rest.NewExperimentController(experimentService)
+	graphController, err := graph.NewController(recipeService)
+	if err != nil {
+		log.Fatalf("Failed to create GraphQL schema: %v", err)
+	}
+
+	// Run the background workers until shutdown, which waits for them before closing the stores
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	runWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	// Purge recipes that have been in the trash for longer than the retention period
+	runWorker(service.NewTrashPurger(recipeService, cfg.Trash.Retention, time.Hour, logger).Run)
+
+	// Deliver recipe events to the registered webhooks
+	webhookClient := &http.Client{Timeout: cfg.Webhook.Timeout}
+	runWorker(service.NewWebhookDispatcher(stores.outbox, stores.webhook, stores.delivery, webhookClient, event.DefaultRetryPolicy(), time.Second, logger).Run)
+
+	// Stream recipe events to Server-Sent Events clients, stopping the hub ends the streams
+	eventHub := service.NewEventHub(stores.outbox, 500*time.Millisecond, logger)
+	runWorker(eventHub.Run)
+	recipeEventController := rest.NewRecipeEventController(eventHub)
 
 	// Add a health check endpoint
 	r.GET("/health", func(c *gin.Context) {
@@ -71,6 +161,22 @@ func main() {
 		})
 	})
 
+	// Add a readiness endpoint, failing once the API is shutting down so load balancers stop sending it requests
+	var ready atomic.Bool
+	r.GET("/ready", func(c *gin.Context) {
+		if !ready.Load() {
+			c.JSON(503, gin.H{
+				"status":  "unavailable",
+				"message": "Recipe API is shutting down",
+			})
+			return
+		}
+		c.JSON(200, gin.H{
+			"status":  "ready",
+			"message": "Recipe API is accepting requests",
+		})
+	})
+
 	// Serve the swagger documentation
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(
 		swaggerFiles.Handler,
@@ -85,18 +191,273 @@ func main() {
 		recipeGroup.GET(":id", recipeController.GetRecipeByID)
 		// Get recipe template by ID
 		recipeGroup.GET(":id/template", recipeController.GetRecipeTemplate)
+		// Get the origin of the cacao in a recipe
+		recipeGroup.GET(":id/origin", recipeController.GetRecipeOrigin)
+		// Get the tempering plan for a recipe
+		recipeGroup.GET(":id/tempering", recipeController.GetRecipeTempering)
+		// Get a printable batch sheet for a recipe
+		recipeGroup.GET(":id/batch-sheet.pdf", recipeController.GetRecipeBatchSheet)
 		// Update recipe
 		recipeGroup.PUT(":id", recipeController.UpdateRecipe)
+		// Move recipe through its lifecycle
+		recipeGroup.POST(":id/status", recipeController.TransitionRecipe)
 		// Delete recipe
 		recipeGroup.DELETE(":id", recipeController.DeleteRecipe)
+		// Restore a deleted recipe
+		recipeGroup.POST(":id/restore", recipeController.RestoreRecipe)
+		// Stream recipe changes with Server-Sent Events
+		recipeGroup.GET("/events", recipeEventController.StreamRecipeEvents)
+		// Import recipes from JSON lines or CSV
+		recipeGroup.POST("/import", recipeController.ImportRecipes)
+		// Export recipes as JSON lines or CSV
+		recipeGroup.GET("/export", recipeController.ExportRecipes)
+		// List deleted recipes
+		recipeGroup.GET("/trash", recipeController.ListTrash)
 		// List recipes
 		recipeGroup.GET("", recipeController.ListRecipes)
 		// Get recipe count
 		recipeGroup.GET("/count", recipeController.CountRecipes)
+		// Create a consolidated purchase list for a production plan
+		recipeGroup.POST("/purchase-list", recipeController.CreatePurchaseList)
+	}
+
+	// Mold catalog endpoints
+	moldGroup := r.Group("/mold")
+	{
+		// Add a mold to the catalog
+		moldGroup.POST("", moldController.CreateMold)
+		// Get mold by ID
+		moldGroup.GET(":id", moldController.GetMoldByID)
+		// Update mold
+		moldGroup.PUT(":id", moldController.UpdateMold)
+		// Delete mold
+		moldGroup.DELETE(":id", moldController.DeleteMold)
+		// List molds
+		moldGroup.GET("", moldController.ListMolds)
+	}
+
+	// Cacao catalog endpoints
+	cacaoGroup := r.Group("/cacao")
+	{
+		// Add cacao to the catalog
+		cacaoGroup.POST("", cacaoController.CreateCacao)
+		// Get cacao by ID
+		cacaoGroup.GET(":id", cacaoController.GetCacaoByID)
+		// Update cacao
+		cacaoGroup.PUT(":id", cacaoController.UpdateCacao)
+		// Delete cacao
+		cacaoGroup.DELETE(":id", cacaoController.DeleteCacao)
+		// List cacao
+		cacaoGroup.GET("", cacaoController.ListCacao)
+	}
+
+	// Bean lot and quality inspection endpoints
+	lotGroup := r.Group("/lot")
+	{
+		// Register a bean lot
+		lotGroup.POST("", beanLotController.CreateBeanLot)
+		// Get bean lot by ID
+		lotGroup.GET(":id", beanLotController.GetBeanLotByID)
+		// Record a quality inspection of a bean lot
+		lotGroup.POST(":id/inspection", beanLotController.InspectBeanLot)
+		// List bean lots
+		lotGroup.GET("", beanLotController.ListBeanLots)
+	}
+
+	// Production batch endpoints
+	batchGroup := r.Group("/batch")
+	{
+		// Start a production batch
+		batchGroup.POST("", batchController.CreateBatch)
+		// Get batch by ID
+		batchGroup.GET(":id", batchController.GetBatchByID)
+		// List batches
+		batchGroup.GET("", batchController.ListBatches)
+	}
+
+	// Tasting panel endpoints
+	tastingGroup := r.Group("/tasting")
+	{
+		// Create a tasting session
+		tastingGroup.POST("", tastingController.CreateTastingSession)
+		// Get the aggregated scores of a batch or recipe
+		tastingGroup.GET("/summary", tastingController.GetTastingSummary)
+		// Get tasting session by ID
+		tastingGroup.GET(":id", tastingController.GetTastingSessionByID)
+		// Record the score of a panelist
+		tastingGroup.POST(":id/score", tastingController.AddTastingScore)
+		// List tasting sessions
+		tastingGroup.GET("", tastingController.ListTastingSessions)
+	}
+
+	// Recipe experiment endpoints
+	experimentGroup := r.Group("/experiment")
+	{
+		// Create an experiment
+		experimentGroup.POST("", experimentController.CreateExperiment)
+		// Get experiment by ID
+		experimentGroup.GET(":id", experimentController.GetExperimentByID)
+		// Update experiment
+		experimentGroup.PUT(":id", experimentController.UpdateExperiment)
+		// Add a variant to an experiment
+		experimentGroup.POST(":id/variant", experimentController.AddExperimentVariant)
+		// Compare the variants of an experiment
+		experimentGroup.GET(":id/comparison", experimentController.CompareExperiment)
+		// List experiments
+		experimentGroup.GET("", experimentController.ListExperiments)
+	}
+
+	// Webhook endpoints
+	webhookGroup := r.Group("/webhook")
+	{
+		// Register a webhook
+		webhookGroup.POST("", webhookController.CreateWebhook)
+		// Get webhook by ID
+		webhookGroup.GET(":id", webhookController.GetWebhookByID)
+		// Update webhook
+		webhookGroup.PUT(":id", webhookController.UpdateWebhook)
+		// Delete webhook
+		webhookGroup.DELETE(":id", webhookController.DeleteWebhook)
+		// List webhooks
+		webhookGroup.GET("", webhookController.ListWebhooks)
+		// List the deliveries to a webhook
+		webhookGroup.GET(":id/delivery", webhookController.ListWebhookDeliveries)
+	}
+
+	// Audit log endpoints
+	auditGroup := r.Group("/audit")
+	{
+		// List or export audit events
+		auditGroup.GET("", auditController.ListAuditEvents)
+	}
+
+	// GraphQL endpoint, with a playground and its assets when opened in a browser
+	r.POST("/graphql", graphController.Query)
+	r.GET("/graphql", graphController.Query)
+	r.GET("/graphql/assets/*file", graphController.Asset)
+
+	// Serve the recipe operations over gRPC for internal services, with reflection for tools like grpcurl
+	grpcOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(rpc.AuthUnaryInterceptor(policy)),
+		grpc.ChainStreamInterceptor(rpc.AuthStreamInterceptor(policy)),
+	}
+	if cfg.TLS.Enabled() {
+		creds, err := credentials.NewServerTLSFromFile(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		if err != nil {
+			log.Fatalf("Failed to load the TLS certificate: %v", err)
+		}
+		grpcOpts = append(grpcOpts, grpc.Creds(creds))
+	}
+	grpcServer := rpc.NewServer(logger, policy, grpcOpts...)
+	recipepb.RegisterRecipeServiceServer(grpcServer, rpc.NewRecipeServer(recipeService))
+	reflection.Register(grpcServer)
+	grpcListener, err := net.Listen("tcp", cfg.GRPC.Addr)
+	if err != nil {
+		log.Fatalf("Failed to listen for gRPC: %v", err)
+	}
+
+	srv := &http.Server{
+		Addr:              cfg.HTTP.Addr,
+		Handler:           r,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
+		ReadTimeout:       cfg.HTTP.ReadTimeout,
+		WriteTimeout:      cfg.HTTP.WriteTimeout,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
+	}
+	httpListener, err := net.Listen("tcp", cfg.HTTP.Addr)
+	if err != nil {
+		log.Fatalf("Failed to listen for HTTP: %v", err)
+	}
+
+	// Start the servers, until SIGTERM or SIGINT or until one of them fails
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stopSignals()
+	serveErr := make(chan error, 2)
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			serveErr <- fmt.Errorf("gRPC server: %w", err)
+		}
+	}()
+	go func() {
+		var err error
+		if cfg.TLS.Enabled() {
+			err = srv.ServeTLS(httpListener, cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		} else {
+			err = srv.Serve(httpListener)
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- fmt.Errorf("HTTP server: %w", err)
+		}
+	}()
+	ready.Store(true)
+	logger.Info("Recipe API started", zap.String("http_addr", httpListener.Addr().String()), zap.String("grpc_addr", grpcListener.Addr().String()))
+
+	var failure error
+	select {
+	case <-signalCtx.Done():
+		logger.Info("Shutting down", zap.Duration("delay", cfg.Shutdown.Delay), zap.Duration("timeout", cfg.Shutdown.Timeout))
+	case failure = <-serveErr:
+		logger.Error("Shutting down after a server failed", zap.Error(failure))
+	}
+	// A second signal stops the API without waiting
+	stopSignals()
+
+	// Fail readiness and keep serving for a while, so load balancers send new requests elsewhere
+	ready.Store(false)
+	if failure == nil {
+		time.Sleep(cfg.Shutdown.Delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Shutdown.Timeout)
+	defer cancel()
+
+	// Stop the workers first, which ends the event streams that would otherwise keep the HTTP server from draining
+	stopWorkers()
+
+	// Stop accepting requests and wait for those in flight, on both servers at once
+	var servers sync.WaitGroup
+	servers.Add(2)
+	go func() {
+		defer servers.Done()
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Warn("HTTP requests did not finish before the shutdown deadline", zap.Error(err))
+			srv.Close()
+		}
+	}()
+	go func() {
+		defer servers.Done()
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			logger.Warn("gRPC calls did not finish before the shutdown deadline")
+			grpcServer.Stop()
+		}
+	}()
+	servers.Wait()
+
+	// Wait for the workers to finish what they were writing before closing the stores
+	workersStopped := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersStopped)
+	}()
+	select {
+	case <-workersStopped:
+	case <-ctx.Done():
+		logger.Warn("Background workers did not stop before the shutdown deadline")
+	}
+	if err := stores.close(ctx); err != nil {
+		logger.Warn("Failed to close the database", zap.Error(err))
 	}
 
-	// Start the server
-	if err := r.Run(":8080"); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	logger.Info("Recipe API stopped")
+	logger.Sync()
+	if failure != nil {
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/config"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/memory"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"go.uber.org/zap"
+)
+
+// stores are the stores of the API in the configured database backend
+type stores struct {
+	recipe     mongo.RecipeStore
+	cacao      mongo.CacaoStore
+	mold       mongo.MoldStore
+	audit      mongo.AuditStore
+	outbox     mongo.OutboxStore
+	webhook    mongo.WebhookStore
+	delivery   mongo.DeliveryStore
+	beanLot    mongo.BeanLotStore
+	batch      mongo.BatchStore
+	tasting    mongo.TastingStore
+	experiment mongo.ExperimentStore
+	tx         mongo.Transactor
+
+	// close releases the connection to the database
+	close func(ctx context.Context) error
+}
+
+// openStores connects to the database of the backend and creates its stores
+func openStores(cfg config.DatabaseConfig, logger *zap.Logger) (*stores, error) {
+	if cfg.Backend == config.Memory {
+		logger.Warn("Keeping data in memory, it is lost when the API stops")
+		return &stores{
+			recipe:     memory.NewRecipeStore(),
+			cacao:      memory.NewCacaoStore(),
+			mold:       memory.NewMoldStore(),
+			audit:      memory.NewAuditStore(),
+			outbox:     memory.NewOutboxStore(),
+			webhook:    memory.NewWebhookStore(),
+			delivery:   memory.NewDeliveryStore(),
+			beanLot:    memory.NewBeanLotStore(),
+			batch:      memory.NewBatchStore(),
+			tasting:    memory.NewTastingStore(),
+			experiment: memory.NewExperimentStore(),
+			tx:         memory.NewTransactor(),
+			close:      func(ctx context.Context) error { return nil },
+		}, nil
+	}
+
+	mongoClient, err := mongo.NewClient(cfg.URI, cfg.ConnectTimeout, cfg.OperationTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to MongoDB: %w", err)
+	}
+	tx, err := mongo.NewMongoDBTransactor(context.Background(), mongoClient)
+	if err != nil {
+		mongoClient.Disconnect(context.Background())
+		return nil, fmt.Errorf("checking MongoDB transaction support: %w", err)
+	}
+	if !tx.Supported() {
+		logger.Warn("MongoDB does not support transactions, recipe changes and their events are not written atomically " +
+			"and event streams may miss events appended concurrently")
+	}
+
+	db := mongoClient.Database(cfg.Name)
+	recipeStore := mongo.NewMongoDBRecipeStore(db)
+	if err := recipeStore.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Failed to create the recipe indexes, listing recipes may be slow", zap.Error(err))
+	}
+	outboxStore := mongo.NewMongoDBOutboxStore(db)
+	if err := outboxStore.EnsureIndexes(context.Background()); err != nil {
+		logger.Warn("Failed to create the outbox indexes, streaming recipe events may be slow", zap.Error(err))
+	}
+	return &stores{
+		recipe:     recipeStore,
+		cacao:      mongo.NewMongoDBCacaoStore(db),
+		mold:       mongo.NewMongoDBMoldStore(db),
+		audit:      mongo.NewMongoDBAuditStore(db),
+		outbox:     outboxStore,
+		webhook:    mongo.NewMongoDBWebhookStore(db),
+		delivery:   mongo.NewMongoDBDeliveryStore(db),
+		beanLot:    mongo.NewMongoDBBeanLotStore(db),
+		batch:      mongo.NewMongoDBBatchStore(db),
+		tasting:    mongo.NewMongoDBTastingStore(db),
+		experiment: mongo.NewMongoDBExperimentStore(db),
+		tx:         tx,
+		close:      mongoClient.Disconnect,
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Package actor carries the identity of the user performing a request through a context.Context.
+package actor
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+)
+
+// Role is a role a user can have.
+type Role string
+
+const (
+	// Reviewer users can approve recipes for production.
+	Reviewer Role = "reviewer"
+)
+
+// Anonymous is the ID of the actor used when a request carries no identity.
+const Anonymous = "anonymous"
+
+// Actor is the user performing an operation.
+type Actor struct {
+	ID    string
+	Roles []Role
+}
+
+// HasRole reports whether the actor has the role.
+func (a Actor) HasRole(role Role) bool {
+	for _, r := range a.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseRoles parses a comma separated list of roles.
+func ParseRoles(s string) []Role {
+	var roles []Role
+	for _, r := range strings.Split(s, ",") {
+		r = strings.ToLower(strings.TrimSpace(r))
+		if r != "" {
+			roles = append(roles, Role(r))
+		}
+	}
+	return roles
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the actor.
+func NewContext(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, a)
+}
+
+// FromContext returns the actor carried by ctx, or an anonymous actor without roles.
+func FromContext(ctx context.Context) Actor {
+	if a, ok := ctx.Value(contextKey{}).(Actor); ok {
+		return a
+	}
+	return Actor{ID: Anonymous}
+}
+
+// Errors returned by Policy.Check
+var (
+	ErrUntrustedRequest = errors.New("request did not come through the authenticating proxy")
+	ErrUnauthenticated  = errors.New("a user is required")
+)
+
+// Policy decides which requests are accepted, trusting the identity set by the authenticating proxy in front of the API.
+// The zero Policy accepts every request, but as the anonymous actor since it has no way to tell the proxy set their identity.
+type Policy struct {
+	ProxySecret   string // When set, requests must carry it to prove their identity was set by the proxy
+	RequireUser   bool   // Reject requests of the anonymous actor
+	TrustIdentity bool   // Trust the identity of requests without a proxy secret being set, for development without a proxy
+}
+
+// Identify returns the actor identified by the user and the comma separated roles of a request carrying the proxy secret.
+// Requests without a user, or whose identity the policy does not trust, are identified as the anonymous actor without roles.
+func (p Policy) Identify(secret, user, roles string) Actor {
+	if user == "" || !p.trusts(secret) {
+		return Actor{ID: Anonymous}
+	}
+	return Actor{ID: user, Roles: ParseRoles(roles)}
+}
+
+// trusts reports whether the identity of a request carrying the proxy secret was set by the proxy
+func (p Policy) trusts(secret string) bool {
+	if p.ProxySecret == "" {
+		return p.TrustIdentity
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(p.ProxySecret)) == 1
+}
+
+// Check reports whether a request carrying the proxy secret and identified as the actor is accepted.
+func (p Policy) Check(secret string, a Actor) error {
+	if p.ProxySecret != "" && !p.trusts(secret) {
+		return ErrUntrustedRequest
+	}
+	if p.RequireUser && a.ID == Anonymous {
+		return ErrUnauthenticated
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package actor
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	if a := FromContext(context.Background()); a.ID != Anonymous || len(a.Roles) != 0 {
+		t.Errorf("Expected anonymous actor without roles, got %+v", a)
+	}
+
+	ctx := NewContext(context.Background(), Actor{ID: "alice", Roles: ParseRoles(" Reviewer, ,editor")})
+	a := FromContext(ctx)
+	if a.ID != "alice" {
+		t.Errorf("Expected alice, got %s", a.ID)
+	}
+	if !a.HasRole(Reviewer) || !a.HasRole("editor") || len(a.Roles) != 2 {
+		t.Errorf("Expected reviewer and editor roles, got %v", a.Roles)
+	}
+}
+
+func TestPolicy(t *testing.T) {
+	alice := Actor{ID: "alice"}
+	anonymous := Actor{ID: Anonymous}
+	if err := (Policy{}).Check("", anonymous); err != nil {
+		t.Errorf("Expected the zero policy to accept anonymous requests, got %v", err)
+	}
+
+	p := Policy{ProxySecret: "proxy-secret-value", RequireUser: true}
+	if err := p.Check("proxy-secret-value", alice); err != nil {
+		t.Errorf("Expected a user with the secret to be accepted, got %v", err)
+	}
+	if err := p.Check("guess", alice); err != ErrUntrustedRequest {
+		t.Errorf("Expected a wrong secret to be rejected, got %v", err)
+	}
+	if err := p.Check("proxy-secret-value", anonymous); err != ErrUnauthenticated {
+		t.Errorf("Expected anonymous requests to be rejected, got %v", err)
+	}
+}
+
+func TestIdentify(t *testing.T) {
+	if a := (Policy{}).Identify("", "mallory", "reviewer"); a.ID != Anonymous || len(a.Roles) != 0 {
+		t.Errorf("Expected the zero policy to ignore the claimed identity, got %+v", a)
+	}
+	if a := (Policy{TrustIdentity: true}).Identify("", "alice", "reviewer"); a.ID != "alice" || !a.HasRole(Reviewer) {
+		t.Errorf("Expected a policy trusting identities to identify alice as a reviewer, got %+v", a)
+	}
+
+	p := Policy{ProxySecret: "proxy-secret-value", TrustIdentity: true}
+	if a := p.Identify("proxy-secret-value", "alice", "reviewer"); a.ID != "alice" || !a.HasRole(Reviewer) {
+		t.Errorf("Expected a request with the secret to identify alice as a reviewer, got %+v", a)
+	}
+	if a := p.Identify("guess", "mallory", "reviewer"); a.ID != Anonymous || len(a.Roles) != 0 {
+		t.Errorf("Expected a request with a wrong secret to be anonymous, got %+v", a)
+	}
+	if a := p.Identify("proxy-secret-value", "", "reviewer"); a.ID != Anonymous || len(a.Roles) != 0 {
+		t.Errorf("Expected a request without a user to be anonymous, got %+v", a)
+	}
+}
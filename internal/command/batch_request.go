@@ -0,0 +1,9 @@
+package command
+
+// BatchRequest represents the request body for starting a production batch
+type BatchRequest struct {
+	RecipeID   string   `json:"recipe_id" binding:"required"`
+	Yield      float64  `json:"yield" binding:"required,gt=0"`
+	BeanLotIDs []string `json:"bean_lot_ids"`
+	Notes      string   `json:"notes"`
+}
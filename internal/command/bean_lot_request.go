@@ -0,0 +1,39 @@
+package command
+
+import (
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+)
+
+// BeanLotRequest represents the request body for registering a bean lot
+type BeanLotRequest struct {
+	LotNumber  string    `json:"lot_number" binding:"required"`
+	CacaoID    string    `json:"cacao_id"`
+	Weight     float64   `json:"weight" binding:"gte=0"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// InspectionRequest represents the request body for recording a quality inspection of a bean lot
+type InspectionRequest struct {
+	SampleSize    int     `json:"sample_size" binding:"gte=0"`
+	FullyBrown    int     `json:"fully_brown" binding:"gte=0"`
+	Purple        int     `json:"purple" binding:"gte=0"`
+	Slaty         int     `json:"slaty" binding:"gte=0"`
+	Moldy         int     `json:"moldy" binding:"gte=0"`
+	InsectDamaged int     `json:"insect_damaged" binding:"gte=0"`
+	Moisture      float64 `json:"moisture" binding:"gte=0,lte=100"`
+	Notes         string  `json:"notes"`
+}
+
+// CutTest returns the cut test results of the request
+func (r *InspectionRequest) CutTest() quality.CutTest {
+	return quality.CutTest{
+		SampleSize:    r.SampleSize,
+		FullyBrown:    r.FullyBrown,
+		Purple:        r.Purple,
+		Slaty:         r.Slaty,
+		Moldy:         r.Moldy,
+		InsectDamaged: r.InsectDamaged,
+	}
+}
@@ -0,0 +1,38 @@
+package command
+
+import "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+
+// CacaoRequest represents the request body for creating/updating a cacao catalog entry
+type CacaoRequest struct {
+	Name   string        `json:"name" binding:"required"`
+	Origin OriginRequest `json:"origin" binding:"required"`
+}
+
+// OriginRequest represents the origin of cacao in a request body
+type OriginRequest struct {
+	Country        string                 `json:"country" binding:"required"`
+	Region         string                 `json:"region"`
+	Producer       string                 `json:"producer"`
+	Variety        string                 `json:"variety"`
+	HarvestYear    int                    `json:"harvest_year"`
+	Fermentation   string                 `json:"fermentation"`
+	Drying         string                 `json:"drying"`
+	Certifications []recipe.Certification `json:"certifications" binding:"dive,oneof=organic fair_trade rainforest_alliance direct_trade"`
+}
+
+// ToDomain converts the request to a recipe.Cacao
+func (r *CacaoRequest) ToDomain() *recipe.Cacao {
+	return &recipe.Cacao{
+		Name: r.Name,
+		Origin: recipe.Origin{
+			Country:        r.Origin.Country,
+			Region:         r.Origin.Region,
+			Producer:       r.Origin.Producer,
+			Variety:        r.Origin.Variety,
+			HarvestYear:    r.Origin.HarvestYear,
+			Fermentation:   r.Origin.Fermentation,
+			Drying:         r.Origin.Drying,
+			Certifications: r.Origin.Certifications,
+		},
+	}
+}
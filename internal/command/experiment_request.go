@@ -0,0 +1,50 @@
+package command
+
+import "github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+
+// ExperimentRequest represents the request body for creating an experiment
+type ExperimentRequest struct {
+	Name         string           `json:"name" binding:"required"`
+	BaseRecipeID string           `json:"base_recipe_id" binding:"required"`
+	Hypothesis   string           `json:"hypothesis"`
+	Variants     []VariantRequest `json:"variants" binding:"dive"`
+}
+
+// UpdateExperimentRequest represents the request body for updating an experiment
+type UpdateExperimentRequest struct {
+	Name       string `json:"name" binding:"required"`
+	Hypothesis string `json:"hypothesis"`
+	Conclusion string `json:"conclusion"`
+}
+
+// VariantRequest represents the request body for adding a variant recipe to an experiment
+type VariantRequest struct {
+	RecipeID          string            `json:"recipe_id" binding:"required"`
+	Label             string            `json:"label"`
+	ChangedParameters map[string]string `json:"changed_parameters"`
+	Notes             string            `json:"notes"`
+}
+
+// ToDomain converts the request to an experiment.Variant
+func (r *VariantRequest) ToDomain() experiment.Variant {
+	return experiment.Variant{
+		RecipeID:          r.RecipeID,
+		Label:             r.Label,
+		ChangedParameters: r.ChangedParameters,
+		Notes:             r.Notes,
+	}
+}
+
+// ToDomain converts the request to an experiment.Experiment
+func (r *ExperimentRequest) ToDomain() *experiment.Experiment {
+	variants := make([]experiment.Variant, len(r.Variants))
+	for i, v := range r.Variants {
+		variants[i] = v.ToDomain()
+	}
+	return &experiment.Experiment{
+		Name:         r.Name,
+		BaseRecipeID: r.BaseRecipeID,
+		Hypothesis:   r.Hypothesis,
+		Variants:     variants,
+	}
+}
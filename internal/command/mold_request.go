@@ -0,0 +1,12 @@
+package command
+
+import "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+
+// MoldRequest represents the request body for creating/updating a mold
+type MoldRequest struct {
+	Name         string          `json:"name" binding:"required"`
+	Type         recipe.MoldType `json:"type" binding:"required,oneof=solid shell"`
+	Cavities     int             `json:"cavities" binding:"required,min=1"`
+	CavityWeight float64         `json:"cavity_weight" binding:"required,gt=0"`
+	ShellWeight  float64         `json:"shell_weight"`
+}
@@ -0,0 +1,30 @@
+package command
+
+import "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+
+// PlanItemRequest represents a single recipe and its target yield in a production plan
+type PlanItemRequest struct {
+	RecipeID string  `json:"recipe_id" binding:"required"`
+	Yield    float64 `json:"yield" binding:"required,gt=0"`
+}
+
+// PurchaseListRequest represents the request body for generating a purchase list from a production plan
+type PurchaseListRequest struct {
+	Items     []PlanItemRequest   `json:"items" binding:"required,min=1,dive"`
+	Inventory []recipe.Ingredient `json:"inventory"`
+}
+
+// ToDomain converts the request to a recipe.ProductionPlan
+func (r *PurchaseListRequest) ToDomain() *recipe.ProductionPlan {
+	items := make([]recipe.PlanItem, len(r.Items))
+	for i, item := range r.Items {
+		items[i] = recipe.PlanItem{
+			RecipeID: item.RecipeID,
+			Yield:    item.Yield,
+		}
+	}
+	return &recipe.ProductionPlan{
+		Items:     items,
+		Inventory: r.Inventory,
+	}
+}
@@ -4,8 +4,18 @@ import "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
 
 // RecipeRequest represents the request body for creating/updating a recipe
 type RecipeRequest struct {
-	Name         string              `json:"name" binding:"required"`
-	Description  string              `json:"description"`
-	Ingredients  []recipe.Ingredient `json:"ingredients" binding:"required,dive"`
-	Instructions string              `json:"instructions" binding:"required"`
+	Name           string                 `json:"name" binding:"required"`
+	Description    string                 `json:"description"`
+	Ingredients    []recipe.Ingredient    `json:"ingredients" binding:"required,dive"`
+	Instructions   string                 `json:"instructions" binding:"required"`
+	Losses         []recipe.ProcessLoss   `json:"losses"`
+	Classification recipe.Classification  `json:"classification" binding:"omitempty,oneof=dark milk white"`
+	Tempering      *recipe.TemperingCurve `json:"tempering"`
+	Process        *recipe.ProcessProfile `json:"process"`
+	Nutrition      *recipe.Nutrition      `json:"nutrition"`
+}
+
+// RecipeStatusRequest represents the request body for changing the lifecycle status of a recipe
+type RecipeStatusRequest struct {
+	Status recipe.Status `json:"status" binding:"required,oneof=draft in_review approved archived"`
 }
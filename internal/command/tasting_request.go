@@ -0,0 +1,22 @@
+package command
+
+import (
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// TastingSessionRequest represents the request body for creating a tasting session
+type TastingSessionRequest struct {
+	BatchID   string    `json:"batch_id" binding:"required_without=RecipeID"`
+	RecipeID  string    `json:"recipe_id" binding:"required_without=BatchID"`
+	HeldAt    time.Time `json:"held_at"`
+	Panelists []string  `json:"panelists"`
+}
+
+// ScoreRequest represents the request body for recording the score of a panelist
+type ScoreRequest struct {
+	Panelist string                        `json:"panelist" binding:"required"`
+	Ratings  map[tasting.Attribute]float64 `json:"ratings" binding:"required"`
+	Notes    string                        `json:"notes"`
+}
@@ -0,0 +1,27 @@
+package command
+
+import "github.com/onasunnymorning/go-make-chocolate/pkg/event"
+
+// WebhookRequest represents the request body for registering/updating a webhook
+type WebhookRequest struct {
+	URL         string       `json:"url" binding:"required,url"`
+	Secret      string       `json:"secret"` // Required when registering, kept when omitted on update
+	EventTypes  []event.Type `json:"event_types" binding:"dive,oneof=recipe.created recipe.updated recipe.deleted recipe.restored"`
+	Description string       `json:"description"`
+	Active      *bool        `json:"active"` // Defaults to true
+}
+
+// ToDomain converts the request to an event.Webhook
+func (r *WebhookRequest) ToDomain() *event.Webhook {
+	active := true
+	if r.Active != nil {
+		active = *r.Active
+	}
+	return &event.Webhook{
+		URL:         r.URL,
+		Secret:      r.Secret,
+		EventTypes:  r.EventTypes,
+		Description: r.Description,
+		Active:      active,
+	}
+}
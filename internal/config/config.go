@@ -0,0 +1,469 @@
+// Package config loads the settings of the Recipe API.
+//
+// Every setting has a default, and can be set in a YAML or JSON file, in an environment variable and with a flag,
+// each overriding the one before. The file is given with the -config flag or the RECIPE_API_CONFIG variable.
+// The variable of a setting is its flag in upper case, with dashes replaced by underscores and prefixed with RECIPE_API_,
+// so -db-name can also be set with RECIPE_API_DB_NAME.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+)
+
+// Database backends
+const (
+	MongoDB = "mongodb" // Stores everything in MongoDB
+	Memory  = "memory"  // Keeps everything in memory and loses it on restart, for development and demos
+)
+
+// Redacted replaces secrets in the output of Config.Redacted
+const Redacted = "REDACTED"
+
+// Config is the configuration of the Recipe API
+type Config struct {
+	HTTP     HTTPConfig     `yaml:"http"`
+	GRPC     GRPCConfig     `yaml:"grpc"`
+	TLS      TLSConfig      `yaml:"tls"`
+	Database DatabaseConfig `yaml:"database"`
+	Log      LogConfig      `yaml:"log"`
+	CORS     CORSConfig     `yaml:"cors"`
+	Auth     AuthConfig     `yaml:"auth"`
+	Trash    TrashConfig    `yaml:"trash"`
+	Webhook  WebhookConfig  `yaml:"webhook"`
+	Shutdown ShutdownConfig `yaml:"shutdown"`
+	Quality  QualityConfig  `yaml:"quality"`
+}
+
+// HTTPConfig configures the server of the REST and GraphQL APIs
+type HTTPConfig struct {
+	Addr              string        `yaml:"addr"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`  // 0 for none, bounds uploads of imports
+	WriteTimeout      time.Duration `yaml:"write_timeout"` // 0 for none, which event streams and large exports need
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+}
+
+// GRPCConfig configures the gRPC server
+type GRPCConfig struct {
+	Addr string `yaml:"addr"`
+}
+
+// TLSConfig configures the certificate both servers use, they serve plain text when it is not set
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+// Enabled reports whether the servers use TLS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// DatabaseConfig configures where the API stores its data
+type DatabaseConfig struct {
+	Backend          string        `yaml:"backend"`
+	URI              string        `yaml:"uri"` // May contain a password
+	Name             string        `yaml:"name"`
+	ConnectTimeout   time.Duration `yaml:"connect_timeout"`
+	OperationTimeout time.Duration `yaml:"operation_timeout"` // 0 for none
+}
+
+// LogConfig configures the logger
+type LogConfig struct {
+	Level string `yaml:"level"`
+}
+
+// CORSConfig configures which browser origins may call the API, no origin may when AllowedOrigins is empty
+type CORSConfig struct {
+	AllowedOrigins []string      `yaml:"allowed_origins"` // * allows any origin
+	AllowedMethods []string      `yaml:"allowed_methods"`
+	AllowedHeaders []string      `yaml:"allowed_headers"`
+	MaxAge         time.Duration `yaml:"max_age"` // How long browsers may cache the answer to a preflight request
+}
+
+// AuthConfig configures how far the API trusts the identity set by the authenticating proxy in front of it
+type AuthConfig struct {
+	ProxySecret   string `yaml:"proxy_secret"`   // When set, requests must carry it to prove they came through the proxy
+	RequireUser   bool   `yaml:"require_user"`   // Reject requests without a user instead of handling them as anonymous
+	TrustIdentity bool   `yaml:"trust_identity"` // Trust the user and roles of requests without a proxy secret, for development only
+}
+
+// TrashConfig configures the trash of deleted recipes
+type TrashConfig struct {
+	Retention time.Duration `yaml:"retention"`
+}
+
+// WebhookConfig configures the delivery of events to webhooks
+type WebhookConfig struct {
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// ShutdownConfig configures how the API stops on SIGTERM
+type ShutdownConfig struct {
+	Delay   time.Duration `yaml:"delay"`   // How long to keep serving while failing readiness, for load balancers to notice
+	Timeout time.Duration `yaml:"timeout"` // Deadline to drain requests, stop the workers and close the database after the delay
+}
+
+// QualityConfig configures how bean lot inspections are graded
+type QualityConfig struct {
+	GradeI      LimitsConfig `yaml:"grade_1"`
+	GradeII     LimitsConfig `yaml:"grade_2"`
+	MaxMoisture float64      `yaml:"max_moisture"` // Lots with more moisture are rejected, in percent
+}
+
+// LimitsConfig are the maximum percentages of defective beans in the cut test of a grade
+type LimitsConfig struct {
+	Moldy         float64 `yaml:"moldy"`
+	Slaty         float64 `yaml:"slaty"`
+	InsectDamaged float64 `yaml:"insect_damaged"`
+}
+
+// Thresholds returns the grading thresholds of the configuration
+func (c QualityConfig) Thresholds() quality.Thresholds {
+	return quality.Thresholds{
+		GradeI:      quality.Limits(c.GradeI),
+		GradeII:     quality.Limits(c.GradeII),
+		MaxMoisture: c.MaxMoisture,
+	}
+}
+
+// Default returns the configuration used when nothing is set
+func Default() Config {
+	thresholds := quality.DefaultThresholds()
+	return Config{
+		HTTP: HTTPConfig{
+			Addr:              ":8080",
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       5 * time.Minute,
+			IdleTimeout:       2 * time.Minute,
+		},
+		GRPC: GRPCConfig{Addr: ":9090"},
+		Database: DatabaseConfig{
+			Backend:        MongoDB,
+			URI:            "mongodb://localhost:27017",
+			Name:           "recipe_db",
+			ConnectTimeout: 10 * time.Second,
+		},
+		Log: LogConfig{Level: "info"},
+		CORS: CORSConfig{
+			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE"},
+			AllowedHeaders: []string{"Content-Type", "Accept", "X-Request-ID"},
+			MaxAge:         12 * time.Hour,
+		},
+		Trash:    TrashConfig{Retention: service.DefaultTrashRetention},
+		Webhook:  WebhookConfig{Timeout: 10 * time.Second},
+		Shutdown: ShutdownConfig{Timeout: 20 * time.Second},
+		Quality: QualityConfig{
+			GradeI:      LimitsConfig(thresholds.GradeI),
+			GradeII:     LimitsConfig(thresholds.GradeII),
+			MaxMoisture: thresholds.MaxMoisture,
+		},
+	}
+}
+
+// EnvPrefix prefixes the environment variables of the settings
+const EnvPrefix = "RECIPE_API_"
+
+// legacyEnv are the variables the API read before it had a configuration, still honoured below their RECIPE_API_ variable
+var legacyEnv = map[string]string{
+	"db-uri":          "MONGODB_URI",
+	"db-name":         "MONGODB_DATABASE",
+	"trash-retention": "RECIPE_TRASH_RETENTION",
+}
+
+// flags returns a flag set whose flags set the fields of c, with the current values of c as their defaults
+func (c *Config) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.String("config", "", "YAML or JSON file with the configuration, or $RECIPE_API_CONFIG")
+
+	fs.StringVar(&c.HTTP.Addr, "http-addr", c.HTTP.Addr, "address the REST and GraphQL APIs listen on")
+	fs.DurationVar(&c.HTTP.ReadHeaderTimeout, "http-read-header-timeout", c.HTTP.ReadHeaderTimeout, "maximum time to read the headers of a request")
+	fs.DurationVar(&c.HTTP.ReadTimeout, "http-read-timeout", c.HTTP.ReadTimeout, "maximum time to read a request, 0 for none")
+	fs.DurationVar(&c.HTTP.WriteTimeout, "http-write-timeout", c.HTTP.WriteTimeout, "maximum time to write a response, 0 for none as event streams need")
+	fs.DurationVar(&c.HTTP.IdleTimeout, "http-idle-timeout", c.HTTP.IdleTimeout, "how long idle keep-alive connections are kept open")
+	fs.StringVar(&c.GRPC.Addr, "grpc-addr", c.GRPC.Addr, "address the gRPC API listens on")
+	fs.StringVar(&c.TLS.CertFile, "tls-cert-file", c.TLS.CertFile, "PEM certificate of both servers, they serve plain text without one")
+	fs.StringVar(&c.TLS.KeyFile, "tls-key-file", c.TLS.KeyFile, "PEM private key of the certificate")
+	fs.StringVar(&c.Database.Backend, "db-backend", c.Database.Backend, "where to store data: mongodb, or memory to lose it on restart")
+	fs.StringVar(&c.Database.URI, "db-uri", c.Database.URI, "MongoDB connection string, or $MONGODB_URI")
+	fs.StringVar(&c.Database.Name, "db-name", c.Database.Name, "MongoDB database, or $MONGODB_DATABASE")
+	fs.DurationVar(&c.Database.ConnectTimeout, "db-connect-timeout", c.Database.ConnectTimeout, "maximum time to connect to MongoDB at startup")
+	fs.DurationVar(&c.Database.OperationTimeout, "db-operation-timeout", c.Database.OperationTimeout, "maximum time of a MongoDB operation, 0 for none")
+	fs.StringVar(&c.Log.Level, "log-level", c.Log.Level, "minimum level of the logs: debug, info, warn or error")
+	fs.Var((*list)(&c.CORS.AllowedOrigins), "cors-allowed-origins", "comma separated browser origins allowed to call the API, * for any")
+	fs.Var((*list)(&c.CORS.AllowedMethods), "cors-allowed-methods", "comma separated methods browsers may use")
+	fs.Var((*list)(&c.CORS.AllowedHeaders), "cors-allowed-headers", "comma separated request headers browsers may send")
+	fs.DurationVar(&c.CORS.MaxAge, "cors-max-age", c.CORS.MaxAge, "how long browsers may cache the answer to a preflight request")
+	fs.StringVar(&c.Auth.ProxySecret, "auth-proxy-secret", c.Auth.ProxySecret, "secret the authenticating proxy sends, requests without it are rejected when set")
+	fs.BoolVar(&c.Auth.RequireUser, "auth-require-user", c.Auth.RequireUser, "reject requests without a user instead of handling them as anonymous")
+	fs.BoolVar(&c.Auth.TrustIdentity, "auth-trust-identity", c.Auth.TrustIdentity, "trust the X-User and X-Roles headers without a proxy secret, for development only")
+	fs.DurationVar(&c.Trash.Retention, "trash-retention", c.Trash.Retention, "how long deleted recipes stay in the trash, or $RECIPE_TRASH_RETENTION")
+	fs.DurationVar(&c.Webhook.Timeout, "webhook-timeout", c.Webhook.Timeout, "maximum time of a webhook delivery")
+	fs.DurationVar(&c.Shutdown.Delay, "shutdown-delay", c.Shutdown.Delay, "how long to keep serving after SIGTERM while failing readiness")
+	fs.DurationVar(&c.Shutdown.Timeout, "shutdown-timeout", c.Shutdown.Timeout, "deadline to drain requests and stop after the delay")
+	for _, grade := range []struct {
+		name, label string
+		limits      *LimitsConfig
+	}{{"grade-1", "grade I", &c.Quality.GradeI}, {"grade-2", "grade II", &c.Quality.GradeII}} {
+		fs.Float64Var(&grade.limits.Moldy, "quality-"+grade.name+"-moldy", grade.limits.Moldy, "maximum percentage of moldy beans in the cut test of "+grade.label)
+		fs.Float64Var(&grade.limits.Slaty, "quality-"+grade.name+"-slaty", grade.limits.Slaty, "maximum percentage of slaty beans in the cut test of "+grade.label)
+		fs.Float64Var(&grade.limits.InsectDamaged, "quality-"+grade.name+"-insect-damaged", grade.limits.InsectDamaged, "maximum percentage of insect damaged beans in the cut test of "+grade.label)
+	}
+	fs.Float64Var(&c.Quality.MaxMoisture, "quality-max-moisture", c.Quality.MaxMoisture, "maximum moisture percentage of bean lots, wetter lots are rejected")
+	return fs
+}
+
+// Load builds the configuration from the defaults, the configuration file, the environment and the command line arguments,
+// and validates it. getenv is usually os.Getenv. The usage is written to output when the arguments are invalid or ask for help.
+func Load(name string, args []string, getenv func(string) string, output io.Writer) (*Config, error) {
+	// Parse the command line first to find the file, and apply it again last so it takes precedence
+	parsed := Default()
+	fs := parsed.flags(name)
+	fs.SetOutput(output)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	set := map[string]string{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = f.Value.String() })
+
+	cfg := Default()
+	path := set["config"]
+	if path == "" {
+		path = getenv(EnvPrefix + "CONFIG")
+	}
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	fs = cfg.flags(name)
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if f.Name == "config" || err != nil {
+			return
+		}
+		variable := EnvName(f.Name)
+		v := getenv(variable)
+		if v == "" && legacyEnv[f.Name] != "" {
+			variable = legacyEnv[f.Name]
+			v = getenv(variable)
+		}
+		if v != "" {
+			if serr := fs.Set(f.Name, v); serr != nil {
+				err = fmt.Errorf("invalid %s: %w", variable, serr)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	for name, v := range set {
+		if err := fs.Set(name, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// EnvName returns the environment variable of the setting with the flag name
+func EnvName(flagName string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// readFile sets the settings in a YAML or JSON file, rejecting unknown settings so typos do not go unnoticed
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("reading configuration: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("reading configuration %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports every invalid setting of the configuration
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	for name, addr := range map[string]string{"http.addr": c.HTTP.Addr, "grpc.addr": c.GRPC.Addr} {
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			invalid("%s %q must be a host:port or :port address", name, addr)
+		}
+	}
+	if c.HTTP.Addr == c.GRPC.Addr {
+		invalid("http.addr and grpc.addr must differ")
+	}
+	for name, d := range map[string]time.Duration{
+		"http.read_header_timeout":   c.HTTP.ReadHeaderTimeout,
+		"http.read_timeout":          c.HTTP.ReadTimeout,
+		"http.write_timeout":         c.HTTP.WriteTimeout,
+		"http.idle_timeout":          c.HTTP.IdleTimeout,
+		"database.operation_timeout": c.Database.OperationTimeout,
+		"cors.max_age":               c.CORS.MaxAge,
+	} {
+		if d < 0 {
+			invalid("%s must not be negative", name)
+		}
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		invalid("tls.cert_file and tls.key_file must be set together")
+	}
+
+	switch c.Database.Backend {
+	case MongoDB:
+		if !strings.HasPrefix(c.Database.URI, "mongodb://") && !strings.HasPrefix(c.Database.URI, "mongodb+srv://") {
+			invalid("database.uri must be a mongodb:// or mongodb+srv:// connection string")
+		}
+		if c.Database.Name == "" || strings.ContainsAny(c.Database.Name, "/\\. \"$") {
+			invalid("database.name %q is not a valid MongoDB database name", c.Database.Name)
+		}
+		if c.Database.ConnectTimeout <= 0 {
+			invalid("database.connect_timeout must be positive")
+		}
+	case Memory:
+	default:
+		invalid("database.backend %q must be %s or %s", c.Database.Backend, MongoDB, Memory)
+	}
+
+	if _, err := zapcore.ParseLevel(c.Log.Level); err != nil {
+		invalid("log.level %q must be debug, info, warn or error", c.Log.Level)
+	}
+
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			invalid("cors.allowed_origins %q must be * or an origin such as https://example.com", origin)
+		}
+	}
+
+	if c.Auth.ProxySecret != "" && len(c.Auth.ProxySecret) < 16 {
+		invalid("auth.proxy_secret must be at least 16 characters")
+	}
+	if c.Auth.RequireUser && c.Auth.ProxySecret == "" && !c.Auth.TrustIdentity {
+		invalid("auth.require_user needs auth.proxy_secret, as users are only trusted with it")
+	}
+	if c.Trash.Retention <= 0 {
+		invalid("trash.retention must be positive")
+	}
+	if c.Webhook.Timeout <= 0 {
+		invalid("webhook.timeout must be positive")
+	}
+	if c.Shutdown.Timeout <= 0 {
+		invalid("shutdown.timeout must be positive")
+	}
+	for name, v := range map[string]float64{
+		"quality.grade_1.moldy":          c.Quality.GradeI.Moldy,
+		"quality.grade_1.slaty":          c.Quality.GradeI.Slaty,
+		"quality.grade_1.insect_damaged": c.Quality.GradeI.InsectDamaged,
+		"quality.grade_2.moldy":          c.Quality.GradeII.Moldy,
+		"quality.grade_2.slaty":          c.Quality.GradeII.Slaty,
+		"quality.grade_2.insect_damaged": c.Quality.GradeII.InsectDamaged,
+	} {
+		if v < 0 || v > 100 {
+			invalid("%s must be a percentage between 0 and 100", name)
+		}
+	}
+	if err := c.Quality.Thresholds().Validate(); err != nil {
+		invalid("quality: %v", err)
+	}
+
+	slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	return errors.Join(errs...)
+}
+
+// Redacted returns a copy of the configuration that is safe to log, with the secrets replaced by REDACTED
+func (c Config) Redacted() Config {
+	c.Database.URI = redactURI(c.Database.URI)
+	if c.Auth.ProxySecret != "" {
+		c.Auth.ProxySecret = Redacted
+	}
+	return c
+}
+
+// redactURI replaces the password in a connection string.
+// It does not use net/url, which rejects the comma separated hosts of replica sets.
+func redactURI(uri string) string {
+	scheme, rest, ok := strings.Cut(uri, "://")
+	if !ok {
+		return uri
+	}
+	authority, path := rest, ""
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		authority, path = rest[:i], rest[i:]
+	}
+	at := strings.LastIndex(authority, "@")
+	if at < 0 {
+		return uri
+	}
+	user, _, hasPassword := strings.Cut(authority[:at], ":")
+	if !hasPassword {
+		return uri
+	}
+	return scheme + "://" + user + ":" + Redacted + authority[at:] + path
+}
+
+// Map returns the configuration as the nested settings of its file, to log it
+func (c Config) Map() map[string]any {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+	var m map[string]any
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// list is a flag holding a comma separated list, replaced rather than appended to when set
+type list []string
+
+func (l *list) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *list) Set(s string) error {
+	*l = []string{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
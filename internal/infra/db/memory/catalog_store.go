@@ -0,0 +1,165 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// CacaoStore implements the mongo.CacaoStore interface in memory
+type CacaoStore struct {
+	mu    sync.RWMutex
+	cacao []*recipe.Cacao
+}
+
+// NewCacaoStore creates a new, empty CacaoStore
+func NewCacaoStore() *CacaoStore {
+	return &CacaoStore{}
+}
+
+// Create adds cacao to the catalog
+func (s *CacaoStore) Create(ctx context.Context, cacao *recipe.Cacao) (*recipe.Cacao, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cacao.ID == "" {
+		cacao.ID = newID()
+	}
+	cacao.CreatedAt = time.Now()
+	cacao.UpdatedAt = time.Now()
+	s.cacao = append(s.cacao, clone(cacao))
+	return cacao, nil
+}
+
+// GetByID retrieves cacao by its ID, returning nil if it does not exist
+func (s *CacaoStore) GetByID(ctx context.Context, id string) (*recipe.Cacao, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, c := range s.cacao {
+		if c.ID == id {
+			return clone(c), nil
+		}
+	}
+	return nil, nil
+}
+
+// Update replaces existing cacao
+func (s *CacaoStore) Update(ctx context.Context, cacao *recipe.Cacao) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cacao.UpdatedAt = time.Now()
+	for i, c := range s.cacao {
+		if c.ID == cacao.ID {
+			s.cacao[i] = clone(cacao)
+		}
+	}
+	return nil
+}
+
+// Delete removes cacao by its ID
+func (s *CacaoStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, c := range s.cacao {
+		if c.ID == id {
+			s.cacao = append(s.cacao[:i], s.cacao[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// List retrieves cacao with pagination
+func (s *CacaoStore) List(ctx context.Context, limit, offset int64) ([]*recipe.Cacao, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cacao := make([]*recipe.Cacao, 0)
+	for _, c := range page(s.cacao, limit, offset) {
+		cacao = append(cacao, clone(c))
+	}
+	return cacao, nil
+}
+
+// MoldStore implements the mongo.MoldStore interface in memory
+type MoldStore struct {
+	mu    sync.RWMutex
+	molds []*recipe.Mold
+}
+
+// NewMoldStore creates a new, empty MoldStore
+func NewMoldStore() *MoldStore {
+	return &MoldStore{}
+}
+
+// Create adds a mold to the catalog
+func (s *MoldStore) Create(ctx context.Context, mold *recipe.Mold) (*recipe.Mold, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if mold.ID == "" {
+		mold.ID = newID()
+	}
+	mold.CreatedAt = time.Now()
+	mold.UpdatedAt = time.Now()
+	s.molds = append(s.molds, clone(mold))
+	return mold, nil
+}
+
+// GetByID retrieves a mold by its ID, returning nil if it does not exist
+func (s *MoldStore) GetByID(ctx context.Context, id string) (*recipe.Mold, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, m := range s.molds {
+		if m.ID == id {
+			return clone(m), nil
+		}
+	}
+	return nil, nil
+}
+
+// Update replaces an existing mold
+func (s *MoldStore) Update(ctx context.Context, mold *recipe.Mold) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	mold.UpdatedAt = time.Now()
+	for i, m := range s.molds {
+		if m.ID == mold.ID {
+			s.molds[i] = clone(mold)
+		}
+	}
+	return nil
+}
+
+// Delete removes a mold by its ID
+func (s *MoldStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, m := range s.molds {
+		if m.ID == id {
+			s.molds = append(s.molds[:i], s.molds[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// List retrieves molds with pagination
+func (s *MoldStore) List(ctx context.Context, limit, offset int64) ([]*recipe.Mold, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	molds := make([]*recipe.Mold, 0)
+	for _, m := range page(s.molds, limit, offset) {
+		molds = append(molds, clone(m))
+	}
+	return molds, nil
+}
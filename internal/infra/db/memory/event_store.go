@@ -0,0 +1,306 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// AuditStore implements the mongo.AuditStore interface in memory
+type AuditStore struct {
+	mu     sync.RWMutex
+	events []*audit.Event // In the order they were appended
+}
+
+// NewAuditStore creates a new, empty AuditStore
+func NewAuditStore() *AuditStore {
+	return &AuditStore{}
+}
+
+// Append adds an event to the audit log
+func (s *AuditStore) Append(ctx context.Context, e *audit.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e.ID == "" {
+		e.ID = newID()
+	}
+	s.events = append(s.events, clone(e))
+	return nil
+}
+
+// List retrieves the events matching the filter with pagination, most recent first
+func (s *AuditStore) List(ctx context.Context, filter audit.Filter, limit, offset int64) ([]*audit.Event, error) {
+	events := s.matching(filter)
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Timestamp.Equal(events[j].Timestamp) {
+			return events[i].Timestamp.After(events[j].Timestamp)
+		}
+		return events[i].ID > events[j].ID
+	})
+	return page(events, limit, offset), nil
+}
+
+// Each calls fn for every event matching the filter in chronological order.
+// It stops at the first error returned by fn.
+func (s *AuditStore) Each(ctx context.Context, filter audit.Filter, fn func(*audit.Event) error) error {
+	events := s.matching(filter)
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+	for _, e := range events {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AuditStore) matching(filter audit.Filter) []*audit.Event {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := make([]*audit.Event, 0)
+	for _, e := range s.events {
+		if filter.Matches(e) {
+			events = append(events, clone(e))
+		}
+	}
+	return events
+}
+
+// OutboxStore implements the mongo.OutboxStore interface in memory
+type OutboxStore struct {
+	mu         sync.RWMutex
+	events     []*event.Event // In the order they were appended
+	dispatched map[string]bool
+}
+
+// NewOutboxStore creates a new, empty OutboxStore
+func NewOutboxStore() *OutboxStore {
+	return &OutboxStore{dispatched: map[string]bool{}}
+}
+
+// Append adds an event to the outbox
+func (s *OutboxStore) Append(ctx context.Context, e *event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e.ID == "" {
+		e.ID = newID()
+	}
+	s.events = append(s.events, clone(e))
+	return nil
+}
+
+// Undispatched retrieves the events that have not been dispatched yet, oldest first
+func (s *OutboxStore) Undispatched(ctx context.Context, limit int64) ([]*event.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	events := make([]*event.Event, 0)
+	for _, e := range s.events {
+		if !s.dispatched[e.ID] {
+			events = append(events, clone(e))
+		}
+	}
+	return page(events, limit, 0), nil
+}
+
+// MarkDispatched records that deliveries of the event have been scheduled
+func (s *OutboxStore) MarkDispatched(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dispatched[id] = true
+	return nil
+}
+
+// After retrieves the events appended after the event with the given ID, oldest first, or from the first event
+// if the ID is empty. It returns event.ErrEventNotFound if there is no event with the ID.
+func (s *OutboxStore) After(ctx context.Context, id string, limit int64) ([]*event.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	start := 0
+	if id != "" {
+		start = -1
+		for i, e := range s.events {
+			if e.ID == id {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return nil, event.ErrEventNotFound
+		}
+	}
+
+	events := make([]*event.Event, 0)
+	for _, e := range s.events[start:] {
+		events = append(events, clone(e))
+	}
+	return page(events, limit, 0), nil
+}
+
+// LatestID returns the ID of the most recently appended event, or an empty ID if the outbox is empty
+func (s *OutboxStore) LatestID(ctx context.Context) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.events) == 0 {
+		return "", nil
+	}
+	return s.events[len(s.events)-1].ID, nil
+}
+
+// WebhookStore implements the mongo.WebhookStore interface in memory
+type WebhookStore struct {
+	mu       sync.RWMutex
+	webhooks []*event.Webhook // Oldest first
+}
+
+// NewWebhookStore creates a new, empty WebhookStore
+func NewWebhookStore() *WebhookStore {
+	return &WebhookStore{}
+}
+
+// cloneWebhook returns a copy of the webhook including its secret, which clone drops as it is never marshalled
+func cloneWebhook(w *event.Webhook) *event.Webhook {
+	c := clone(w)
+	c.Secret = w.Secret
+	return c
+}
+
+// Create registers a webhook
+func (s *WebhookStore) Create(ctx context.Context, w *event.Webhook) (*event.Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w.ID == "" {
+		w.ID = newID()
+	}
+	w.CreatedAt = time.Now()
+	w.UpdatedAt = w.CreatedAt
+	s.webhooks = append(s.webhooks, cloneWebhook(w))
+	return w, nil
+}
+
+// GetByID retrieves a webhook by its ID, returning nil if it does not exist
+func (s *WebhookStore) GetByID(ctx context.Context, id string) (*event.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, w := range s.webhooks {
+		if w.ID == id {
+			return cloneWebhook(w), nil
+		}
+	}
+	return nil, nil
+}
+
+// Update replaces an existing webhook
+func (s *WebhookStore) Update(ctx context.Context, w *event.Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.UpdatedAt = time.Now()
+	for i, existing := range s.webhooks {
+		if existing.ID == w.ID {
+			s.webhooks[i] = cloneWebhook(w)
+		}
+	}
+	return nil
+}
+
+// Delete removes a webhook by its ID
+func (s *WebhookStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, w := range s.webhooks {
+		if w.ID == id {
+			s.webhooks = append(s.webhooks[:i], s.webhooks[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// List retrieves all webhooks, oldest first
+func (s *WebhookStore) List(ctx context.Context) ([]*event.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	webhooks := make([]*event.Webhook, len(s.webhooks))
+	for i, w := range s.webhooks {
+		webhooks[i] = cloneWebhook(w)
+	}
+	return webhooks, nil
+}
+
+// DeliveryStore implements the mongo.DeliveryStore interface in memory
+type DeliveryStore struct {
+	mu         sync.RWMutex
+	deliveries []*event.Delivery // In the order they were created
+}
+
+// NewDeliveryStore creates a new, empty DeliveryStore
+func NewDeliveryStore() *DeliveryStore {
+	return &DeliveryStore{}
+}
+
+// Create adds a delivery to the log
+func (s *DeliveryStore) Create(ctx context.Context, d *event.Delivery) (*event.Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if d.ID == "" {
+		d.ID = newID()
+	}
+	s.deliveries = append(s.deliveries, clone(d))
+	return d, nil
+}
+
+// Update replaces an existing delivery
+func (s *DeliveryStore) Update(ctx context.Context, d *event.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.deliveries {
+		if existing.ID == d.ID {
+			s.deliveries[i] = clone(d)
+		}
+	}
+	return nil
+}
+
+// Due retrieves the pending deliveries whose next attempt is due, in the order they were created
+func (s *DeliveryStore) Due(ctx context.Context, now time.Time, limit int64) ([]*event.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	deliveries := make([]*event.Delivery, 0)
+	for _, d := range s.deliveries {
+		if d.Status == event.Pending && !d.NextAttemptAt.After(now) {
+			deliveries = append(deliveries, clone(d))
+		}
+	}
+	return page(deliveries, limit, 0), nil
+}
+
+// List retrieves the deliveries to a webhook with pagination, most recent first
+func (s *DeliveryStore) List(ctx context.Context, webhookID string, limit, offset int64) ([]*event.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	deliveries := make([]*event.Delivery, 0)
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		if s.deliveries[i].WebhookID == webhookID {
+			deliveries = append(deliveries, clone(s.deliveries[i]))
+		}
+	}
+	return page(deliveries, limit, offset), nil
+}
@@ -0,0 +1,63 @@
+// Package memory implements the stores of the mongo package in memory, for tests and for running the API without a database.
+// Every store is safe for concurrent use, and hands out copies so callers cannot change what is stored.
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// newID returns a new ID in the same format as the MongoDB stores
+func newID() string {
+	return primitive.NewObjectID().Hex()
+}
+
+// clone returns a deep copy of v made through JSON, so fields that are not marshalled, such as those tagged "-", are not copied
+func clone[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var c T
+	if err := json.Unmarshal(data, &c); err != nil {
+		panic(err)
+	}
+	return &c
+}
+
+// page returns the items in [offset, offset+limit), where a limit of 0 or less means no limit like in MongoDB
+func page[T any](items []T, limit, offset int64) []T {
+	if offset >= int64(len(items)) {
+		return []T{}
+	}
+	items = items[max(offset, 0):]
+	if limit > 0 && limit < int64(len(items)) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// Transactor implements the mongo.Transactor interface by running the function under a lock,
+// so the changes of a function are not interleaved with those of another.
+// The changes are not rolled back when the function fails.
+type Transactor struct {
+	mu sync.Mutex
+}
+
+// NewTransactor creates a new Transactor
+func NewTransactor() *Transactor {
+	return &Transactor{}
+}
+
+// WithinTx runs fn under the lock of the transactor
+func (t *Transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return fn(ctx)
+}
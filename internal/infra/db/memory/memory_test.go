@@ -0,0 +1,272 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// The stores must be usable wherever the services expect the MongoDB stores
+var (
+	_ mongo.RecipeStore     = (*RecipeStore)(nil)
+	_ mongo.CacaoStore      = (*CacaoStore)(nil)
+	_ mongo.MoldStore       = (*MoldStore)(nil)
+	_ mongo.AuditStore      = (*AuditStore)(nil)
+	_ mongo.OutboxStore     = (*OutboxStore)(nil)
+	_ mongo.WebhookStore    = (*WebhookStore)(nil)
+	_ mongo.DeliveryStore   = (*DeliveryStore)(nil)
+	_ mongo.BeanLotStore    = (*BeanLotStore)(nil)
+	_ mongo.BatchStore      = (*BatchStore)(nil)
+	_ mongo.TastingStore    = (*TastingStore)(nil)
+	_ mongo.ExperimentStore = (*ExperimentStore)(nil)
+	_ mongo.Transactor      = (*Transactor)(nil)
+)
+
+func TestRecipeStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewRecipeStore()
+	for _, name := range []string{"Dark 70", "Milk 40", "Dark 85"} {
+		if _, err := s.Create(ctx, &recipe.Recipe{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	first, err := s.List(ctx, recipe.ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Recipes) != 1 || first.Recipes[0].Name != "Dark 70" || first.Total != 3 || first.NextCursor == "" {
+		t.Fatalf("expected the first page in insertion order, got %+v", first)
+	}
+	after, err := recipe.ParseCursor(first.NextCursor, recipe.DefaultSort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := s.List(ctx, recipe.ListOptions{Limit: 2, After: &after})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recipes := next.Recipes
+	if len(recipes) != 2 || recipes[0].Name != "Milk 40" || recipes[1].Name != "Dark 85" || next.NextCursor != "" {
+		t.Fatalf("expected the last page in insertion order, got %+v", next)
+	}
+	byName, _ := s.List(ctx, recipe.ListOptions{Filter: recipe.Filter{Name: "dark"}, Sort: recipe.Sort{Field: recipe.SortByName, Descending: true}})
+	if len(byName.Recipes) != 2 || byName.Recipes[0].Name != "Dark 85" || byName.Total != 2 {
+		t.Errorf("expected the dark recipes by name descending, got %+v", byName)
+	}
+
+	// Changing a returned recipe does not change the stored one
+	recipes[0].Name = "Changed"
+	got, err := s.GetByID(ctx, recipes[0].ID)
+	if err != nil || got.Name != "Milk 40" {
+		t.Errorf("expected the stored recipe to be unchanged, got %+v, %v", got, err)
+	}
+
+	if err := s.Delete(ctx, got.ID, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := s.GetByID(ctx, got.ID); got != nil {
+		t.Errorf("expected a deleted recipe not to be found, got %+v", got)
+	}
+	if err := s.Delete(ctx, got.ID, "alice"); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("expected ErrRecipeNotFound when deleting twice, got %v", err)
+	}
+	if count, _ := s.Count(ctx); count != 2 {
+		t.Errorf("expected 2 recipes outside the trash, got %d", count)
+	}
+	trash, _ := s.ListDeleted(ctx, 0, 0)
+	if len(trash) != 1 || trash[0].DeletedBy != "alice" {
+		t.Errorf("expected the deleted recipe in the trash, got %+v", trash)
+	}
+
+	if purged, _ := s.Purge(ctx, time.Now().Add(time.Hour)); purged != 1 {
+		t.Errorf("expected 1 purged recipe, got %d", purged)
+	}
+	if err := s.Restore(ctx, trash[0].ID); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("expected a purged recipe not to be restorable, got %v", err)
+	}
+}
+
+func TestDeliveryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewDeliveryStore()
+	due := event.NewDelivery("hook-1", event.New(event.RecipeCreated, "r1", "", nil))
+	later := event.NewDelivery("hook-1", event.New(event.RecipeUpdated, "r1", "", nil))
+	later.NextAttemptAt = time.Now().Add(time.Minute)
+	other := event.NewDelivery("hook-2", event.New(event.RecipeCreated, "r2", "", nil))
+	other.Status = event.Succeeded
+	for _, d := range []*event.Delivery{due, later, other} {
+		if _, err := s.Create(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, _ := s.Due(ctx, time.Now(), 10)
+	if len(got) != 1 || got[0].ID != due.ID {
+		t.Errorf("expected only the pending delivery that is due, got %+v", got)
+	}
+	list, _ := s.List(ctx, "hook-1", 10, 0)
+	if len(list) != 2 || list[0].ID != later.ID {
+		t.Errorf("expected the deliveries to the webhook most recent first, got %+v", list)
+	}
+}
+
+func TestWebhookStoreKeepsSecret(t *testing.T) {
+	ctx := context.Background()
+	s := NewWebhookStore()
+	w, err := event.NewWebhook("https://erp.example.com/hooks", "0123456789abcdef", nil, "ERP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Create(ctx, w); err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := s.GetByID(ctx, w.ID)
+	if got == nil || got.Secret != "0123456789abcdef" {
+		t.Errorf("expected the secret to be stored, got %+v", got)
+	}
+	list, _ := s.List(ctx)
+	if len(list) != 1 || list[0].Secret != "0123456789abcdef" {
+		t.Errorf("expected the listed webhook to carry its secret, got %+v", list)
+	}
+}
+
+func TestOutboxStoreAfter(t *testing.T) {
+	ctx := context.Background()
+	s := NewOutboxStore()
+	if latest, _ := s.LatestID(ctx); latest != "" {
+		t.Errorf("expected no latest ID in an empty outbox, got %s", latest)
+	}
+
+	// Events committed in another order than their IDs, as concurrent transactions do
+	for _, id := range []string{"65f1c0ffee000000000000b2", "65f1c0ffee000000000000b1", "65f1c0ffee000000000000b3"} {
+		e := event.New(event.RecipeCreated, "r1", "", nil)
+		e.ID = id
+		if err := s.Append(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, _ := s.After(ctx, "65f1c0ffee000000000000b2", 10)
+	if len(got) != 2 || got[0].ID != "65f1c0ffee000000000000b1" || got[1].ID != "65f1c0ffee000000000000b3" {
+		t.Errorf("expected the events committed after the cursor, got %+v", got)
+	}
+	if got, _ := s.After(ctx, "", 2); len(got) != 2 || got[0].ID != "65f1c0ffee000000000000b2" {
+		t.Errorf("expected the first page of events, got %+v", got)
+	}
+	if _, err := s.After(ctx, "65f1c0ffee000000000000ff", 10); !errors.Is(err, event.ErrEventNotFound) {
+		t.Errorf("expected an unknown event to be reported, got %v", err)
+	}
+	if latest, _ := s.LatestID(ctx); latest != "65f1c0ffee000000000000b3" {
+		t.Errorf("expected the last committed event, got %s", latest)
+	}
+}
+
+func TestTastingStoreAddScore(t *testing.T) {
+	ctx := context.Background()
+	s := NewTastingStore()
+	session, _ := tasting.NewSession("", "r1", time.Now(), nil)
+	if _, err := s.Create(ctx, session); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(panelist string) {
+			defer wg.Done()
+			if _, err := s.AddScore(ctx, session.ID, tasting.Score{Panelist: panelist}); err != nil {
+				t.Error(err)
+			}
+		}(fmt.Sprintf("panelist-%d", i))
+	}
+	wg.Wait()
+
+	got, _ := s.GetByID(ctx, session.ID)
+	if len(got.Scores) != 10 || len(got.Panelists) != 10 {
+		t.Errorf("expected every concurrent score to be kept, got %d scores from %d panelists", len(got.Scores), len(got.Panelists))
+	}
+	if _, err := s.AddScore(ctx, session.ID, tasting.Score{Panelist: "panelist-3"}); !errors.Is(err, tasting.ErrDuplicatePanelist) {
+		t.Errorf("expected a second score of the panelist to be rejected, got %v", err)
+	}
+	if _, err := s.AddScore(ctx, "missing", tasting.Score{Panelist: "panelist-3"}); !errors.Is(err, tasting.ErrSessionNotFound) {
+		t.Errorf("expected an unknown session to be reported, got %v", err)
+	}
+}
+
+func TestExperimentStoreAddVariant(t *testing.T) {
+	ctx := context.Background()
+	s := NewExperimentStore()
+	exp, _ := experiment.NewExperiment("Less sugar", "base", "")
+	if _, err := s.Create(ctx, exp); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(recipeID string) {
+			defer wg.Done()
+			if _, err := s.AddVariant(ctx, exp.ID, experiment.Variant{RecipeID: recipeID}); err != nil {
+				t.Error(err)
+			}
+		}(fmt.Sprintf("variant-%d", i))
+	}
+	wg.Wait()
+
+	// An update of the experiment read before the variants were added must not drop them
+	exp.Conclusion = "Sweeter is better"
+	if err := s.Update(ctx, exp); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := s.GetByID(ctx, exp.ID)
+	if len(got.Variants) != 10 || got.Conclusion != "Sweeter is better" {
+		t.Errorf("expected every concurrent variant to be kept, got %d variants and conclusion %q", len(got.Variants), got.Conclusion)
+	}
+	if _, err := s.AddVariant(ctx, exp.ID, experiment.Variant{RecipeID: "variant-3"}); !errors.Is(err, experiment.ErrDuplicateVariant) {
+		t.Errorf("expected a recipe to be added once, got %v", err)
+	}
+	if _, err := s.AddVariant(ctx, "missing", experiment.Variant{RecipeID: "variant-3"}); !errors.Is(err, experiment.ErrExperimentNotFound) {
+		t.Errorf("expected an unknown experiment to be reported, got %v", err)
+	}
+}
+
+func TestBeanLotStoreAddInspection(t *testing.T) {
+	ctx := context.Background()
+	s := NewBeanLotStore()
+	lot, _ := quality.NewBeanLot("L-1", "", 500, time.Now())
+	if _, err := s.Create(ctx, lot); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(inspector string) {
+			defer wg.Done()
+			if _, err := s.AddInspection(ctx, lot.ID, quality.Inspection{InspectedBy: inspector, Grade: quality.GradeI}); err != nil {
+				t.Error(err)
+			}
+		}(fmt.Sprintf("inspector-%d", i))
+	}
+	wg.Wait()
+
+	got, _ := s.GetByID(ctx, lot.ID)
+	if len(got.Inspections) != 10 || got.Grade != quality.GradeI {
+		t.Errorf("expected every concurrent inspection to be kept, got %d inspections and grade %q", len(got.Inspections), got.Grade)
+	}
+	if _, err := s.AddInspection(ctx, "missing", quality.Inspection{}); !errors.Is(err, quality.ErrBeanLotNotFound) {
+		t.Errorf("expected an unknown lot to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/production"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// BeanLotStore implements the mongo.BeanLotStore interface in memory
+type BeanLotStore struct {
+	mu   sync.RWMutex
+	lots []*quality.BeanLot
+}
+
+// NewBeanLotStore creates a new, empty BeanLotStore
+func NewBeanLotStore() *BeanLotStore {
+	return &BeanLotStore{}
+}
+
+// Create registers a bean lot
+func (s *BeanLotStore) Create(ctx context.Context, lot *quality.BeanLot) (*quality.BeanLot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lot.ID == "" {
+		lot.ID = newID()
+	}
+	lot.CreatedAt = time.Now()
+	lot.UpdatedAt = time.Now()
+	s.lots = append(s.lots, clone(lot))
+	return lot, nil
+}
+
+// GetByID retrieves a bean lot by its ID, returning nil if it does not exist
+func (s *BeanLotStore) GetByID(ctx context.Context, id string) (*quality.BeanLot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, l := range s.lots {
+		if l.ID == id {
+			return clone(l), nil
+		}
+	}
+	return nil, nil
+}
+
+// AddInspection records the inspection and the grade it gives the lot
+func (s *BeanLotStore) AddInspection(ctx context.Context, id string, inspection quality.Inspection) (*quality.BeanLot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, l := range s.lots {
+		if l.ID == id {
+			l.AddInspection(*clone(&inspection))
+			return clone(l), nil
+		}
+	}
+	return nil, quality.ErrBeanLotNotFound
+}
+
+// List retrieves bean lots with pagination, most recently received first
+func (s *BeanLotStore) List(ctx context.Context, limit, offset int64) ([]*quality.BeanLot, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	lots := make([]*quality.BeanLot, len(s.lots))
+	for i, l := range s.lots {
+		lots[i] = clone(l)
+	}
+	sort.SliceStable(lots, func(i, j int) bool { return lots[i].ReceivedAt.After(lots[j].ReceivedAt) })
+	return page(lots, limit, offset), nil
+}
+
+// BatchStore implements the mongo.BatchStore interface in memory
+type BatchStore struct {
+	mu      sync.RWMutex
+	batches []*production.Batch // Oldest first
+}
+
+// NewBatchStore creates a new, empty BatchStore
+func NewBatchStore() *BatchStore {
+	return &BatchStore{}
+}
+
+// Create starts a production batch
+func (s *BatchStore) Create(ctx context.Context, batch *production.Batch) (*production.Batch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if batch.ID == "" {
+		batch.ID = newID()
+	}
+	batch.CreatedAt = time.Now()
+	s.batches = append(s.batches, clone(batch))
+	return batch, nil
+}
+
+// GetByID retrieves a batch by its ID, returning nil if it does not exist
+func (s *BatchStore) GetByID(ctx context.Context, id string) (*production.Batch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, b := range s.batches {
+		if b.ID == id {
+			return clone(b), nil
+		}
+	}
+	return nil, nil
+}
+
+// List retrieves batches with pagination, most recent first
+func (s *BatchStore) List(ctx context.Context, limit, offset int64) ([]*production.Batch, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	batches := make([]*production.Batch, 0, len(s.batches))
+	for i := len(s.batches) - 1; i >= 0; i-- {
+		batches = append(batches, clone(s.batches[i]))
+	}
+	return page(batches, limit, offset), nil
+}
+
+// TastingStore implements the mongo.TastingStore interface in memory
+type TastingStore struct {
+	mu       sync.RWMutex
+	sessions []*tasting.Session
+}
+
+// NewTastingStore creates a new, empty TastingStore
+func NewTastingStore() *TastingStore {
+	return &TastingStore{}
+}
+
+// Create adds a tasting session
+func (s *TastingStore) Create(ctx context.Context, session *tasting.Session) (*tasting.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if session.ID == "" {
+		session.ID = newID()
+	}
+	session.CreatedAt = time.Now()
+	s.sessions = append(s.sessions, clone(session))
+	return session, nil
+}
+
+// GetByID retrieves a tasting session by its ID, returning nil if it does not exist
+func (s *TastingStore) GetByID(ctx context.Context, id string) (*tasting.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, ts := range s.sessions {
+		if ts.ID == id {
+			return clone(ts), nil
+		}
+	}
+	return nil, nil
+}
+
+// AddScore records the score of a panelist unless they have already scored the session
+func (s *TastingStore) AddScore(ctx context.Context, id string, score tasting.Score) (*tasting.Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, ts := range s.sessions {
+		if ts.ID == id {
+			if err := ts.AddScore(*clone(&score)); err != nil {
+				return nil, err
+			}
+			return clone(ts), nil
+		}
+	}
+	return nil, tasting.ErrSessionNotFound
+}
+
+// List retrieves tasting sessions for the batch and/or recipe with pagination, most recently held first
+func (s *TastingStore) List(ctx context.Context, batchID, recipeID string, limit, offset int64) ([]*tasting.Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sessions := make([]*tasting.Session, 0)
+	for _, ts := range s.sessions {
+		if (batchID == "" || ts.BatchID == batchID) && (recipeID == "" || ts.RecipeID == recipeID) {
+			sessions = append(sessions, clone(ts))
+		}
+	}
+	sort.SliceStable(sessions, func(i, j int) bool { return sessions[i].HeldAt.After(sessions[j].HeldAt) })
+	return page(sessions, limit, offset), nil
+}
+
+// ExperimentStore implements the mongo.ExperimentStore interface in memory
+type ExperimentStore struct {
+	mu          sync.RWMutex
+	experiments []*experiment.Experiment
+}
+
+// NewExperimentStore creates a new, empty ExperimentStore
+func NewExperimentStore() *ExperimentStore {
+	return &ExperimentStore{}
+}
+
+// Create adds an experiment
+func (s *ExperimentStore) Create(ctx context.Context, e *experiment.Experiment) (*experiment.Experiment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e.ID == "" {
+		e.ID = newID()
+	}
+	e.CreatedAt = time.Now()
+	e.UpdatedAt = time.Now()
+	s.experiments = append(s.experiments, clone(e))
+	return e, nil
+}
+
+// GetByID retrieves an experiment by its ID, returning nil if it does not exist
+func (s *ExperimentStore) GetByID(ctx context.Context, id string) (*experiment.Experiment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, e := range s.experiments {
+		if e.ID == id {
+			return clone(e), nil
+		}
+	}
+	return nil, nil
+}
+
+// Update updates the name, hypothesis and conclusion of an existing experiment, leaving its variants alone
+func (s *ExperimentStore) Update(ctx context.Context, e *experiment.Experiment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e.UpdatedAt = time.Now()
+	for _, existing := range s.experiments {
+		if existing.ID == e.ID {
+			existing.Name = e.Name
+			existing.Hypothesis = e.Hypothesis
+			existing.Conclusion = e.Conclusion
+			existing.UpdatedAt = e.UpdatedAt
+		}
+	}
+	return nil
+}
+
+// AddVariant adds a variant unless its recipe is already part of the experiment
+func (s *ExperimentStore) AddVariant(ctx context.Context, id string, variant experiment.Variant) (*experiment.Experiment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.experiments {
+		if e.ID == id {
+			if err := e.AddVariant(*clone(&variant)); err != nil {
+				return nil, err
+			}
+			return clone(e), nil
+		}
+	}
+	return nil, experiment.ErrExperimentNotFound
+}
+
+// Delete removes an experiment by its ID
+func (s *ExperimentStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, e := range s.experiments {
+		if e.ID == id {
+			s.experiments = append(s.experiments[:i], s.experiments[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// List retrieves experiments with pagination
+func (s *ExperimentStore) List(ctx context.Context, limit, offset int64) ([]*experiment.Experiment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	experiments := make([]*experiment.Experiment, 0)
+	for _, e := range page(s.experiments, limit, offset) {
+		experiments = append(experiments, clone(e))
+	}
+	return experiments, nil
+}
@@ -0,0 +1,189 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// RecipeStore implements the mongo.RecipeStore interface in memory
+type RecipeStore struct {
+	mu      sync.RWMutex
+	recipes []*recipe.Recipe // In the order they were created
+}
+
+// NewRecipeStore creates a new, empty RecipeStore
+func NewRecipeStore() *RecipeStore {
+	return &RecipeStore{}
+}
+
+// Create stores a new recipe
+func (s *RecipeStore) Create(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if rcp.ID == "" {
+		rcp.ID = newID()
+	}
+	rcp.CreatedAt = time.Now()
+	rcp.UpdatedAt = time.Now()
+	s.recipes = append(s.recipes, clone(rcp))
+	return rcp, nil
+}
+
+// find returns the index of the recipe with the given ID, or -1
+func (s *RecipeStore) find(id string) int {
+	for i, r := range s.recipes {
+		if r.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetByID retrieves a recipe by its ID, returning nil if it does not exist or is in the trash
+func (s *RecipeStore) GetByID(ctx context.Context, id string) (*recipe.Recipe, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i := s.find(id)
+	if i < 0 || s.recipes[i].DeletedAt != nil {
+		return nil, nil
+	}
+	return clone(s.recipes[i]), nil
+}
+
+// Update replaces an existing recipe.
+// It returns recipe.ErrRecipeNotFound if the recipe does not exist or is in the trash.
+func (s *RecipeStore) Update(ctx context.Context, rcp *recipe.Recipe) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rcp.UpdatedAt = time.Now()
+	i := s.find(rcp.ID)
+	if i < 0 || s.recipes[i].DeletedAt != nil {
+		return recipe.ErrRecipeNotFound
+	}
+	s.recipes[i] = clone(rcp)
+	return nil
+}
+
+// Delete moves a recipe to the trash, recording when and by whom it was deleted.
+// It returns recipe.ErrRecipeNotFound if the recipe does not exist or is already in the trash.
+func (s *RecipeStore) Delete(ctx context.Context, id, deletedBy string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(id)
+	if i < 0 || s.recipes[i].DeletedAt != nil {
+		return recipe.ErrRecipeNotFound
+	}
+	now := time.Now()
+	s.recipes[i].DeletedAt = &now
+	s.recipes[i].DeletedBy = deletedBy
+	return nil
+}
+
+// Restore takes a recipe out of the trash.
+// It returns recipe.ErrRecipeNotFound if the recipe is not in the trash.
+func (s *RecipeStore) Restore(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(id)
+	if i < 0 || s.recipes[i].DeletedAt == nil {
+		return recipe.ErrRecipeNotFound
+	}
+	s.recipes[i].DeletedAt = nil
+	s.recipes[i].DeletedBy = ""
+	return nil
+}
+
+// Purge permanently removes the recipes that were moved to the trash before the given time, returning how many were removed
+func (s *RecipeStore) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kept := s.recipes[:0]
+	for _, r := range s.recipes {
+		if r.DeletedAt == nil || !r.DeletedAt.Before(deletedBefore) {
+			kept = append(kept, r)
+		}
+	}
+	purged := int64(len(s.recipes) - len(kept))
+	s.recipes = kept
+	return purged, nil
+}
+
+// List retrieves a page of the recipes matching the filter in the sort order, starting after the cursor, leaving out recipes in the trash
+func (s *RecipeStore) List(ctx context.Context, opts recipe.ListOptions) (*recipe.Page, error) {
+	order := opts.Sort
+	if order.Field == "" {
+		order = recipe.DefaultSort
+	}
+	recipes := s.matching(opts.Filter)
+	slices.SortFunc(recipes, order.Compare)
+	page := &recipe.Page{Recipes: recipes, Total: int64(len(recipes))}
+	if opts.After != nil {
+		after := opts.After.Recipe()
+		start, _ := slices.BinarySearchFunc(recipes, after, order.Compare)
+		if start < len(recipes) && order.Compare(recipes[start], after) == 0 {
+			start++
+		}
+		page.Recipes = recipes[start:]
+	}
+	if opts.Limit > 0 && int64(len(page.Recipes)) > opts.Limit {
+		page.Recipes = page.Recipes[:opts.Limit]
+		page.NextCursor = recipe.NewCursor(page.Recipes[opts.Limit-1], order).Encode()
+	}
+	return page, nil
+}
+
+// ListDeleted retrieves the recipes in the trash with pagination, most recently deleted first
+func (s *RecipeStore) ListDeleted(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error) {
+	s.mu.RLock()
+	var deleted []*recipe.Recipe
+	for _, r := range s.recipes {
+		if r.DeletedAt != nil {
+			deleted = append(deleted, clone(r))
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.SliceStable(deleted, func(i, j int) bool { return deleted[i].DeletedAt.After(*deleted[j].DeletedAt) })
+	return page(deleted, limit, offset), nil
+}
+
+// Each calls fn for every recipe matching the filter in the order they were created, leaving out recipes in the trash.
+// It stops at the first error returned by fn.
+func (s *RecipeStore) Each(ctx context.Context, filter recipe.Filter, fn func(*recipe.Recipe) error) error {
+	for _, r := range s.matching(filter) {
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count returns the total number of recipes, leaving out recipes in the trash
+func (s *RecipeStore) Count(ctx context.Context) (int64, error) {
+	return int64(len(s.matching(recipe.Filter{}))), nil
+}
+
+// matching returns copies of the recipes matching the filter that are not in the trash, in the order they were created
+func (s *RecipeStore) matching(filter recipe.Filter) []*recipe.Recipe {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	recipes := make([]*recipe.Recipe, 0)
+	for _, r := range s.recipes {
+		if r.DeletedAt == nil && filter.Matches(r) {
+			recipes = append(recipes, clone(r))
+		}
+	}
+	return recipes
+}
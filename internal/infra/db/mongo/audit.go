@@ -0,0 +1,62 @@
+package mongo
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+)
+
+// AuditEventDoc represents an audit event document in MongoDB
+type AuditEventDoc struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Action       string             `bson:"action"`
+	ResourceType string             `bson:"resource_type"`
+	ResourceID   string             `bson:"resource_id"`
+	Actor        string             `bson:"actor"`
+	RequestID    string             `bson:"request_id,omitempty"`
+	Timestamp    time.Time          `bson:"timestamp"`
+	Before       string             `bson:"before,omitempty"` // JSON snapshot, stored verbatim
+	After        string             `bson:"after,omitempty"`  // JSON snapshot, stored verbatim
+	Changes      []string           `bson:"changes,omitempty"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (e *AuditEventDoc) ToDomain() *audit.Event {
+	event := &audit.Event{
+		ID:           e.ID.Hex(),
+		Action:       audit.Action(e.Action),
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceID,
+		Actor:        e.Actor,
+		RequestID:    e.RequestID,
+		Timestamp:    e.Timestamp,
+		Changes:      e.Changes,
+	}
+	if e.Before != "" {
+		event.Before = json.RawMessage(e.Before)
+	}
+	if e.After != "" {
+		event.After = json.RawMessage(e.After)
+	}
+	return event
+}
+
+// ToMongoAuditEvent converts a domain model to a MongoDB document
+func ToMongoAuditEvent(e *audit.Event) *AuditEventDoc {
+	id, _ := primitive.ObjectIDFromHex(e.ID)
+	return &AuditEventDoc{
+		ID:           id,
+		Action:       string(e.Action),
+		ResourceType: e.ResourceType,
+		ResourceID:   e.ResourceID,
+		Actor:        e.Actor,
+		RequestID:    e.RequestID,
+		Timestamp:    e.Timestamp,
+		Before:       string(e.Before),
+		After:        string(e.After),
+		Changes:      e.Changes,
+	}
+}
@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+)
+
+// AuditStore defines the interface for audit log database operations.
+// The audit log is append-only, events can not be changed or removed.
+type AuditStore interface {
+	Append(ctx context.Context, event *audit.Event) error
+	List(ctx context.Context, filter audit.Filter, limit, offset int64) ([]*audit.Event, error)
+	Each(ctx context.Context, filter audit.Filter, fn func(*audit.Event) error) error
+}
+
+// MongoDBAuditStore implements the AuditStore interface using MongoDB
+type MongoDBAuditStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBAuditStore creates a new MongoDBAuditStore
+func NewMongoDBAuditStore(db *mongo.Database) *MongoDBAuditStore {
+	return &MongoDBAuditStore{
+		collection: db.Collection("audit_events"),
+	}
+}
+
+// Append inserts an event into the audit log
+func (s *MongoDBAuditStore) Append(ctx context.Context, event *audit.Event) error {
+	if event.ID == "" {
+		event.ID = primitive.NewObjectID().Hex()
+	}
+	_, err := s.collection.InsertOne(ctx, ToMongoAuditEvent(event))
+	return err
+}
+
+// List retrieves the events matching the filter with pagination, most recent first
+func (s *MongoDBAuditStore) List(ctx context.Context, filter audit.Filter, limit, offset int64) ([]*audit.Event, error) {
+	cursor, err := s.collection.Find(ctx, toMongoAuditFilter(filter),
+		options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*AuditEventDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	events := make([]*audit.Event, len(docs))
+	for i, doc := range docs {
+		events[i] = doc.ToDomain()
+	}
+
+	return events, nil
+}
+
+// Each calls fn for every event matching the filter in chronological order, without loading them all in memory.
+// It stops at the first error returned by fn.
+func (s *MongoDBAuditStore) Each(ctx context.Context, filter audit.Filter, fn func(*audit.Event) error) error {
+	cursor, err := s.collection.Find(ctx, toMongoAuditFilter(filter),
+		options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc AuditEventDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if err := fn(doc.ToDomain()); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// toMongoAuditFilter converts an audit.Filter to a MongoDB query
+func toMongoAuditFilter(filter audit.Filter) bson.M {
+	query := bson.M{}
+	if filter.ResourceType != "" {
+		query["resource_type"] = filter.ResourceType
+	}
+	if filter.ResourceID != "" {
+		query["resource_id"] = filter.ResourceID
+	}
+	if filter.Actor != "" {
+		query["actor"] = filter.Actor
+	}
+	if filter.Action != "" {
+		query["action"] = string(filter.Action)
+	}
+	if filter.RequestID != "" {
+		query["request_id"] = filter.RequestID
+	}
+	timestamp := bson.M{}
+	if !filter.From.IsZero() {
+		timestamp["$gte"] = filter.From
+	}
+	if !filter.To.IsZero() {
+		timestamp["$lt"] = filter.To
+	}
+	if len(timestamp) > 0 {
+		query["timestamp"] = timestamp
+	}
+	return query
+}
@@ -0,0 +1,56 @@
+package mongo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+)
+
+func TestAuditEventConversion(t *testing.T) {
+	event := &audit.Event{
+		ID:           primitive.NewObjectID().Hex(),
+		Action:       audit.Update,
+		ResourceType: audit.RecipeResource,
+		ResourceID:   "abc",
+		Actor:        "alice",
+		RequestID:    "req-1",
+		Timestamp:    time.Now().Truncate(time.Millisecond),
+		Before:       json.RawMessage(`{"Name":"Dark"}`),
+		After:        json.RawMessage(`{"Name":"Darker"}`),
+		Changes:      []string{"Name"},
+	}
+
+	back := ToMongoAuditEvent(event).ToDomain()
+	if back.ID != event.ID || back.Action != event.Action || back.Actor != event.Actor || back.RequestID != event.RequestID {
+		t.Errorf("Expected event to round trip, got %+v", back)
+	}
+	if string(back.Before) != string(event.Before) || string(back.After) != string(event.After) {
+		t.Errorf("Expected snapshots to round trip, got %s and %s", back.Before, back.After)
+	}
+
+	created := ToMongoAuditEvent(&audit.Event{Action: audit.Create, After: event.After}).ToDomain()
+	if created.Before != nil {
+		t.Errorf("Expected no before snapshot for a creation, got %s", created.Before)
+	}
+}
+
+func TestToMongoAuditFilter(t *testing.T) {
+	if query := toMongoAuditFilter(audit.Filter{}); len(query) != 0 {
+		t.Errorf("Expected empty query for empty filter, got %v", query)
+	}
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := toMongoAuditFilter(audit.Filter{Actor: "alice", Action: audit.Approve, From: from})
+	if query["actor"] != "alice" || query["action"] != "approve" {
+		t.Errorf("Expected actor and action criteria, got %v", query)
+	}
+	timestamp, ok := query["timestamp"].(bson.M)
+	if !ok || timestamp["$gte"] != from || timestamp["$lt"] != nil {
+		t.Errorf("Expected timestamp from %v, got %v", from, query["timestamp"])
+	}
+}
@@ -0,0 +1,53 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/production"
+)
+
+// BatchDoc represents a production batch document in MongoDB
+type BatchDoc struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	RecipeID   string             `bson:"recipe_id"`
+	Recipe     *RecipeDoc         `bson:"recipe"` // The recipe scaled to the yield of the batch
+	BeanLotIDs []string           `bson:"bean_lot_ids,omitempty"`
+	Notes      string             `bson:"notes,omitempty"`
+	CreatedAt  time.Time          `bson:"created_at"`
+	CreatedBy  string             `bson:"created_by"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (b *BatchDoc) ToDomain() *production.Batch {
+	batch := &production.Batch{
+		ID:         b.ID.Hex(),
+		RecipeID:   b.RecipeID,
+		BeanLotIDs: b.BeanLotIDs,
+		Notes:      b.Notes,
+		CreatedAt:  b.CreatedAt,
+		CreatedBy:  b.CreatedBy,
+	}
+	if b.Recipe != nil {
+		batch.Recipe = b.Recipe.ToDomain()
+	}
+	return batch
+}
+
+// ToMongoBatch converts a domain model to a MongoDB document
+func ToMongoBatch(b *production.Batch) *BatchDoc {
+	id, _ := primitive.ObjectIDFromHex(b.ID)
+	doc := &BatchDoc{
+		ID:         id,
+		RecipeID:   b.RecipeID,
+		BeanLotIDs: b.BeanLotIDs,
+		Notes:      b.Notes,
+		CreatedAt:  b.CreatedAt,
+		CreatedBy:  b.CreatedBy,
+	}
+	if b.Recipe != nil {
+		doc.Recipe = ToMongo(b.Recipe)
+	}
+	return doc
+}
@@ -0,0 +1,86 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/production"
+)
+
+// BatchStore defines the interface for production batch database operations
+type BatchStore interface {
+	Create(ctx context.Context, batch *production.Batch) (*production.Batch, error)
+	GetByID(ctx context.Context, id string) (*production.Batch, error)
+	List(ctx context.Context, limit, offset int64) ([]*production.Batch, error)
+}
+
+// MongoDBBatchStore implements the BatchStore interface using MongoDB
+type MongoDBBatchStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBBatchStore creates a new MongoDBBatchStore
+func NewMongoDBBatchStore(db *mongo.Database) *MongoDBBatchStore {
+	return &MongoDBBatchStore{
+		collection: db.Collection("batches"),
+	}
+}
+
+// Create inserts a new batch into the database
+func (s *MongoDBBatchStore) Create(ctx context.Context, batch *production.Batch) (*production.Batch, error) {
+	if batch.ID == "" {
+		batch.ID = primitive.NewObjectID().Hex()
+	}
+	batch.CreatedAt = time.Now()
+
+	_, err := s.collection.InsertOne(ctx, ToMongoBatch(batch))
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// GetByID retrieves a batch by its ID
+func (s *MongoDBBatchStore) GetByID(ctx context.Context, id string) (*production.Batch, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc BatchDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// List retrieves batches with pagination, most recent first
+func (s *MongoDBBatchStore) List(ctx context.Context, limit, offset int64) ([]*production.Batch, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{},
+		options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*BatchDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	batches := make([]*production.Batch, len(docs))
+	for i, doc := range docs {
+		batches[i] = doc.ToDomain()
+	}
+
+	return batches, nil
+}
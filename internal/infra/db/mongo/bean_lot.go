@@ -0,0 +1,107 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+)
+
+// BeanLotDoc represents a bean lot document in MongoDB
+type BeanLotDoc struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	LotNumber   string             `bson:"lot_number"`
+	CacaoID     string             `bson:"cacao_id,omitempty"`
+	Weight      float64            `bson:"weight"`
+	ReceivedAt  time.Time          `bson:"received_at"`
+	Inspections []InspectionDoc    `bson:"inspections"`
+	Grade       string             `bson:"grade"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+// InspectionDoc represents a quality inspection document in MongoDB
+type InspectionDoc struct {
+	SampleSize    int       `bson:"sample_size"`
+	FullyBrown    int       `bson:"fully_brown"`
+	Purple        int       `bson:"purple"`
+	Slaty         int       `bson:"slaty"`
+	Moldy         int       `bson:"moldy"`
+	InsectDamaged int       `bson:"insect_damaged"`
+	Moisture      float64   `bson:"moisture"`
+	Grade         string    `bson:"grade"`
+	Notes         string    `bson:"notes,omitempty"`
+	InspectedAt   time.Time `bson:"inspected_at"`
+	InspectedBy   string    `bson:"inspected_by"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (l *BeanLotDoc) ToDomain() *quality.BeanLot {
+	inspections := make([]quality.Inspection, len(l.Inspections))
+	for i, doc := range l.Inspections {
+		inspections[i] = quality.Inspection{
+			CutTest: quality.CutTest{
+				SampleSize:    doc.SampleSize,
+				FullyBrown:    doc.FullyBrown,
+				Purple:        doc.Purple,
+				Slaty:         doc.Slaty,
+				Moldy:         doc.Moldy,
+				InsectDamaged: doc.InsectDamaged,
+			},
+			Moisture:    doc.Moisture,
+			Grade:       quality.Grade(doc.Grade),
+			Notes:       doc.Notes,
+			InspectedAt: doc.InspectedAt,
+			InspectedBy: doc.InspectedBy,
+		}
+	}
+	return &quality.BeanLot{
+		ID:          l.ID.Hex(),
+		LotNumber:   l.LotNumber,
+		CacaoID:     l.CacaoID,
+		Weight:      l.Weight,
+		ReceivedAt:  l.ReceivedAt,
+		Inspections: inspections,
+		Grade:       quality.Grade(l.Grade),
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+// ToMongoBeanLot converts a domain model to a MongoDB document
+func ToMongoBeanLot(l *quality.BeanLot) *BeanLotDoc {
+	id, _ := primitive.ObjectIDFromHex(l.ID)
+	inspections := make([]InspectionDoc, len(l.Inspections))
+	for i, inspection := range l.Inspections {
+		inspections[i] = toMongoInspection(inspection)
+	}
+	return &BeanLotDoc{
+		ID:          id,
+		LotNumber:   l.LotNumber,
+		CacaoID:     l.CacaoID,
+		Weight:      l.Weight,
+		ReceivedAt:  l.ReceivedAt,
+		Inspections: inspections,
+		Grade:       string(l.Grade),
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+// toMongoInspection converts an inspection to its MongoDB document
+func toMongoInspection(inspection quality.Inspection) InspectionDoc {
+	return InspectionDoc{
+		SampleSize:    inspection.CutTest.SampleSize,
+		FullyBrown:    inspection.CutTest.FullyBrown,
+		Purple:        inspection.CutTest.Purple,
+		Slaty:         inspection.CutTest.Slaty,
+		Moldy:         inspection.CutTest.Moldy,
+		InsectDamaged: inspection.CutTest.InsectDamaged,
+		Moisture:      inspection.Moisture,
+		Grade:         string(inspection.Grade),
+		Notes:         inspection.Notes,
+		InspectedAt:   inspection.InspectedAt,
+		InspectedBy:   inspection.InspectedBy,
+	}
+}
@@ -0,0 +1,115 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+)
+
+// BeanLotStore defines the interface for bean lot database operations
+type BeanLotStore interface {
+	Create(ctx context.Context, lot *quality.BeanLot) (*quality.BeanLot, error)
+	GetByID(ctx context.Context, id string) (*quality.BeanLot, error)
+	// AddInspection records the inspection and the grade it gives the lot in one atomic update,
+	// returning quality.ErrBeanLotNotFound if the lot does not exist
+	AddInspection(ctx context.Context, id string, inspection quality.Inspection) (*quality.BeanLot, error)
+	List(ctx context.Context, limit, offset int64) ([]*quality.BeanLot, error)
+}
+
+// MongoDBBeanLotStore implements the BeanLotStore interface using MongoDB
+type MongoDBBeanLotStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBBeanLotStore creates a new MongoDBBeanLotStore
+func NewMongoDBBeanLotStore(db *mongo.Database) *MongoDBBeanLotStore {
+	return &MongoDBBeanLotStore{
+		collection: db.Collection("bean_lots"),
+	}
+}
+
+// Create inserts a new bean lot into the database
+func (s *MongoDBBeanLotStore) Create(ctx context.Context, lot *quality.BeanLot) (*quality.BeanLot, error) {
+	if lot.ID == "" {
+		lot.ID = primitive.NewObjectID().Hex()
+	}
+	lot.CreatedAt = time.Now()
+	lot.UpdatedAt = time.Now()
+
+	_, err := s.collection.InsertOne(ctx, ToMongoBeanLot(lot))
+	if err != nil {
+		return nil, err
+	}
+
+	return lot, nil
+}
+
+// GetByID retrieves a bean lot by its ID
+func (s *MongoDBBeanLotStore) GetByID(ctx context.Context, id string) (*quality.BeanLot, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc BeanLotDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// AddInspection appends the inspection to the lot and grades the lot with it.
+// The inspection is pushed rather than the lot replaced, so concurrent inspections are not lost.
+func (s *MongoDBBeanLotStore) AddInspection(ctx context.Context, id string, inspection quality.Inspection) (*quality.BeanLot, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, quality.ErrBeanLotNotFound
+	}
+
+	update := bson.M{
+		"$push": bson.M{"inspections": toMongoInspection(inspection)},
+		"$set":  bson.M{"grade": string(inspection.Grade), "updated_at": time.Now()},
+	}
+	var doc BeanLotDoc
+	err = s.collection.FindOneAndUpdate(ctx, bson.M{"_id": oid}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return nil, quality.ErrBeanLotNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// List retrieves bean lots with pagination, most recently received first
+func (s *MongoDBBeanLotStore) List(ctx context.Context, limit, offset int64) ([]*quality.BeanLot, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{},
+		options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "received_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*BeanLotDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	lots := make([]*quality.BeanLot, len(docs))
+	for i, doc := range docs {
+		lots[i] = doc.ToDomain()
+	}
+
+	return lots, nil
+}
@@ -0,0 +1,93 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// CacaoDoc represents a cacao catalog document in MongoDB
+type CacaoDoc struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Name      string             `bson:"name"`
+	Origin    OriginDoc          `bson:"origin"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+// OriginDoc represents the origin of cacao in MongoDB
+type OriginDoc struct {
+	Country        string   `bson:"country"`
+	Region         string   `bson:"region,omitempty"`
+	Producer       string   `bson:"producer,omitempty"`
+	Variety        string   `bson:"variety,omitempty"`
+	HarvestYear    int      `bson:"harvest_year,omitempty"`
+	Fermentation   string   `bson:"fermentation,omitempty"`
+	Drying         string   `bson:"drying,omitempty"`
+	Certifications []string `bson:"certifications,omitempty"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (c *CacaoDoc) ToDomain() *recipe.Cacao {
+	return &recipe.Cacao{
+		ID:        c.ID.Hex(),
+		Name:      c.Name,
+		Origin:    *toDomainOrigin(&c.Origin),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// ToMongoCacao converts a domain model to a MongoDB document
+func ToMongoCacao(c *recipe.Cacao) *CacaoDoc {
+	id, _ := primitive.ObjectIDFromHex(c.ID)
+	return &CacaoDoc{
+		ID:        id,
+		Name:      c.Name,
+		Origin:    *toMongoOrigin(&c.Origin),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+func toDomainOrigin(doc *OriginDoc) *recipe.Origin {
+	if doc == nil {
+		return nil
+	}
+	var certifications []recipe.Certification
+	for _, c := range doc.Certifications {
+		certifications = append(certifications, recipe.Certification(c))
+	}
+	return &recipe.Origin{
+		Country:        doc.Country,
+		Region:         doc.Region,
+		Producer:       doc.Producer,
+		Variety:        doc.Variety,
+		HarvestYear:    doc.HarvestYear,
+		Fermentation:   doc.Fermentation,
+		Drying:         doc.Drying,
+		Certifications: certifications,
+	}
+}
+
+func toMongoOrigin(o *recipe.Origin) *OriginDoc {
+	if o == nil {
+		return nil
+	}
+	var certifications []string
+	for _, c := range o.Certifications {
+		certifications = append(certifications, string(c))
+	}
+	return &OriginDoc{
+		Country:        o.Country,
+		Region:         o.Region,
+		Producer:       o.Producer,
+		Variety:        o.Variety,
+		HarvestYear:    o.HarvestYear,
+		Fermentation:   o.Fermentation,
+		Drying:         o.Drying,
+		Certifications: certifications,
+	}
+}
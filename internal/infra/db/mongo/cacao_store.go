@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// CacaoStore defines the interface for cacao catalog database operations
+type CacaoStore interface {
+	Create(ctx context.Context, cacao *recipe.Cacao) (*recipe.Cacao, error)
+	GetByID(ctx context.Context, id string) (*recipe.Cacao, error)
+	Update(ctx context.Context, cacao *recipe.Cacao) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, limit, offset int64) ([]*recipe.Cacao, error)
+}
+
+// MongoDBCacaoStore implements the CacaoStore interface using MongoDB
+type MongoDBCacaoStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBCacaoStore creates a new MongoDBCacaoStore
+func NewMongoDBCacaoStore(db *mongo.Database) *MongoDBCacaoStore {
+	return &MongoDBCacaoStore{
+		collection: db.Collection("cacao"),
+	}
+}
+
+// Create inserts a new cacao catalog entry into the database
+func (s *MongoDBCacaoStore) Create(ctx context.Context, cacao *recipe.Cacao) (*recipe.Cacao, error) {
+	if cacao.ID == "" {
+		cacao.ID = primitive.NewObjectID().Hex()
+	}
+	cacao.CreatedAt = time.Now()
+	cacao.UpdatedAt = time.Now()
+
+	_, err := s.collection.InsertOne(ctx, ToMongoCacao(cacao))
+	if err != nil {
+		return nil, err
+	}
+
+	return cacao, nil
+}
+
+// GetByID retrieves a cacao catalog entry by its ID, returning nil if it does not exist.
+// An ID that is not an ObjectID can not exist, so it is not found either.
+func (s *MongoDBCacaoStore) GetByID(ctx context.Context, id string) (*recipe.Cacao, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var doc CacaoDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// Update updates an existing cacao catalog entry
+func (s *MongoDBCacaoStore) Update(ctx context.Context, cacao *recipe.Cacao) error {
+	oid, err := primitive.ObjectIDFromHex(cacao.ID)
+	if err != nil {
+		return err
+	}
+
+	cacao.UpdatedAt = time.Now()
+
+	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": oid}, ToMongoCacao(cacao))
+	return err
+}
+
+// Delete removes a cacao catalog entry by its ID
+func (s *MongoDBCacaoStore) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	return err
+}
+
+// List retrieves cacao catalog entries with pagination
+func (s *MongoDBCacaoStore) List(ctx context.Context, limit, offset int64) ([]*recipe.Cacao, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{},
+		options.Find().SetLimit(limit).SetSkip(offset))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*CacaoDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*recipe.Cacao, len(docs))
+	for i, doc := range docs {
+		entries[i] = doc.ToDomain()
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,168 @@
+package mongo
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// OutboxEventDoc represents a domain event document in the MongoDB outbox
+type OutboxEventDoc struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Seq          int64              `bson:"seq,omitempty"` // Position in the order the events were committed in
+	Type         string             `bson:"type"`
+	ResourceID   string             `bson:"resource_id"`
+	RequestID    string             `bson:"request_id,omitempty"`
+	OccurredAt   time.Time          `bson:"occurred_at"`
+	Data         string             `bson:"data,omitempty"`          // JSON snapshot, stored verbatim
+	DispatchedAt *time.Time         `bson:"dispatched_at,omitempty"` // Set once deliveries to the webhooks have been scheduled
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (e *OutboxEventDoc) ToDomain() *event.Event {
+	evt := &event.Event{
+		ID:         e.ID.Hex(),
+		Type:       event.Type(e.Type),
+		ResourceID: e.ResourceID,
+		RequestID:  e.RequestID,
+		OccurredAt: e.OccurredAt,
+	}
+	if e.Data != "" {
+		evt.Data = json.RawMessage(e.Data)
+	}
+	return evt
+}
+
+// ToMongoEvent converts a domain model to a MongoDB document
+func ToMongoEvent(e *event.Event) *OutboxEventDoc {
+	id, _ := primitive.ObjectIDFromHex(e.ID)
+	return &OutboxEventDoc{
+		ID:         id,
+		Type:       string(e.Type),
+		ResourceID: e.ResourceID,
+		RequestID:  e.RequestID,
+		OccurredAt: e.OccurredAt,
+		Data:       string(e.Data),
+	}
+}
+
+// WebhookDoc represents a webhook document in MongoDB
+type WebhookDoc struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	URL         string             `bson:"url"`
+	Secret      string             `bson:"secret"`
+	EventTypes  []string           `bson:"event_types,omitempty"`
+	Description string             `bson:"description,omitempty"`
+	Active      bool               `bson:"active"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	UpdatedAt   time.Time          `bson:"updated_at"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (w *WebhookDoc) ToDomain() *event.Webhook {
+	var types []event.Type
+	for _, t := range w.EventTypes {
+		types = append(types, event.Type(t))
+	}
+	return &event.Webhook{
+		ID:          w.ID.Hex(),
+		URL:         w.URL,
+		Secret:      w.Secret,
+		EventTypes:  types,
+		Description: w.Description,
+		Active:      w.Active,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+// ToMongoWebhook converts a domain model to a MongoDB document
+func ToMongoWebhook(w *event.Webhook) *WebhookDoc {
+	id, _ := primitive.ObjectIDFromHex(w.ID)
+	var types []string
+	for _, t := range w.EventTypes {
+		types = append(types, string(t))
+	}
+	return &WebhookDoc{
+		ID:          id,
+		URL:         w.URL,
+		Secret:      w.Secret,
+		EventTypes:  types,
+		Description: w.Description,
+		Active:      w.Active,
+		CreatedAt:   w.CreatedAt,
+		UpdatedAt:   w.UpdatedAt,
+	}
+}
+
+// DeliveryDoc represents a webhook delivery document in MongoDB
+type DeliveryDoc struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	WebhookID     string             `bson:"webhook_id"`
+	Event         *OutboxEventDoc    `bson:"event"`
+	Status        string             `bson:"status"`
+	Attempts      []AttemptDoc       `bson:"attempts,omitempty"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at"`
+	CreatedAt     time.Time          `bson:"created_at"`
+	UpdatedAt     time.Time          `bson:"updated_at"`
+}
+
+// AttemptDoc represents a delivery attempt document in MongoDB
+type AttemptDoc struct {
+	At         time.Time     `bson:"at"`
+	StatusCode int           `bson:"status_code,omitempty"`
+	Error      string        `bson:"error,omitempty"`
+	Duration   time.Duration `bson:"duration"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (d *DeliveryDoc) ToDomain() *event.Delivery {
+	delivery := &event.Delivery{
+		ID:            d.ID.Hex(),
+		WebhookID:     d.WebhookID,
+		Status:        event.DeliveryStatus(d.Status),
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+	if d.Event != nil {
+		delivery.Event = d.Event.ToDomain()
+	}
+	for _, a := range d.Attempts {
+		delivery.Attempts = append(delivery.Attempts, event.Attempt{
+			At:         a.At,
+			StatusCode: a.StatusCode,
+			Error:      a.Error,
+			Duration:   a.Duration,
+		})
+	}
+	return delivery
+}
+
+// ToMongoDelivery converts a domain model to a MongoDB document
+func ToMongoDelivery(d *event.Delivery) *DeliveryDoc {
+	id, _ := primitive.ObjectIDFromHex(d.ID)
+	doc := &DeliveryDoc{
+		ID:            id,
+		WebhookID:     d.WebhookID,
+		Status:        string(d.Status),
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+	if d.Event != nil {
+		doc.Event = ToMongoEvent(d.Event)
+	}
+	for _, a := range d.Attempts {
+		doc.Attempts = append(doc.Attempts, AttemptDoc{
+			At:         a.At,
+			StatusCode: a.StatusCode,
+			Error:      a.Error,
+			Duration:   a.Duration,
+		})
+	}
+	return doc
+}
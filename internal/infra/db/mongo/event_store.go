@@ -0,0 +1,325 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// OutboxStore defines the interface for the transactional outbox of domain events.
+// Events are appended in the same transaction as the change they describe, and dispatched afterwards.
+type OutboxStore interface {
+	Append(ctx context.Context, e *event.Event) error
+	Undispatched(ctx context.Context, limit int64) ([]*event.Event, error)
+	MarkDispatched(ctx context.Context, id string) error
+	After(ctx context.Context, id string, limit int64) ([]*event.Event, error)
+	LatestID(ctx context.Context) (string, error)
+}
+
+// MongoDBOutboxStore implements the OutboxStore interface using MongoDB.
+// Each event is numbered from a counter incremented in the transaction that appends it. Concurrent transactions
+// conflict on the counter, so one waits for the other to commit and the numbers follow the order the events were
+// committed in, which readers rely on to page through the outbox without missing events committed late.
+type MongoDBOutboxStore struct {
+	collection *mongo.Collection
+	counters   *mongo.Collection
+}
+
+// outboxCounter is the ID of the counter document numbering the outbox events
+const outboxCounter = "outbox"
+
+// NewMongoDBOutboxStore creates a new MongoDBOutboxStore
+func NewMongoDBOutboxStore(db *mongo.Database) *MongoDBOutboxStore {
+	return &MongoDBOutboxStore{
+		collection: db.Collection("outbox"),
+		counters:   db.Collection("counters"),
+	}
+}
+
+// EnsureIndexes creates the index the outbox is paged through and the counter numbering its events,
+// so the first append does not have to create a collection in a transaction. Doing so again does nothing.
+func (s *MongoDBOutboxStore) EnsureIndexes(ctx context.Context) error {
+	_, err := s.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "seq", Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"seq": bson.M{"$exists": true}}),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.counters.UpdateOne(ctx, bson.M{"_id": outboxCounter},
+		bson.M{"$setOnInsert": bson.M{"seq": int64(0)}}, options.Update().SetUpsert(true))
+	return err
+}
+
+// Append inserts an event into the outbox, numbering it after the events committed before it
+func (s *MongoDBOutboxStore) Append(ctx context.Context, e *event.Event) error {
+	var counter struct {
+		Seq int64 `bson:"seq"`
+	}
+	err := s.counters.FindOneAndUpdate(ctx, bson.M{"_id": outboxCounter}, bson.M{"$inc": bson.M{"seq": int64(1)}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).Decode(&counter)
+	if err != nil {
+		return err
+	}
+
+	if e.ID == "" {
+		e.ID = primitive.NewObjectID().Hex()
+	}
+	doc := ToMongoEvent(e)
+	doc.Seq = counter.Seq
+	_, err = s.collection.InsertOne(ctx, doc)
+	return err
+}
+
+// Undispatched retrieves the events that have not been dispatched yet, oldest first
+func (s *MongoDBOutboxStore) Undispatched(ctx context.Context, limit int64) ([]*event.Event, error) {
+	return s.find(ctx, bson.M{"dispatched_at": bson.M{"$exists": false}},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit))
+}
+
+// MarkDispatched records that deliveries of the event have been scheduled
+func (s *MongoDBOutboxStore) MarkDispatched(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+	_, err = s.collection.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{"dispatched_at": time.Now()}})
+	return err
+}
+
+// bySeq sorts events in the order they were committed. Events appended before they were numbered sort first, by ID.
+var bySeq = bson.D{{Key: "seq", Value: 1}, {Key: "_id", Value: 1}}
+
+// After retrieves the events committed after the event with the given ID, oldest first, or from the first event
+// if the ID is empty. It returns event.ErrEventNotFound if there is no event with the ID.
+func (s *MongoDBOutboxStore) After(ctx context.Context, id string, limit int64) ([]*event.Event, error) {
+	opts := options.Find().SetSort(bySeq).SetLimit(limit)
+	if id == "" {
+		return s.find(ctx, bson.M{}, opts)
+	}
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, event.ErrEventNotFound
+	}
+	var cursor OutboxEventDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&cursor)
+	if err == mongo.ErrNoDocuments {
+		return nil, event.ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.Seq == 0 {
+		// Appended before events were numbered
+		return s.find(ctx, bson.M{"$or": bson.A{bson.M{"seq": bson.M{"$exists": true}}, bson.M{"_id": bson.M{"$gt": oid}}}}, opts)
+	}
+	return s.find(ctx, bson.M{"seq": bson.M{"$gt": cursor.Seq}}, opts)
+}
+
+// LatestID returns the ID of the most recently committed event, or an empty ID if the outbox is empty
+func (s *MongoDBOutboxStore) LatestID(ctx context.Context) (string, error) {
+	var doc OutboxEventDoc
+	err := s.collection.FindOne(ctx, bson.M{}, options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}, {Key: "_id", Value: -1}})).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return doc.ID.Hex(), nil
+}
+
+func (s *MongoDBOutboxStore) find(ctx context.Context, query bson.M, opts *options.FindOptions) ([]*event.Event, error) {
+	cursor, err := s.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*OutboxEventDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	events := make([]*event.Event, len(docs))
+	for i, doc := range docs {
+		events[i] = doc.ToDomain()
+	}
+
+	return events, nil
+}
+
+// WebhookStore defines the interface for webhook database operations
+type WebhookStore interface {
+	Create(ctx context.Context, w *event.Webhook) (*event.Webhook, error)
+	GetByID(ctx context.Context, id string) (*event.Webhook, error)
+	Update(ctx context.Context, w *event.Webhook) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*event.Webhook, error)
+}
+
+// MongoDBWebhookStore implements the WebhookStore interface using MongoDB
+type MongoDBWebhookStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBWebhookStore creates a new MongoDBWebhookStore
+func NewMongoDBWebhookStore(db *mongo.Database) *MongoDBWebhookStore {
+	return &MongoDBWebhookStore{
+		collection: db.Collection("webhooks"),
+	}
+}
+
+// Create inserts a new webhook into the database
+func (s *MongoDBWebhookStore) Create(ctx context.Context, w *event.Webhook) (*event.Webhook, error) {
+	if w.ID == "" {
+		w.ID = primitive.NewObjectID().Hex()
+	}
+	w.CreatedAt = time.Now()
+	w.UpdatedAt = w.CreatedAt
+
+	if _, err := s.collection.InsertOne(ctx, ToMongoWebhook(w)); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// GetByID retrieves a webhook by its ID, returning nil if it does not exist.
+// An ID that is not an ObjectID can not exist, so it is not found either.
+func (s *MongoDBWebhookStore) GetByID(ctx context.Context, id string) (*event.Webhook, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var doc WebhookDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// Update updates an existing webhook
+func (s *MongoDBWebhookStore) Update(ctx context.Context, w *event.Webhook) error {
+	oid, err := primitive.ObjectIDFromHex(w.ID)
+	if err != nil {
+		return err
+	}
+
+	w.UpdatedAt = time.Now()
+	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": oid}, ToMongoWebhook(w))
+	return err
+}
+
+// Delete removes a webhook by its ID
+func (s *MongoDBWebhookStore) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	return err
+}
+
+// List retrieves all webhooks, oldest first
+func (s *MongoDBWebhookStore) List(ctx context.Context) ([]*event.Webhook, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*WebhookDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*event.Webhook, len(docs))
+	for i, doc := range docs {
+		webhooks[i] = doc.ToDomain()
+	}
+
+	return webhooks, nil
+}
+
+// DeliveryStore defines the interface for the webhook delivery log
+type DeliveryStore interface {
+	Create(ctx context.Context, d *event.Delivery) (*event.Delivery, error)
+	Update(ctx context.Context, d *event.Delivery) error
+	Due(ctx context.Context, now time.Time, limit int64) ([]*event.Delivery, error)
+	List(ctx context.Context, webhookID string, limit, offset int64) ([]*event.Delivery, error)
+}
+
+// MongoDBDeliveryStore implements the DeliveryStore interface using MongoDB
+type MongoDBDeliveryStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBDeliveryStore creates a new MongoDBDeliveryStore
+func NewMongoDBDeliveryStore(db *mongo.Database) *MongoDBDeliveryStore {
+	return &MongoDBDeliveryStore{
+		collection: db.Collection("webhook_deliveries"),
+	}
+}
+
+// Create inserts a new delivery into the database
+func (s *MongoDBDeliveryStore) Create(ctx context.Context, d *event.Delivery) (*event.Delivery, error) {
+	if d.ID == "" {
+		d.ID = primitive.NewObjectID().Hex()
+	}
+	if _, err := s.collection.InsertOne(ctx, ToMongoDelivery(d)); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Update updates an existing delivery
+func (s *MongoDBDeliveryStore) Update(ctx context.Context, d *event.Delivery) error {
+	oid, err := primitive.ObjectIDFromHex(d.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": oid}, ToMongoDelivery(d))
+	return err
+}
+
+// Due retrieves the pending deliveries whose next attempt is due, in the order they were created
+func (s *MongoDBDeliveryStore) Due(ctx context.Context, now time.Time, limit int64) ([]*event.Delivery, error) {
+	return s.find(ctx, bson.M{"status": string(event.Pending), "next_attempt_at": bson.M{"$lte": now}},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit))
+}
+
+// List retrieves the deliveries to a webhook with pagination, most recent first
+func (s *MongoDBDeliveryStore) List(ctx context.Context, webhookID string, limit, offset int64) ([]*event.Delivery, error) {
+	return s.find(ctx, bson.M{"webhook_id": webhookID},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: -1}}).SetLimit(limit).SetSkip(offset))
+}
+
+func (s *MongoDBDeliveryStore) find(ctx context.Context, query bson.M, opts *options.FindOptions) ([]*event.Delivery, error) {
+	cursor, err := s.collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*DeliveryDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*event.Delivery, len(docs))
+	for i, doc := range docs {
+		deliveries[i] = doc.ToDomain()
+	}
+
+	return deliveries, nil
+}
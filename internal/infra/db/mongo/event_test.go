@@ -0,0 +1,52 @@
+package mongo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+func TestWebhookConversion(t *testing.T) {
+	w := &event.Webhook{
+		ID:         primitive.NewObjectID().Hex(),
+		URL:        "https://erp.example.com/hooks",
+		Secret:     "0123456789abcdef",
+		EventTypes: []event.Type{event.RecipeCreated, event.RecipeDeleted},
+		Active:     true,
+	}
+
+	back := ToMongoWebhook(w).ToDomain()
+	if !reflect.DeepEqual(back, w) {
+		t.Errorf("Expected webhook to round trip, got %+v", back)
+	}
+}
+
+func TestDeliveryConversion(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	d := &event.Delivery{
+		ID:        primitive.NewObjectID().Hex(),
+		WebhookID: "w1",
+		Event: &event.Event{
+			ID:         primitive.NewObjectID().Hex(),
+			Type:       event.RecipeUpdated,
+			ResourceID: "r1",
+			OccurredAt: now,
+			Data:       json.RawMessage(`{"Name":"Dark"}`),
+		},
+		Status:        event.Pending,
+		Attempts:      []event.Attempt{{At: now, StatusCode: 503, Duration: time.Second}},
+		NextAttemptAt: now.Add(time.Minute),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	back := ToMongoDelivery(d).ToDomain()
+	if !reflect.DeepEqual(back, d) {
+		t.Errorf("Expected delivery to round trip, got %+v", back)
+	}
+}
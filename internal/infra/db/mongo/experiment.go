@@ -0,0 +1,81 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+)
+
+// ExperimentDoc represents an experiment document in MongoDB
+type ExperimentDoc struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Name         string             `bson:"name"`
+	BaseRecipeID string             `bson:"base_recipe_id"`
+	Hypothesis   string             `bson:"hypothesis"`
+	Variants     []VariantDoc       `bson:"variants"`
+	Conclusion   string             `bson:"conclusion,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+}
+
+// VariantDoc represents a variant of an experiment in MongoDB
+type VariantDoc struct {
+	RecipeID          string            `bson:"recipe_id"`
+	Label             string            `bson:"label"`
+	ChangedParameters map[string]string `bson:"changed_parameters,omitempty"`
+	Notes             string            `bson:"notes,omitempty"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (e *ExperimentDoc) ToDomain() *experiment.Experiment {
+	variants := make([]experiment.Variant, len(e.Variants))
+	for i, doc := range e.Variants {
+		variants[i] = experiment.Variant{
+			RecipeID:          doc.RecipeID,
+			Label:             doc.Label,
+			ChangedParameters: doc.ChangedParameters,
+			Notes:             doc.Notes,
+		}
+	}
+	return &experiment.Experiment{
+		ID:           e.ID.Hex(),
+		Name:         e.Name,
+		BaseRecipeID: e.BaseRecipeID,
+		Hypothesis:   e.Hypothesis,
+		Variants:     variants,
+		Conclusion:   e.Conclusion,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+}
+
+// ToMongoExperiment converts a domain model to a MongoDB document
+func ToMongoExperiment(e *experiment.Experiment) *ExperimentDoc {
+	id, _ := primitive.ObjectIDFromHex(e.ID)
+	variants := make([]VariantDoc, len(e.Variants))
+	for i, v := range e.Variants {
+		variants[i] = toMongoVariant(v)
+	}
+	return &ExperimentDoc{
+		ID:           id,
+		Name:         e.Name,
+		BaseRecipeID: e.BaseRecipeID,
+		Hypothesis:   e.Hypothesis,
+		Variants:     variants,
+		Conclusion:   e.Conclusion,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+}
+
+// toMongoVariant converts a variant of an experiment to a MongoDB document
+func toMongoVariant(v experiment.Variant) VariantDoc {
+	return VariantDoc{
+		RecipeID:          v.RecipeID,
+		Label:             v.Label,
+		ChangedParameters: v.ChangedParameters,
+		Notes:             v.Notes,
+	}
+}
@@ -0,0 +1,160 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+)
+
+// ExperimentStore defines the interface for experiment database operations
+type ExperimentStore interface {
+	Create(ctx context.Context, experiment *experiment.Experiment) (*experiment.Experiment, error)
+	GetByID(ctx context.Context, id string) (*experiment.Experiment, error)
+	Update(ctx context.Context, experiment *experiment.Experiment) error
+	// AddVariant adds a variant in one atomic update, returning experiment.ErrDuplicateVariant if its recipe is
+	// already part of the experiment and experiment.ErrExperimentNotFound if the experiment does not exist
+	AddVariant(ctx context.Context, id string, variant experiment.Variant) (*experiment.Experiment, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, limit, offset int64) ([]*experiment.Experiment, error)
+}
+
+// MongoDBExperimentStore implements the ExperimentStore interface using MongoDB
+type MongoDBExperimentStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBExperimentStore creates a new MongoDBExperimentStore
+func NewMongoDBExperimentStore(db *mongo.Database) *MongoDBExperimentStore {
+	return &MongoDBExperimentStore{
+		collection: db.Collection("experiments"),
+	}
+}
+
+// Create inserts a new experiment into the database
+func (s *MongoDBExperimentStore) Create(ctx context.Context, experiment *experiment.Experiment) (*experiment.Experiment, error) {
+	if experiment.ID == "" {
+		experiment.ID = primitive.NewObjectID().Hex()
+	}
+	experiment.CreatedAt = time.Now()
+	experiment.UpdatedAt = time.Now()
+
+	_, err := s.collection.InsertOne(ctx, ToMongoExperiment(experiment))
+	if err != nil {
+		return nil, err
+	}
+
+	return experiment, nil
+}
+
+// GetByID retrieves a experiment by its ID, returning nil if it does not exist.
+// An ID that is not an ObjectID can not exist, so it is not found either.
+func (s *MongoDBExperimentStore) GetByID(ctx context.Context, id string) (*experiment.Experiment, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var doc ExperimentDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// Update updates the name, hypothesis and conclusion of an existing experiment.
+// Its variants are left alone, so variants added in the meantime are kept.
+func (s *MongoDBExperimentStore) Update(ctx context.Context, experiment *experiment.Experiment) error {
+	oid, err := primitive.ObjectIDFromHex(experiment.ID)
+	if err != nil {
+		return err
+	}
+
+	experiment.UpdatedAt = time.Now()
+
+	_, err = s.collection.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{
+		"name":       experiment.Name,
+		"hypothesis": experiment.Hypothesis,
+		"conclusion": experiment.Conclusion,
+		"updated_at": experiment.UpdatedAt,
+	}})
+	return err
+}
+
+// AddVariant appends the variant unless its recipe is the base recipe or already a variant. The check and the update
+// are a single operation, so concurrent variants are neither lost nor duplicated.
+func (s *MongoDBExperimentStore) AddVariant(ctx context.Context, id string, variant experiment.Variant) (*experiment.Experiment, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, experiment.ErrExperimentNotFound
+	}
+
+	filter := bson.M{
+		"_id":                oid,
+		"base_recipe_id":     bson.M{"$ne": variant.RecipeID},
+		"variants.recipe_id": bson.M{"$ne": variant.RecipeID},
+	}
+	update := bson.M{
+		"$push": bson.M{"variants": toMongoVariant(variant)},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+
+	var doc ExperimentDoc
+	err = s.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		n, err := s.collection.CountDocuments(ctx, bson.M{"_id": oid})
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, experiment.ErrExperimentNotFound
+		}
+		return nil, experiment.ErrDuplicateVariant
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// Delete removes a experiment by its ID
+func (s *MongoDBExperimentStore) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	return err
+}
+
+// List retrieves experiments with pagination
+func (s *MongoDBExperimentStore) List(ctx context.Context, limit, offset int64) ([]*experiment.Experiment, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{},
+		options.Find().SetLimit(limit).SetSkip(offset))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*ExperimentDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	experiments := make([]*experiment.Experiment, len(docs))
+	for i, doc := range docs {
+		experiments[i] = doc.ToDomain()
+	}
+
+	return experiments, nil
+}
@@ -0,0 +1,50 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// MoldDoc represents a mold document in MongoDB
+type MoldDoc struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Name         string             `bson:"name"`
+	Type         string             `bson:"type"`
+	Cavities     int                `bson:"cavities"`
+	CavityWeight float64            `bson:"cavity_weight"`
+	ShellWeight  float64            `bson:"shell_weight,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at"`
+	UpdatedAt    time.Time          `bson:"updated_at"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (m *MoldDoc) ToDomain() *recipe.Mold {
+	return &recipe.Mold{
+		ID:           m.ID.Hex(),
+		Name:         m.Name,
+		Type:         recipe.MoldType(m.Type),
+		Cavities:     m.Cavities,
+		CavityWeight: m.CavityWeight,
+		ShellWeight:  m.ShellWeight,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// ToMongoMold converts a domain model to a MongoDB document
+func ToMongoMold(m *recipe.Mold) *MoldDoc {
+	id, _ := primitive.ObjectIDFromHex(m.ID)
+	return &MoldDoc{
+		ID:           id,
+		Name:         m.Name,
+		Type:         string(m.Type),
+		Cavities:     m.Cavities,
+		CavityWeight: m.CavityWeight,
+		ShellWeight:  m.ShellWeight,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// MoldStore defines the interface for mold database operations
+type MoldStore interface {
+	Create(ctx context.Context, mold *recipe.Mold) (*recipe.Mold, error)
+	GetByID(ctx context.Context, id string) (*recipe.Mold, error)
+	Update(ctx context.Context, mold *recipe.Mold) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, limit, offset int64) ([]*recipe.Mold, error)
+}
+
+// MongoDBMoldStore implements the MoldStore interface using MongoDB
+type MongoDBMoldStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBMoldStore creates a new MongoDBMoldStore
+func NewMongoDBMoldStore(db *mongo.Database) *MongoDBMoldStore {
+	return &MongoDBMoldStore{
+		collection: db.Collection("molds"),
+	}
+}
+
+// Create inserts a new mold into the database
+func (s *MongoDBMoldStore) Create(ctx context.Context, mold *recipe.Mold) (*recipe.Mold, error) {
+	if mold.ID == "" {
+		mold.ID = primitive.NewObjectID().Hex()
+	}
+	mold.CreatedAt = time.Now()
+	mold.UpdatedAt = time.Now()
+
+	_, err := s.collection.InsertOne(ctx, ToMongoMold(mold))
+	if err != nil {
+		return nil, err
+	}
+
+	return mold, nil
+}
+
+// GetByID retrieves a mold by its ID, returning nil if it does not exist.
+// An ID that is not an ObjectID can not exist, so it is not found either.
+func (s *MongoDBMoldStore) GetByID(ctx context.Context, id string) (*recipe.Mold, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, nil
+	}
+
+	var doc MoldDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// Update updates an existing mold
+func (s *MongoDBMoldStore) Update(ctx context.Context, mold *recipe.Mold) error {
+	oid, err := primitive.ObjectIDFromHex(mold.ID)
+	if err != nil {
+		return err
+	}
+
+	mold.UpdatedAt = time.Now()
+
+	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": oid}, ToMongoMold(mold))
+	return err
+}
+
+// Delete removes a mold by its ID
+func (s *MongoDBMoldStore) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.collection.DeleteOne(ctx, bson.M{"_id": oid})
+	return err
+}
+
+// List retrieves molds with pagination
+func (s *MongoDBMoldStore) List(ctx context.Context, limit, offset int64) ([]*recipe.Mold, error) {
+	cursor, err := s.collection.Find(ctx, bson.M{},
+		options.Find().SetLimit(limit).SetSkip(offset))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*MoldDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	molds := make([]*recipe.Mold, len(docs))
+	for i, doc := range docs {
+		molds[i] = doc.ToDomain()
+	}
+
+	return molds, nil
+}
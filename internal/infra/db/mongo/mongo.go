@@ -8,17 +8,23 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// NewClient creates a new MongoDB client
-func NewClient(uri string) (*mongo.Client, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+// NewClient creates a new MongoDB client, failing when the server cannot be reached within connectTimeout.
+// Operations of the client time out after operationTimeout, or never when it is 0.
+func NewClient(uri string, connectTimeout, operationTimeout time.Duration) (*mongo.Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
 	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	opts := options.Client().ApplyURI(uri)
+	if operationTimeout > 0 {
+		opts.SetTimeout(operationTimeout)
+	}
+	client, err := mongo.Connect(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	if err := client.Ping(ctx, nil); err != nil {
+		client.Disconnect(context.Background())
 		return nil, err
 	}
 
@@ -22,13 +22,80 @@ type RecipeDoc struct {
 	UpdatedBy       string             `bson:"updated_by"`
 	CacaoPercentage float64            `bson:"cacao_percentage,omitempty"` // Optional field for cacao percentage
 	Yield           QuantityDoc        `bson:"yield,omitempty"`            // Optional field for yield
+	Input           QuantityDoc        `bson:"input,omitempty"`            // Optional field for gross input before losses
+	Losses          []ProcessLossDoc   `bson:"losses,omitempty"`
+	Classification  string             `bson:"classification,omitempty"`
+	Tempering       *TemperingCurveDoc `bson:"tempering,omitempty"` // Optional overrides of the default tempering curve
+	Process         *ProcessProfileDoc `bson:"process,omitempty"`   // Optional bean-to-bar process profile
+	Nutrition       *NutritionDoc      `bson:"nutrition,omitempty"` // Optional nutrition facts per 100 g
+	Status          string             `bson:"status,omitempty"`
+	Revision        int                `bson:"revision,omitempty"`
+	RevisionOf      string             `bson:"revision_of,omitempty"`
+	ApprovedBy      string             `bson:"approved_by,omitempty"`
+	ApprovedAt      *time.Time         `bson:"approved_at,omitempty"`
+	DeletedAt       *time.Time         `bson:"deleted_at,omitempty"` // Set when the recipe is in the trash
+	DeletedBy       string             `bson:"deleted_by,omitempty"`
 }
 
 // IngredientDoc represents an ingredient document in MongoDB
 type IngredientDoc struct {
-	Name     string      `bson:"name"`
-	IsCacao  bool        `bson:"is_cacao"` // Indicates if the ingredient is cacao
-	Quantity QuantityDoc `bson:"quantity"`
+	Name      string      `bson:"name"`
+	IsCacao   bool        `bson:"is_cacao"` // Indicates if the ingredient is cacao
+	Quantity  QuantityDoc `bson:"quantity"`
+	CacaoID   string      `bson:"cacao_id,omitempty"`
+	Origin    *OriginDoc  `bson:"origin,omitempty"`
+	CostPerKg float64     `bson:"cost_per_kg,omitempty"`
+}
+
+// ProcessLossDoc represents a process loss document in MongoDB
+type ProcessLossDoc struct {
+	Stage      string  `bson:"stage"`
+	Percentage float64 `bson:"percentage"`
+}
+
+// TemperingCurveDoc represents a tempering curve document in MongoDB
+type TemperingCurveDoc struct {
+	MeltTemperature float64 `bson:"melt_temperature,omitempty"`
+	CoolTemperature float64 `bson:"cool_temperature,omitempty"`
+	WorkTemperature float64 `bson:"work_temperature,omitempty"`
+}
+
+// ProcessProfileDoc represents a bean-to-bar process profile document in MongoDB
+type ProcessProfileDoc struct {
+	Roast          []CurvePointDoc     `bson:"roast,omitempty"`
+	WinnowingRatio float64             `bson:"winnowing_ratio,omitempty"`
+	Refining       *RefiningProfileDoc `bson:"refining,omitempty"`
+	Conching       *ConchingProfileDoc `bson:"conching,omitempty"`
+}
+
+// CurvePointDoc represents a time/temperature curve point document in MongoDB
+type CurvePointDoc struct {
+	Minute      float64 `bson:"minute"`
+	Temperature float64 `bson:"temperature"`
+}
+
+// RefiningProfileDoc represents a refining profile document in MongoDB
+type RefiningProfileDoc struct {
+	DurationHours      float64 `bson:"duration_hours"`
+	TargetParticleSize float64 `bson:"target_particle_size"`
+}
+
+// ConchingProfileDoc represents a conching profile document in MongoDB
+type ConchingProfileDoc struct {
+	DurationHours float64 `bson:"duration_hours"`
+	Temperature   float64 `bson:"temperature"`
+}
+
+// NutritionDoc represents nutrition facts per 100 g in MongoDB
+type NutritionDoc struct {
+	Energy       float64 `bson:"energy"`
+	Fat          float64 `bson:"fat"`
+	SaturatedFat float64 `bson:"saturated_fat"`
+	Carbohydrate float64 `bson:"carbohydrate"`
+	Sugar        float64 `bson:"sugar"`
+	Protein      float64 `bson:"protein"`
+	Fiber        float64 `bson:"fiber"`
+	Sodium       float64 `bson:"sodium"`
 }
 
 // QuantityDoc represents a quantity document in MongoDB
@@ -51,6 +118,19 @@ func (r *RecipeDoc) ToDomain() *recipe.Recipe {
 		UpdatedBy:       r.UpdatedBy,
 		CacaoPercentage: r.CacaoPercentage,
 		Yield:           toDomainQuantity(r.Yield),
+		Input:           toDomainQuantity(r.Input),
+		Losses:          toDomainLosses(r.Losses),
+		Classification:  recipe.Classification(r.Classification),
+		Tempering:       toDomainTemperingCurve(r.Tempering),
+		Process:         toDomainProcessProfile(r.Process),
+		Nutrition:       toDomainNutrition(r.Nutrition),
+		Status:          toDomainStatus(r.Status),
+		Revision:        max(r.Revision, 1),
+		RevisionOf:      r.RevisionOf,
+		ApprovedBy:      r.ApprovedBy,
+		ApprovedAt:      r.ApprovedAt,
+		DeletedAt:       r.DeletedAt,
+		DeletedBy:       r.DeletedBy,
 	}
 }
 
@@ -69,16 +149,41 @@ func ToMongo(r *recipe.Recipe) *RecipeDoc {
 		UpdatedBy:       r.UpdatedBy,
 		CacaoPercentage: r.CacaoPercentage,
 		Yield:           toMongoQuantity(r.Yield),
+		Input:           toMongoQuantity(r.Input),
+		Losses:          toMongoLosses(r.Losses),
+		Classification:  string(r.Classification),
+		Tempering:       toMongoTemperingCurve(r.Tempering),
+		Process:         toMongoProcessProfile(r.Process),
+		Nutrition:       toMongoNutrition(r.Nutrition),
+		Status:          string(r.Status),
+		Revision:        r.Revision,
+		RevisionOf:      r.RevisionOf,
+		ApprovedBy:      r.ApprovedBy,
+		ApprovedAt:      r.ApprovedAt,
+		DeletedAt:       r.DeletedAt,
+		DeletedBy:       r.DeletedBy,
+	}
+}
+
+// toDomainStatus maps the stored status to a recipe status.
+// Recipes stored before the lifecycle was introduced have no status and are treated as drafts.
+func toDomainStatus(status string) recipe.Status {
+	if status == "" {
+		return recipe.Draft
 	}
+	return recipe.Status(status)
 }
 
 func toDomainIngredients(docs []IngredientDoc) []recipe.Ingredient {
 	ingredients := make([]recipe.Ingredient, len(docs))
 	for i, doc := range docs {
 		ingredients[i] = recipe.Ingredient{
-			Name:     doc.Name,
-			IsCacao:  doc.IsCacao,
-			Quantity: toDomainQuantity(doc.Quantity),
+			Name:      doc.Name,
+			IsCacao:   doc.IsCacao,
+			Quantity:  toDomainQuantity(doc.Quantity),
+			CacaoID:   doc.CacaoID,
+			Origin:    toDomainOrigin(doc.Origin),
+			CostPerKg: doc.CostPerKg,
 		}
 	}
 	return ingredients
@@ -88,9 +193,12 @@ func toMongoIngredients(ingredients []recipe.Ingredient) []IngredientDoc {
 	docs := make([]IngredientDoc, len(ingredients))
 	for i, ing := range ingredients {
 		docs[i] = IngredientDoc{
-			Name:     ing.Name,
-			IsCacao:  ing.IsCacao,
-			Quantity: toMongoQuantity(ing.Quantity),
+			Name:      ing.Name,
+			IsCacao:   ing.IsCacao,
+			Quantity:  toMongoQuantity(ing.Quantity),
+			CacaoID:   ing.CacaoID,
+			Origin:    toMongoOrigin(ing.Origin),
+			CostPerKg: ing.CostPerKg,
 		}
 	}
 	return docs
@@ -109,3 +217,142 @@ func toMongoQuantity(q recipe.Quantity) QuantityDoc {
 		Unit:   string(q.Unit),
 	}
 }
+
+func toDomainLosses(docs []ProcessLossDoc) []recipe.ProcessLoss {
+	if len(docs) == 0 {
+		return nil
+	}
+	losses := make([]recipe.ProcessLoss, len(docs))
+	for i, doc := range docs {
+		losses[i] = recipe.ProcessLoss{
+			Stage:      doc.Stage,
+			Percentage: doc.Percentage,
+		}
+	}
+	return losses
+}
+
+func toMongoLosses(losses []recipe.ProcessLoss) []ProcessLossDoc {
+	if len(losses) == 0 {
+		return nil
+	}
+	docs := make([]ProcessLossDoc, len(losses))
+	for i, loss := range losses {
+		docs[i] = ProcessLossDoc{
+			Stage:      loss.Stage,
+			Percentage: loss.Percentage,
+		}
+	}
+	return docs
+}
+
+func toDomainTemperingCurve(doc *TemperingCurveDoc) *recipe.TemperingCurve {
+	if doc == nil {
+		return nil
+	}
+	return &recipe.TemperingCurve{
+		MeltTemperature: doc.MeltTemperature,
+		CoolTemperature: doc.CoolTemperature,
+		WorkTemperature: doc.WorkTemperature,
+	}
+}
+
+func toMongoTemperingCurve(curve *recipe.TemperingCurve) *TemperingCurveDoc {
+	if curve == nil {
+		return nil
+	}
+	return &TemperingCurveDoc{
+		MeltTemperature: curve.MeltTemperature,
+		CoolTemperature: curve.CoolTemperature,
+		WorkTemperature: curve.WorkTemperature,
+	}
+}
+
+func toDomainProcessProfile(doc *ProcessProfileDoc) *recipe.ProcessProfile {
+	if doc == nil {
+		return nil
+	}
+	p := &recipe.ProcessProfile{
+		WinnowingRatio: doc.WinnowingRatio,
+	}
+	if len(doc.Roast) > 0 {
+		points := make([]recipe.CurvePoint, len(doc.Roast))
+		for i, point := range doc.Roast {
+			points[i] = recipe.CurvePoint{Minute: point.Minute, Temperature: point.Temperature}
+		}
+		p.Roast = &recipe.RoastProfile{Points: points}
+	}
+	if doc.Refining != nil {
+		p.Refining = &recipe.RefiningProfile{
+			DurationHours:      doc.Refining.DurationHours,
+			TargetParticleSize: doc.Refining.TargetParticleSize,
+		}
+	}
+	if doc.Conching != nil {
+		p.Conching = &recipe.ConchingProfile{
+			DurationHours: doc.Conching.DurationHours,
+			Temperature:   doc.Conching.Temperature,
+		}
+	}
+	return p
+}
+
+func toMongoProcessProfile(p *recipe.ProcessProfile) *ProcessProfileDoc {
+	if p == nil {
+		return nil
+	}
+	doc := &ProcessProfileDoc{
+		WinnowingRatio: p.WinnowingRatio,
+	}
+	if p.Roast != nil {
+		doc.Roast = make([]CurvePointDoc, len(p.Roast.Points))
+		for i, point := range p.Roast.Points {
+			doc.Roast[i] = CurvePointDoc{Minute: point.Minute, Temperature: point.Temperature}
+		}
+	}
+	if p.Refining != nil {
+		doc.Refining = &RefiningProfileDoc{
+			DurationHours:      p.Refining.DurationHours,
+			TargetParticleSize: p.Refining.TargetParticleSize,
+		}
+	}
+	if p.Conching != nil {
+		doc.Conching = &ConchingProfileDoc{
+			DurationHours: p.Conching.DurationHours,
+			Temperature:   p.Conching.Temperature,
+		}
+	}
+	return doc
+}
+
+func toDomainNutrition(doc *NutritionDoc) *recipe.Nutrition {
+	if doc == nil {
+		return nil
+	}
+	return &recipe.Nutrition{
+		Energy:       doc.Energy,
+		Fat:          doc.Fat,
+		SaturatedFat: doc.SaturatedFat,
+		Carbohydrate: doc.Carbohydrate,
+		Sugar:        doc.Sugar,
+		Protein:      doc.Protein,
+		Fiber:        doc.Fiber,
+		Sodium:       doc.Sodium,
+	}
+}
+
+func toMongoNutrition(n *recipe.Nutrition) *NutritionDoc {
+	if n == nil {
+		return nil
+	}
+	return &NutritionDoc{
+		Energy:       n.Energy,
+		Fat:          n.Fat,
+		SaturatedFat: n.SaturatedFat,
+		Carbohydrate: n.Carbohydrate,
+		Sugar:        n.Sugar,
+		Protein:      n.Protein,
+		Fiber:        n.Fiber,
+		Sodium:       n.Sodium,
+	}
+}
@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,11 +18,18 @@ type RecipeStore interface {
 	Create(ctx context.Context, recipe *recipe.Recipe) (*recipe.Recipe, error)
 	GetByID(ctx context.Context, id string) (*recipe.Recipe, error)
 	Update(ctx context.Context, recipe *recipe.Recipe) error
-	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error)
+	Delete(ctx context.Context, id, deletedBy string) error
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	List(ctx context.Context, opts recipe.ListOptions) (*recipe.Page, error)
+	ListDeleted(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error)
+	Each(ctx context.Context, filter recipe.Filter, fn func(*recipe.Recipe) error) error
 	Count(ctx context.Context) (int64, error)
 }
 
+// notDeleted matches recipes that are not in the trash
+var notDeleted = bson.M{"$exists": false}
+
 // MongoDBRecipeStore implements the RecipeStore interface using MongoDB
 type MongoDBRecipeStore struct {
 	collection *mongo.Collection
@@ -51,15 +59,16 @@ func (s *MongoDBRecipeStore) Create(ctx context.Context, recipe *recipe.Recipe)
 	return recipe, nil
 }
 
-// GetByID retrieves a recipe by its ID
+// GetByID retrieves a recipe by its ID, returning nil if it does not exist or is in the trash.
+// An ID that is not an ObjectID can not exist, so it is not found either.
 func (s *MongoDBRecipeStore) GetByID(ctx context.Context, id string) (*recipe.Recipe, error) {
 	oid, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, err
+		return nil, nil
 	}
 
 	var doc RecipeDoc
-	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid, "deleted_at": notDeleted}).Decode(&doc)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			return nil, nil
@@ -70,35 +79,209 @@ func (s *MongoDBRecipeStore) GetByID(ctx context.Context, id string) (*recipe.Re
 	return doc.ToDomain(), nil
 }
 
-// Update updates an existing recipe
-func (s *MongoDBRecipeStore) Update(ctx context.Context, recipe *recipe.Recipe) error {
-	oid, err := primitive.ObjectIDFromHex(recipe.ID)
+// Update updates an existing recipe.
+// It returns recipe.ErrRecipeNotFound if the recipe does not exist or is in the trash.
+func (s *MongoDBRecipeStore) Update(ctx context.Context, rcp *recipe.Recipe) error {
+	oid, err := primitive.ObjectIDFromHex(rcp.ID)
+	if err != nil {
+		return recipe.ErrRecipeNotFound
+	}
+
+	rcp.UpdatedAt = time.Now()
+	doc := ToMongo(rcp)
+
+	// Recipes in the trash can only be changed by restoring them
+	res, err := s.collection.ReplaceOne(ctx, bson.M{"_id": oid, "deleted_at": notDeleted}, doc)
 	if err != nil {
 		return err
 	}
+	if res.MatchedCount == 0 {
+		return recipe.ErrRecipeNotFound
+	}
+	return nil
+}
 
-	recipe.UpdatedAt = time.Now()
-	doc := ToMongo(recipe)
+// Delete moves a recipe to the trash, recording when and by whom it was deleted.
+// It returns recipe.ErrRecipeNotFound if the recipe does not exist or is already in the trash.
+func (s *MongoDBRecipeStore) Delete(ctx context.Context, id, deletedBy string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return recipe.ErrRecipeNotFound
+	}
 
-	_, err = s.collection.ReplaceOne(ctx, bson.M{"_id": oid}, doc)
-	return err
+	res, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": oid, "deleted_at": notDeleted},
+		bson.M{"$set": bson.M{"deleted_at": time.Now(), "deleted_by": deletedBy}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return recipe.ErrRecipeNotFound
+	}
+	return nil
 }
 
-// Delete removes a recipe by its ID
-func (s *MongoDBRecipeStore) Delete(ctx context.Context, id string) error {
+// Restore takes a recipe out of the trash.
+// It returns recipe.ErrRecipeNotFound if the recipe is not in the trash.
+func (s *MongoDBRecipeStore) Restore(ctx context.Context, id string) error {
 	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return recipe.ErrRecipeNotFound
+	}
+
+	res, err := s.collection.UpdateOne(ctx,
+		bson.M{"_id": oid, "deleted_at": bson.M{"$exists": true}},
+		bson.M{"$unset": bson.M{"deleted_at": "", "deleted_by": ""}})
 	if err != nil {
 		return err
 	}
+	if res.MatchedCount == 0 {
+		return recipe.ErrRecipeNotFound
+	}
+	return nil
+}
+
+// Purge permanently removes the recipes that were moved to the trash before the given time, returning how many were removed
+func (s *MongoDBRecipeStore) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	res, err := s.collection.DeleteMany(ctx, bson.M{"deleted_at": bson.M{"$lt": deletedBefore}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// sortKeys are the document fields recipes are sorted on for each sort field
+var sortKeys = map[recipe.SortField]string{
+	recipe.SortByName:    "name",
+	recipe.SortByCreated: "created_at",
+	recipe.SortByUpdated: "updated_at",
+	recipe.SortByCacao:   "cacao_percentage",
+}
 
-	_, err = s.collection.DeleteOne(ctx, bson.M{"_id": oid})
+// EnsureIndexes creates the indexes the recipe queries rely on, one per sort order so pages are read from the index
+// instead of sorting the collection. Creating an index that already exists does nothing.
+func (s *MongoDBRecipeStore) EnsureIndexes(ctx context.Context) error {
+	models := make([]mongo.IndexModel, 0, len(sortKeys))
+	for _, key := range sortKeys {
+		models = append(models, mongo.IndexModel{Keys: bson.D{{Key: key, Value: 1}, {Key: "_id", Value: 1}}})
+	}
+	_, err := s.collection.Indexes().CreateMany(ctx, models)
 	return err
 }
 
-// List retrieves recipes with pagination
-func (s *MongoDBRecipeStore) List(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error) {
-	cursor, err := s.collection.Find(ctx, bson.M{},
-		options.Find().SetLimit(limit).SetSkip(offset))
+// List retrieves a page of the recipes matching the filter in the sort order, leaving out recipes in the trash.
+// Pages start after the cursor of the previous page instead of skipping recipes, so they are read from the index
+// however deep they are, and recipes added or removed in between do not shift the following pages.
+func (s *MongoDBRecipeStore) List(ctx context.Context, opts recipe.ListOptions) (*recipe.Page, error) {
+	query := toMongoFilter(opts.Filter)
+	query["deleted_at"] = notDeleted
+	total, err := s.collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	sort := opts.Sort
+	if sort.Field == "" {
+		sort = recipe.DefaultSort
+	}
+	if opts.After != nil {
+		after, err := afterCursor(*opts.After)
+		if err != nil {
+			return nil, err
+		}
+		query = bson.M{"$and": bson.A{query, after}}
+	}
+	direction := 1
+	if sort.Descending {
+		direction = -1
+	}
+	find := options.Find().SetSort(bson.D{{Key: sortKeys[sort.Field], Value: direction}, {Key: "_id", Value: direction}})
+	if opts.Limit > 0 {
+		// Read one more recipe to know whether there is a next page
+		find.SetLimit(opts.Limit + 1)
+	}
+	recipes, err := s.find(ctx, query, find)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &recipe.Page{Recipes: recipes, Total: total}
+	if opts.Limit > 0 && int64(len(recipes)) > opts.Limit {
+		page.Recipes = recipes[:opts.Limit]
+		page.NextCursor = recipe.NewCursor(page.Recipes[opts.Limit-1], sort).Encode()
+	}
+	return page, nil
+}
+
+// afterCursor returns the query for the recipes after the cursor in its sort order: those with a greater (or, descending,
+// lesser) value of the sort field, and those with the same value and a greater (lesser) ID.
+func afterCursor(c recipe.Cursor) (bson.M, error) {
+	oid, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, recipe.ErrInvalidCursor
+	}
+	key := sortKeys[c.Sort.Field]
+	op := "$gt"
+	if c.Sort.Descending {
+		op = "$lt"
+	}
+
+	var value any
+	switch c.Sort.Field {
+	case recipe.SortByName:
+		value = c.Name
+	case recipe.SortByUpdated:
+		value = *c.UpdatedAt
+	case recipe.SortByCacao:
+		if c.Cacao == 0 {
+			// Recipes without cacao have no cacao_percentage field, which sorts before every number
+			if c.Sort.Descending {
+				return bson.M{key: nil, "_id": bson.M{"$lt": oid}}, nil
+			}
+			return bson.M{"$or": bson.A{bson.M{key: bson.M{"$ne": nil}}, bson.M{key: nil, "_id": bson.M{"$gt": oid}}}}, nil
+		}
+		value = c.Cacao
+	default:
+		value = *c.CreatedAt
+	}
+	after := bson.A{bson.M{key: bson.M{op: value}}, bson.M{key: value, "_id": bson.M{op: oid}}}
+	if c.Sort.Field == recipe.SortByCacao && c.Sort.Descending {
+		after = append(after, bson.M{key: nil})
+	}
+	return bson.M{"$or": after}, nil
+}
+
+// ListDeleted retrieves the recipes in the trash with pagination, most recently deleted first
+func (s *MongoDBRecipeStore) ListDeleted(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error) {
+	return s.find(ctx, bson.M{"deleted_at": bson.M{"$exists": true}},
+		options.Find().SetSort(bson.M{"deleted_at": -1}).SetLimit(limit).SetSkip(offset))
+}
+
+// Each calls fn for every recipe matching the filter in the order they were created, leaving out recipes in the trash,
+// without loading them all in memory. It stops at the first error returned by fn.
+func (s *MongoDBRecipeStore) Each(ctx context.Context, filter recipe.Filter, fn func(*recipe.Recipe) error) error {
+	query := toMongoFilter(filter)
+	query["deleted_at"] = notDeleted
+	cursor, err := s.collection.Find(ctx, query, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc RecipeDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return err
+		}
+		if err := fn(doc.ToDomain()); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+func (s *MongoDBRecipeStore) find(ctx context.Context, query bson.M, opts *options.FindOptions) ([]*recipe.Recipe, error) {
+	cursor, err := s.collection.Find(ctx, query, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +298,62 @@ func (s *MongoDBRecipeStore) List(ctx context.Context, limit, offset int64) ([]*
 	return recipes, nil
 }
 
-// Count returns the total number of recipes
+// Count returns the total number of recipes, leaving out recipes in the trash
 func (s *MongoDBRecipeStore) Count(ctx context.Context) (int64, error) {
-	return s.collection.CountDocuments(ctx, bson.M{})
+	return s.collection.CountDocuments(ctx, bson.M{"deleted_at": notDeleted})
+}
+
+// toMongoFilter converts a recipe.Filter to a MongoDB query.
+// The name is matched as a case-insensitive substring. Origin criteria have to match on the same cacao ingredient and are compared case-insensitively.
+func toMongoFilter(filter recipe.Filter) bson.M {
+	origin := bson.M{}
+	if filter.OriginCountry != "" {
+		origin["origin.country"] = equalFold(filter.OriginCountry)
+	}
+	if filter.OriginRegion != "" {
+		origin["origin.region"] = equalFold(filter.OriginRegion)
+	}
+	if filter.Variety != "" {
+		origin["origin.variety"] = equalFold(filter.Variety)
+	}
+	if filter.Certification != "" {
+		origin["origin.certifications"] = string(filter.Certification)
+	}
+
+	query := bson.M{}
+	if filter.Name != "" {
+		query["name"] = primitive.Regex{Pattern: regexp.QuoteMeta(filter.Name), Options: "i"}
+	}
+	if filter.CreatedBy != "" {
+		query["created_by"] = filter.CreatedBy
+	}
+	if filter.RevisionOf != "" {
+		query["revision_of"] = filter.RevisionOf
+	}
+	switch {
+	case filter.MinCacao > 0 && filter.MaxCacao > 0:
+		query["cacao_percentage"] = bson.M{"$gte": filter.MinCacao, "$lte": filter.MaxCacao}
+	case filter.MinCacao > 0:
+		query["cacao_percentage"] = bson.M{"$gte": filter.MinCacao}
+	case filter.MaxCacao > 0:
+		// Recipes without cacao have no cacao_percentage field, $not also matches those
+		query["cacao_percentage"] = bson.M{"$not": bson.M{"$gt": filter.MaxCacao}}
+	}
+	switch filter.Status {
+	case "":
+	case recipe.Draft:
+		// Recipes stored before the lifecycle was introduced have no status and are drafts
+		query["status"] = bson.M{"$in": bson.A{string(recipe.Draft), "", nil}}
+	default:
+		query["status"] = string(filter.Status)
+	}
+	if len(origin) > 0 {
+		query["ingredients"] = bson.M{"$elemMatch": origin}
+	}
+	return query
+}
+
+// equalFold returns a case-insensitive exact match for the value.
+func equalFold(value string) primitive.Regex {
+	return primitive.Regex{Pattern: "^" + regexp.QuoteMeta(value) + "$", Options: "i"}
 }
@@ -1,11 +1,18 @@
 package mongo
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
 	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
 )
 
@@ -374,3 +381,230 @@ func TestToTemplate(t *testing.T) {
 		t.Errorf("Expected TemplateRecipe.CacaoPercentage %f, got %f", r.CacaoPercentage, templateRec.CacaoPercentage)
 	}
 }
+
+func TestProcessProfileConversion(t *testing.T) {
+	profile := &recipe.ProcessProfile{
+		Roast: &recipe.RoastProfile{Points: []recipe.CurvePoint{
+			{Minute: 0, Temperature: 150},
+			{Minute: 20, Temperature: 125},
+		}},
+		WinnowingRatio: 0.8,
+		Refining:       &recipe.RefiningProfile{DurationHours: 48, TargetParticleSize: 20},
+		Conching:       &recipe.ConchingProfile{DurationHours: 24, Temperature: 60},
+	}
+
+	converted := toDomainProcessProfile(toMongoProcessProfile(profile))
+	if len(converted.Roast.Points) != 2 || converted.Roast.Points[1] != profile.Roast.Points[1] {
+		t.Errorf("Expected roast points %v, got %v", profile.Roast.Points, converted.Roast.Points)
+	}
+	if converted.WinnowingRatio != profile.WinnowingRatio {
+		t.Errorf("Expected winnowing ratio %f, got %f", profile.WinnowingRatio, converted.WinnowingRatio)
+	}
+	if *converted.Refining != *profile.Refining {
+		t.Errorf("Expected refining %+v, got %+v", *profile.Refining, *converted.Refining)
+	}
+	if *converted.Conching != *profile.Conching {
+		t.Errorf("Expected conching %+v, got %+v", *profile.Conching, *converted.Conching)
+	}
+
+	if toMongoProcessProfile(nil) != nil || toDomainProcessProfile(nil) != nil {
+		t.Error("Expected nil process profile to stay nil")
+	}
+}
+
+func TestToMongoFilter(t *testing.T) {
+	if query := toMongoFilter(recipe.Filter{}); len(query) != 0 {
+		t.Errorf("Expected empty query for empty filter, got %v", query)
+	}
+
+	query := toMongoFilter(recipe.Filter{OriginCountry: "Peru", Certification: recipe.Organic})
+	ingredients, ok := query["ingredients"].(bson.M)
+	if !ok {
+		t.Fatalf("Expected an ingredients criterion, got %v", query)
+	}
+	match, ok := ingredients["$elemMatch"].(bson.M)
+	if !ok {
+		t.Fatalf("Expected an $elemMatch criterion, got %v", ingredients)
+	}
+	country, ok := match["origin.country"].(primitive.Regex)
+	if !ok || country.Pattern != "^Peru$" || country.Options != "i" {
+		t.Errorf("Expected case-insensitive country match, got %v", match["origin.country"])
+	}
+	if match["origin.certifications"] != "organic" {
+		t.Errorf("Expected certification organic, got %v", match["origin.certifications"])
+	}
+}
+
+func TestToMongoFilterStatus(t *testing.T) {
+	query := toMongoFilter(recipe.Filter{Status: recipe.Approved})
+	if query["status"] != "approved" {
+		t.Errorf("Expected status approved, got %v", query["status"])
+	}
+
+	// Drafts include recipes stored before the lifecycle was introduced
+	query = toMongoFilter(recipe.Filter{Status: recipe.Draft})
+	status, ok := query["status"].(bson.M)
+	if !ok {
+		t.Fatalf("Expected an $in criterion for drafts, got %v", query["status"])
+	}
+	if in, ok := status["$in"].(bson.A); !ok || len(in) != 3 {
+		t.Errorf("Expected draft, empty and missing status, got %v", status["$in"])
+	}
+}
+
+func TestToMongoFilterFields(t *testing.T) {
+	query := toMongoFilter(recipe.Filter{Name: "dark (70)", CreatedBy: "alice", MinCacao: 60, MaxCacao: 80, RevisionOf: "r1"})
+	name, ok := query["name"].(primitive.Regex)
+	if !ok || name.Pattern != `dark \(70\)` || name.Options != "i" {
+		t.Errorf("Expected a case-insensitive substring match on the name, got %v", query["name"])
+	}
+	if query["created_by"] != "alice" {
+		t.Errorf("Expected created_by alice, got %v", query["created_by"])
+	}
+	if query["revision_of"] != "r1" {
+		t.Errorf("Expected revision_of r1, got %v", query["revision_of"])
+	}
+	if cacao, ok := query["cacao_percentage"].(bson.M); !ok || cacao["$gte"] != 60.0 || cacao["$lte"] != 80.0 {
+		t.Errorf("Expected a cacao percentage range, got %v", query["cacao_percentage"])
+	}
+
+	// Recipes without cacao have no cacao_percentage field, which $lte would not match
+	query = toMongoFilter(recipe.Filter{MaxCacao: 30})
+	if cacao, ok := query["cacao_percentage"].(bson.M); !ok || cacao["$not"] == nil {
+		t.Errorf("Expected a $not criterion for a maximum, got %v", query["cacao_percentage"])
+	}
+}
+
+func TestAfterCursor(t *testing.T) {
+	id := primitive.NewObjectID()
+	created := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	query, err := afterCursor(recipe.NewCursor(&recipe.Recipe{ID: id.Hex(), CreatedAt: created}, recipe.DefaultSort))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := bson.M{"$or": bson.A{
+		bson.M{"created_at": bson.M{"$gt": created}},
+		bson.M{"created_at": created, "_id": bson.M{"$gt": id}},
+	}}
+	if fmt.Sprint(query) != fmt.Sprint(want) {
+		t.Errorf("Expected %v, got %v", want, query)
+	}
+
+	// Descending by cacao, recipes without cacao come last
+	query, _ = afterCursor(recipe.NewCursor(&recipe.Recipe{ID: id.Hex(), CacaoPercentage: 70}, recipe.Sort{Field: recipe.SortByCacao, Descending: true}))
+	if or := query["$or"].(bson.A); len(or) != 3 || fmt.Sprint(or[2]) != fmt.Sprint(bson.M{"cacao_percentage": nil}) {
+		t.Errorf("Expected recipes without cacao after the cursor, got %v", query)
+	}
+
+	if _, err := afterCursor(recipe.Cursor{Sort: recipe.Sort{Field: recipe.SortByName}, ID: "not-an-id"}); err != recipe.ErrInvalidCursor {
+		t.Errorf("Expected ErrInvalidCursor for an invalid ID, got %v", err)
+	}
+}
+
+func TestRecipeStatusConversion(t *testing.T) {
+	doc := &RecipeDoc{}
+	rcp := doc.ToDomain()
+	if rcp.Status != recipe.Draft || rcp.Revision != 1 {
+		t.Errorf("Expected legacy recipe to be revision 1 draft, got %s revision %d", rcp.Status, rcp.Revision)
+	}
+
+	now := time.Now()
+	rcp.Status = recipe.Approved
+	rcp.Revision = 3
+	rcp.RevisionOf = "abc"
+	rcp.ApprovedBy = "alice"
+	rcp.ApprovedAt = &now
+	back := ToMongo(rcp).ToDomain()
+	if back.Status != recipe.Approved || back.Revision != 3 || back.RevisionOf != "abc" || back.ApprovedBy != "alice" || back.ApprovedAt == nil {
+		t.Errorf("Expected lifecycle fields to round trip, got %+v", back)
+	}
+}
+
+func TestRecipeDeletedConversion(t *testing.T) {
+	deletedAt := time.Now()
+	rcp := &recipe.Recipe{DeletedAt: &deletedAt, DeletedBy: "bob"}
+	doc := ToMongo(rcp)
+	if doc.DeletedAt == nil || !doc.DeletedAt.Equal(deletedAt) || doc.DeletedBy != "bob" {
+		t.Errorf("Expected deletion to be stored, got %v by %q", doc.DeletedAt, doc.DeletedBy)
+	}
+	back := doc.ToDomain()
+	if back.DeletedAt == nil || back.DeletedBy != "bob" {
+		t.Errorf("Expected deletion to round trip, got %v by %q", back.DeletedAt, back.DeletedBy)
+	}
+
+	if doc := ToMongo(&recipe.Recipe{}); doc.DeletedAt != nil {
+		t.Error("Expected no deletion time for a recipe that is not deleted")
+	}
+}
+
+func TestNutritionConversion(t *testing.T) {
+	n := &recipe.Nutrition{Energy: 598, Fat: 43, SaturatedFat: 25, Carbohydrate: 46, Sugar: 24, Protein: 8, Fiber: 11, Sodium: 20}
+	if converted := toDomainNutrition(toMongoNutrition(n)); *converted != *n {
+		t.Errorf("Expected nutrition %+v, got %+v", *n, *converted)
+	}
+	if toMongoNutrition(nil) != nil || toDomainNutrition(nil) != nil {
+		t.Error("Expected nil nutrition to stay nil")
+	}
+}
+
+// unreachableDB returns a database on a server that does not exist, for the checks the stores make before querying it
+func unreachableDB(t *testing.T) *mongo.Database {
+	t.Helper()
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://127.0.0.1:1").SetServerSelectionTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+	return client.Database("recipe_test")
+}
+
+func TestRecipeStoreInvalidID(t *testing.T) {
+	ctx := context.Background()
+	s := NewMongoDBRecipeStore(unreachableDB(t))
+
+	if rcp, err := s.GetByID(ctx, "abc"); rcp != nil || err != nil {
+		t.Errorf("Expected an ID that is not an ObjectID to be not found, got %v, %v", rcp, err)
+	}
+	if err := s.Update(ctx, &recipe.Recipe{ID: "abc"}); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("Expected ErrRecipeNotFound, got %v", err)
+	}
+	if err := s.Delete(ctx, "abc", "alice"); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("Expected ErrRecipeNotFound, got %v", err)
+	}
+}
+
+func TestCacaoStoreInvalidID(t *testing.T) {
+	s := NewMongoDBCacaoStore(unreachableDB(t))
+	if c, err := s.GetByID(context.Background(), "abc"); c != nil || err != nil {
+		t.Errorf("Expected an ID that is not an ObjectID to be not found, got %v, %v", c, err)
+	}
+}
+
+func TestBeanLotStoreInvalidID(t *testing.T) {
+	s := NewMongoDBBeanLotStore(unreachableDB(t))
+	if _, err := s.AddInspection(context.Background(), "abc", quality.Inspection{}); !errors.Is(err, quality.ErrBeanLotNotFound) {
+		t.Errorf("Expected an ID that is not an ObjectID to be not found, got %v", err)
+	}
+}
+
+func TestWebhookStoreInvalidID(t *testing.T) {
+	s := NewMongoDBWebhookStore(unreachableDB(t))
+	if w, err := s.GetByID(context.Background(), "abc"); w != nil || err != nil {
+		t.Errorf("Expected an ID that is not an ObjectID to be not found, got %v, %v", w, err)
+	}
+}
+
+func TestExperimentStoreInvalidID(t *testing.T) {
+	s := NewMongoDBExperimentStore(unreachableDB(t))
+	if exp, err := s.GetByID(context.Background(), "abc"); exp != nil || err != nil {
+		t.Errorf("Expected an ID that is not an ObjectID to be not found, got %v, %v", exp, err)
+	}
+}
+
+func TestMoldStoreInvalidID(t *testing.T) {
+	s := NewMongoDBMoldStore(unreachableDB(t))
+	if m, err := s.GetByID(context.Background(), "abc"); m != nil || err != nil {
+		t.Errorf("Expected an ID that is not an ObjectID to be not found, got %v, %v", m, err)
+	}
+}
@@ -0,0 +1,83 @@
+package mongo
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// TastingSessionDoc represents a tasting session document in MongoDB
+type TastingSessionDoc struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	BatchID   string             `bson:"batch_id,omitempty"`
+	RecipeID  string             `bson:"recipe_id,omitempty"`
+	HeldAt    time.Time          `bson:"held_at"`
+	Panelists []string           `bson:"panelists"`
+	Scores    []ScoreDoc         `bson:"scores"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// ScoreDoc represents the score of a panelist in MongoDB
+type ScoreDoc struct {
+	Panelist string             `bson:"panelist"`
+	Ratings  map[string]float64 `bson:"ratings"`
+	Notes    string             `bson:"notes,omitempty"`
+}
+
+// ToDomain converts a MongoDB document to a domain model
+func (s *TastingSessionDoc) ToDomain() *tasting.Session {
+	scores := make([]tasting.Score, len(s.Scores))
+	for i, doc := range s.Scores {
+		ratings := make(map[tasting.Attribute]float64, len(doc.Ratings))
+		for attribute, rating := range doc.Ratings {
+			ratings[tasting.Attribute(attribute)] = rating
+		}
+		scores[i] = tasting.Score{
+			Panelist: doc.Panelist,
+			Ratings:  ratings,
+			Notes:    doc.Notes,
+		}
+	}
+	return &tasting.Session{
+		ID:        s.ID.Hex(),
+		BatchID:   s.BatchID,
+		RecipeID:  s.RecipeID,
+		HeldAt:    s.HeldAt,
+		Panelists: s.Panelists,
+		Scores:    scores,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// ToMongoTastingSession converts a domain model to a MongoDB document
+func ToMongoTastingSession(s *tasting.Session) *TastingSessionDoc {
+	id, _ := primitive.ObjectIDFromHex(s.ID)
+	scores := make([]ScoreDoc, len(s.Scores))
+	for i, score := range s.Scores {
+		scores[i] = toMongoScore(score)
+	}
+	return &TastingSessionDoc{
+		ID:        id,
+		BatchID:   s.BatchID,
+		RecipeID:  s.RecipeID,
+		HeldAt:    s.HeldAt,
+		Panelists: s.Panelists,
+		Scores:    scores,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// toMongoScore converts the score of a panelist to a MongoDB document
+func toMongoScore(score tasting.Score) ScoreDoc {
+	ratings := make(map[string]float64, len(score.Ratings))
+	for attribute, rating := range score.Ratings {
+		ratings[string(attribute)] = rating
+	}
+	return ScoreDoc{
+		Panelist: score.Panelist,
+		Ratings:  ratings,
+		Notes:    score.Notes,
+	}
+}
@@ -0,0 +1,140 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// TastingStore defines the interface for tasting session database operations
+type TastingStore interface {
+	Create(ctx context.Context, session *tasting.Session) (*tasting.Session, error)
+	GetByID(ctx context.Context, id string) (*tasting.Session, error)
+	// AddScore records the score of a panelist in one atomic update, returning tasting.ErrDuplicatePanelist if the
+	// panelist has already scored the session and tasting.ErrSessionNotFound if it does not exist
+	AddScore(ctx context.Context, id string, score tasting.Score) (*tasting.Session, error)
+	// List retrieves sessions for the batch and/or recipe, a limit of 0 returns all sessions
+	List(ctx context.Context, batchID, recipeID string, limit, offset int64) ([]*tasting.Session, error)
+}
+
+// MongoDBTastingStore implements the TastingStore interface using MongoDB
+type MongoDBTastingStore struct {
+	collection *mongo.Collection
+}
+
+// NewMongoDBTastingStore creates a new MongoDBTastingStore
+func NewMongoDBTastingStore(db *mongo.Database) *MongoDBTastingStore {
+	return &MongoDBTastingStore{
+		collection: db.Collection("tasting_sessions"),
+	}
+}
+
+// Create inserts a new tasting session into the database
+func (s *MongoDBTastingStore) Create(ctx context.Context, session *tasting.Session) (*tasting.Session, error) {
+	if session.ID == "" {
+		session.ID = primitive.NewObjectID().Hex()
+	}
+	session.CreatedAt = time.Now()
+
+	_, err := s.collection.InsertOne(ctx, ToMongoTastingSession(session))
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// GetByID retrieves a tasting session by its ID
+func (s *MongoDBTastingStore) GetByID(ctx context.Context, id string) (*tasting.Session, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc TastingSessionDoc
+	err = s.collection.FindOne(ctx, bson.M{"_id": oid}).Decode(&doc)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// AddScore appends the score to the session unless the panelist has already scored it, and adds the panelist
+// to the panel. The check and the update are a single operation, so concurrent scores are neither lost nor duplicated.
+func (s *MongoDBTastingStore) AddScore(ctx context.Context, id string, score tasting.Score) (*tasting.Session, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, tasting.ErrSessionNotFound
+	}
+
+	// An update pipeline, as the panelists of older sessions may be null, which $addToSet rejects
+	panelists := bson.M{"$ifNull": bson.A{"$panelists", bson.A{}}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"scores": bson.M{"$concatArrays": bson.A{
+			bson.M{"$ifNull": bson.A{"$scores", bson.A{}}},
+			bson.A{bson.M{"$literal": toMongoScore(score)}},
+		}},
+		"panelists": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{bson.M{"$literal": score.Panelist}, panelists}},
+			panelists,
+			bson.M{"$concatArrays": bson.A{panelists, bson.A{bson.M{"$literal": score.Panelist}}}},
+		}},
+	}}}}
+
+	var doc TastingSessionDoc
+	err = s.collection.FindOneAndUpdate(ctx, bson.M{"_id": oid, "scores.panelist": bson.M{"$ne": score.Panelist}}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		n, err := s.collection.CountDocuments(ctx, bson.M{"_id": oid})
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, tasting.ErrSessionNotFound
+		}
+		return nil, tasting.ErrDuplicatePanelist
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return doc.ToDomain(), nil
+}
+
+// List retrieves tasting sessions for the batch and/or recipe with pagination, most recent first
+func (s *MongoDBTastingStore) List(ctx context.Context, batchID, recipeID string, limit, offset int64) ([]*tasting.Session, error) {
+	filter := bson.M{}
+	if batchID != "" {
+		filter["batch_id"] = batchID
+	}
+	if recipeID != "" {
+		filter["recipe_id"] = recipeID
+	}
+
+	cursor, err := s.collection.Find(ctx, filter,
+		options.Find().SetLimit(limit).SetSkip(offset).SetSort(bson.D{{Key: "held_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*TastingSessionDoc, 0)
+	if err = cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*tasting.Session, len(docs))
+	for i, doc := range docs {
+		sessions[i] = doc.ToDomain()
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,59 @@
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Transactor runs a function in a database transaction.
+// Stores called with the context passed to the function take part in the transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// MongoDBTransactor implements the Transactor interface using MongoDB sessions
+type MongoDBTransactor struct {
+	client    *mongo.Client
+	supported bool
+}
+
+// NewMongoDBTransactor creates a new MongoDBTransactor.
+// MongoDB only supports transactions on replica sets and sharded clusters; on a standalone server
+// the functions are run without a transaction, so their writes are not atomic.
+func NewMongoDBTransactor(ctx context.Context, client *mongo.Client) (*MongoDBTransactor, error) {
+	var hello bson.M
+	if err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello); err != nil {
+		return nil, err
+	}
+	_, replicaSet := hello["setName"]
+	return &MongoDBTransactor{
+		client:    client,
+		supported: replicaSet || hello["msg"] == "isdbgrid",
+	}, nil
+}
+
+// Supported reports whether the server supports transactions
+func (t *MongoDBTransactor) Supported() bool {
+	return t.supported
+}
+
+// WithinTx runs fn in a transaction, which is committed if fn returns nil and aborted otherwise.
+// The function may be retried on transient errors.
+func (t *MongoDBTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !t.supported {
+		return fn(ctx)
+	}
+
+	session, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"embed"
+	"encoding/json"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	gin "github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+)
+
+// playground is a GraphiQL page to explore the schema and try queries in the browser
+//
+//go:embed playground.html
+var playground []byte
+
+// assets are the GraphiQL and React bundles of the playground, with their checksums in SHA384SUMS
+//
+//go:generate go run gen_assets.go
+//go:embed assets
+var assets embed.FS
+
+// Request is a GraphQL request as sent in the body of a POST request
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Controller handles GraphQL requests over HTTP
+type Controller struct {
+	schema gql.Schema
+}
+
+// NewController creates a new instance of Controller with the schema over the recipe service
+func NewController(recipeService service.RecipeService) (*Controller, error) {
+	schema, err := NewSchema(recipeService)
+	if err != nil {
+		return nil, err
+	}
+	return &Controller{schema: schema}, nil
+}
+
+// Query godoc
+// @Summary Query recipes with GraphQL
+// @Description Run a GraphQL query against the recipes, templates and their ingredients, allergens and cost.
+// @Description Send the query as a JSON body with POST, or in the query, variables and operationName parameters with GET.
+// @Description Opening the endpoint in a browser serves a GraphiQL playground with the schema.
+// @Tags graphql
+// @Accept json
+// @Produce json,html
+// @Param request body Request false "GraphQL request"
+// @Param query query string false "GraphQL query, for GET requests"
+// @Param variables query string false "Variables of the query as a JSON object, for GET requests"
+// @Param operationName query string false "Operation to run if the query has several, for GET requests"
+// @Success 200
+// @Failure 400
+// @Router /graphql [post]
+// @Router /graphql [get]
+func (gc *Controller) Query(ctx *gin.Context) {
+	var req Request
+	if ctx.Request.Method == "GET" {
+		req.Query = ctx.Query("query")
+		req.OperationName = ctx.Query("operationName")
+		if req.Query == "" && ctx.NegotiateFormat(gin.MIMEJSON, gin.MIMEHTML) == gin.MIMEHTML {
+			ctx.Data(200, gin.MIMEHTML+"; charset=utf-8", playground)
+			return
+		}
+		if v := ctx.Query("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				ctx.JSON(400, gin.H{"error": "variables must be a JSON object"})
+				return
+			}
+		}
+	} else if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Query == "" {
+		ctx.JSON(400, gin.H{"error": "query is required"})
+		return
+	}
+
+	// Errors of the query are reported in the result, next to the data that could be resolved
+	result := gql.Do(gql.Params{
+		Schema:         gc.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        ctx,
+	})
+	ctx.JSON(200, result)
+}
+
+// Asset serves a file of the assets of the playground
+func (gc *Controller) Asset(ctx *gin.Context) {
+	name := strings.TrimPrefix(ctx.Param("file"), "/")
+	data, err := fs.ReadFile(assets, path.Join("assets", name))
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Asset not found"})
+		return
+	}
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+	ctx.Data(200, contentType, data)
+}
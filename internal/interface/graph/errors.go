@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// Error is an error of a resolver with a machine readable code in the extensions of the GraphQL error
+type Error struct {
+	err  error
+	code string
+}
+
+func (e *Error) Error() string { return e.err.Error() }
+
+func (e *Error) Unwrap() error { return e.err }
+
+// Extensions returns the code of the error, following the status codes of the REST API
+func (e *Error) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// graphError wraps an error returned by a service or a resolver with its code.
+// Missing resources are reported as NOT_FOUND, missing permissions as FORBIDDEN, invalid arguments and other
+// domain errors as BAD_USER_INPUT and anything else as INTERNAL_SERVER_ERROR.
+func graphError(err error) error {
+	code := "INTERNAL_SERVER_ERROR"
+	var recipeErr *recipe.Error
+	switch {
+	case errors.Is(err, recipe.ErrRecipeNotFound), errors.Is(err, recipe.ErrCacaoNotFound), errors.Is(err, recipe.ErrMoldNotFound):
+		code = "NOT_FOUND"
+	case errors.Is(err, recipe.ErrReviewerRequired):
+		code = "FORBIDDEN"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		code = "CANCELED"
+	case errors.As(err, &recipeErr), errors.Is(err, errInvalidFirst):
+		code = "BAD_USER_INPUT"
+	}
+	return &Error{err: err, code: code}
+}
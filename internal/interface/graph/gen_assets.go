@@ -0,0 +1,105 @@
+//go:build ignore
+
+// gen_assets downloads the GraphiQL and React bundles of the playground into the assets directory, where they are embedded
+// so the playground loads nothing from a CDN. The bundles are standalone builds without imports of their own.
+// Their SHA-384 checksums are kept in assets/SHA384SUMS: a download that does not match a recorded checksum is an error,
+// unless -update is given after changing a version. Commit the bundles together with their checksums.
+package main
+
+import (
+	"bufio"
+	"crypto/sha512"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// assets are the pinned bundles, by the name they are served under
+var assets = map[string]string{
+	"react.production.min.js":     "https://unpkg.com/react@18.3.1/umd/react.production.min.js",
+	"react-dom.production.min.js": "https://unpkg.com/react-dom@18.3.1/umd/react-dom.production.min.js",
+	"graphiql.min.js":             "https://unpkg.com/graphiql@3.8.3/graphiql.min.js",
+	"graphiql.min.css":            "https://unpkg.com/graphiql@3.8.3/graphiql.min.css",
+}
+
+const dir = "assets"
+
+func main() {
+	update := flag.Bool("update", false, "record the checksums of downloads that do not match instead of failing")
+	flag.Parse()
+
+	sums, err := readSums(filepath.Join(dir, "SHA384SUMS"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, url := range assets {
+		data, err := download(url)
+		if err != nil {
+			log.Fatalf("%s: %v", url, err)
+		}
+		sum := sha512.Sum384(data)
+		got := hex.EncodeToString(sum[:])
+		if want, ok := sums[name]; ok && want != got && !*update {
+			log.Fatalf("%s: checksum %s does not match the recorded %s, run with -update if the version changed", url, got, want)
+		}
+		sums[name] = got
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := writeSums(filepath.Join(dir, "SHA384SUMS"), sums); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func download(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// readSums reads a checksum file in the format of sha384sum, so the bundles can also be checked with sha384sum -c
+func readSums(path string) (map[string]string, error) {
+	sums := make(map[string]string)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return sums, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if sum, name, ok := strings.Cut(scanner.Text(), "  "); ok {
+			sums[name] = sum
+		}
+	}
+	return sums, scanner.Err()
+}
+
+func writeSums(path string, sums map[string]string) error {
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s  %s\n", sums[name], name)
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
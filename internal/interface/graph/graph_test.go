@@ -0,0 +1,318 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/memory"
+	"github.com/onasunnymorning/go-make-chocolate/internal/interface/rest"
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// newTestRouter serves the GraphQL endpoint over in-memory stores with three milk chocolate recipes
+func newTestRouter(t *testing.T) (*gin.Engine, []*recipe.Recipe) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	recipeService := service.NewRecipeService(memory.NewRecipeStore(), memory.NewCacaoStore(), memory.NewAuditStore(), memory.NewOutboxStore(), memory.NewTransactor())
+	var created []*recipe.Recipe
+	for i := 1; i <= 3; i++ {
+		rcp, err := recipeService.Create(context.Background(), &recipe.Recipe{
+			Name: fmt.Sprintf("Milk %d", i),
+			Ingredients: []recipe.Ingredient{
+				{Name: "Cacao nibs", IsCacao: true, Quantity: recipe.Quantity{Amount: 400, Unit: recipe.Gram}, CostPerKg: 20,
+					Origin: &recipe.Origin{Country: "Peru", Certifications: []recipe.Certification{recipe.Organic}}},
+				{Name: "Cane sugar", Quantity: recipe.Quantity{Amount: 350, Unit: recipe.Gram}, CostPerKg: 2},
+				{Name: "Whole milk powder", Quantity: recipe.Quantity{Amount: 200, Unit: recipe.Gram}, CostPerKg: 8},
+				{Name: "Roasted hazelnuts", Quantity: recipe.Quantity{Amount: 50, Unit: recipe.Gram}},
+			},
+			Instructions: "Conche for 48 hours.",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, rcp)
+	}
+
+	controller, err := NewController(recipeService)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.Use(rest.RequestID(), rest.Identity(actor.Policy{TrustIdentity: true}))
+	r.POST("/graphql", controller.Query)
+	r.GET("/graphql", controller.Query)
+	r.GET("/graphql/assets/*file", controller.Asset)
+	return r, created
+}
+
+type response struct {
+	Data   map[string]json.RawMessage
+	Errors []struct {
+		Message    string
+		Extensions map[string]string
+	}
+}
+
+func query(t *testing.T, r *gin.Engine, q string, variables map[string]interface{}) *response {
+	t.Helper()
+	body, _ := json.Marshal(Request{Query: q, Variables: variables})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	if w.Code != 200 {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body)
+	}
+	var resp response
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	return &resp
+}
+
+func TestRecipeQuery(t *testing.T) {
+	r, created := newTestRouter(t)
+	resp := query(t, r, `query($id: ID!) {
+		recipe(id: $id, yield: 500) {
+			id name status classification allergens cost costPerKg
+			yield { amount unit }
+			ingredients { name quantity { amount } cost allergens }
+			tempering { workTemperature }
+			singleOrigin { country certifications }
+			template { ingredients { percentage } }
+		}
+	}`, map[string]interface{}{"id": created[0].ID})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors %+v", resp.Errors)
+	}
+
+	var got struct {
+		ID             string
+		Name           string
+		Status         string
+		Classification string
+		Allergens      []string
+		Cost           float64
+		CostPerKg      float64
+		Yield          struct{ Amount float64 }
+		Ingredients    []struct {
+			Name      string
+			Quantity  struct{ Amount float64 }
+			Cost      float64
+			Allergens []string
+		}
+		Tempering    struct{ WorkTemperature float64 }
+		SingleOrigin struct {
+			Country        string
+			Certifications []string
+		}
+		Template struct {
+			Ingredients []struct{ Percentage float64 }
+		}
+	}
+	if err := json.Unmarshal(resp.Data["recipe"], &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != created[0].ID || got.Name != "Milk 1" || got.Status != "DRAFT" || got.Classification != "MILK" {
+		t.Errorf("expected the stored recipe scaled, got %+v", got)
+	}
+	if strings.Join(got.Allergens, ",") != "MILK,TREE_NUTS" {
+		t.Errorf("expected milk and tree nuts, got %v", got.Allergens)
+	}
+	if got.Yield.Amount != 500 || got.Ingredients[0].Quantity.Amount != 200 || got.Ingredients[2].Allergens[0] != "MILK" {
+		t.Errorf("expected the ingredients for 500 g, got %+v", got.Ingredients)
+	}
+	// 200 g nibs at 20, 175 g sugar at 2 and 100 g milk powder at 8
+	if math.Abs(got.Cost-5.15) > 1e-9 || math.Abs(got.CostPerKg-10.3) > 1e-9 || got.Ingredients[0].Cost != 4 {
+		t.Errorf("expected a cost of 5.15 or 10.3 per kg, got %v and %v", got.Cost, got.CostPerKg)
+	}
+	if got.Tempering.WorkTemperature != 29 || got.SingleOrigin.Country != "Peru" || got.SingleOrigin.Certifications[0] != "ORGANIC" {
+		t.Errorf("unexpected tempering or origin %+v", got)
+	}
+	if got.Template.Ingredients[0].Percentage != 40 {
+		t.Errorf("unexpected template %+v", got.Template)
+	}
+
+	resp = query(t, r, `{ recipe(id: "missing") { id } template(id: "missing") { name } }`, nil)
+	if len(resp.Errors) > 0 || string(resp.Data["recipe"]) != "null" || string(resp.Data["template"]) != "null" {
+		t.Errorf("expected null for a missing recipe, got %+v", resp)
+	}
+
+	resp = query(t, r, `query($id: ID!) { recipe(id: $id, yield: -1) { id } }`, map[string]interface{}{"id": created[0].ID})
+	if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "BAD_USER_INPUT" {
+		t.Errorf("expected an invalid yield error, got %+v", resp.Errors)
+	}
+}
+
+func TestTemplateQuery(t *testing.T) {
+	r, created := newTestRouter(t)
+	resp := query(t, r, `query($id: ID!) {
+		template(id: $id) {
+			recipeId allergens
+			recipe(yield: 2000) { ingredients { quantity { amount } } }
+		}
+	}`, map[string]interface{}{"id": created[1].ID})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors %+v", resp.Errors)
+	}
+	var got struct {
+		RecipeID  string
+		Allergens []string
+		Recipe    struct {
+			Ingredients []struct{ Quantity struct{ Amount float64 } }
+		}
+	}
+	if err := json.Unmarshal(resp.Data["template"], &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.RecipeID != created[1].ID || len(got.Allergens) != 2 || got.Recipe.Ingredients[0].Quantity.Amount != 800 {
+		t.Errorf("unexpected template %+v", got)
+	}
+}
+
+func TestRecipesQuery(t *testing.T) {
+	r, _ := newTestRouter(t)
+	const q = `query($after: String) {
+		recipes(first: 2, after: $after, status: DRAFT, certification: ORGANIC) {
+			edges { cursor node { name } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+	type page struct {
+		Edges []struct {
+			Cursor string
+			Node   struct{ Name string }
+		}
+		PageInfo struct {
+			HasNextPage bool
+			EndCursor   *string
+		}
+	}
+
+	var names []string
+	var after interface{}
+	for i := 0; ; i++ {
+		resp := query(t, r, q, map[string]interface{}{"after": after})
+		if len(resp.Errors) > 0 {
+			t.Fatalf("unexpected errors %+v", resp.Errors)
+		}
+		var p page
+		if err := json.Unmarshal(resp.Data["recipes"], &p); err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range p.Edges {
+			names = append(names, e.Node.Name)
+		}
+		if !p.PageInfo.HasNextPage {
+			break
+		}
+		if i > 2 {
+			t.Fatal("expected the pages to end")
+		}
+		after = *p.PageInfo.EndCursor
+	}
+	if strings.Join(names, ",") != "Milk 1,Milk 2,Milk 3" {
+		t.Errorf("expected every recipe once, got %v", names)
+	}
+
+	resp := query(t, r, `{ recipes(sort: NAME, descending: true, name: "milk", minCacao: 30) { totalCount nodes { name } } }`, nil)
+	if string(resp.Data["recipes"]) != `{"nodes":[{"name":"Milk 3"},{"name":"Milk 2"},{"name":"Milk 1"}],"totalCount":3}` {
+		t.Errorf("expected the recipes by name descending, got %s %+v", resp.Data["recipes"], resp.Errors)
+	}
+
+	resp = query(t, r, `{ recipes(originCountry: "Ghana") { nodes { id } } }`, nil)
+	if string(resp.Data["recipes"]) != `{"nodes":[]}` {
+		t.Errorf("expected no recipes from Ghana, got %s", resp.Data["recipes"])
+	}
+
+	resp = query(t, r, `{ recipes(after: "bogus") { nodes { id } } }`, nil)
+	if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "BAD_USER_INPUT" {
+		t.Errorf("expected an invalid cursor error, got %+v", resp.Errors)
+	}
+}
+
+func TestQueryGET(t *testing.T) {
+	r, _ := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(`{ recipes { nodes { name } } }`), nil)
+	r.ServeHTTP(w, req)
+	if w.Code != 200 || !strings.Contains(w.Body.String(), `"Milk 3"`) {
+		t.Errorf("expected the recipes, got %d: %s", w.Code, w.Body)
+	}
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/graphql", nil)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	r.ServeHTTP(w, req)
+	if w.Code != 200 || !strings.Contains(w.Body.String(), "GraphiQL") {
+		t.Errorf("expected the playground, got %d", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	if w.Code != 400 {
+		t.Errorf("expected 400 without a query, got %d", w.Code)
+	}
+}
+
+func TestPlaygroundAssets(t *testing.T) {
+	page := string(playground)
+	if remote := regexp.MustCompile(`(?:src|href)="(?:https?:)?//[^"]*"`).FindAllString(page, -1); len(remote) > 0 {
+		t.Errorf("expected the playground to load nothing from a CDN, got %v", remote)
+	}
+
+	// Every vendored asset must match its recorded checksum
+	sums, err := assets.ReadFile("assets/SHA384SUMS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendored := make(map[string]bool)
+	for _, line := range strings.Split(strings.TrimSpace(string(sums)), "\n") {
+		sum, name, ok := strings.Cut(line, "  ")
+		if !ok {
+			continue
+		}
+		data, err := assets.ReadFile("assets/" + name)
+		if err != nil {
+			t.Errorf("expected %s to be vendored: %v", name, err)
+			continue
+		}
+		if got := sha512.Sum384(data); hex.EncodeToString(got[:]) != sum {
+			t.Errorf("expected %s to match its checksum", name)
+		}
+		vendored[name] = true
+	}
+
+	r, _ := newTestRouter(t)
+	for _, m := range regexp.MustCompile(`(?:src|href)="/graphql/assets/([^"]+)"`).FindAllStringSubmatch(page, -1) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql/assets/"+m[1], nil))
+		if vendored[m[1]] && w.Code != 200 {
+			t.Errorf("expected %s to be served, got %d", m[1], w.Code)
+		}
+		if !vendored[m[1]] {
+			t.Logf("%s is not vendored, run go generate ./internal/interface/graph", m[1])
+		}
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql/assets/../controller.go", nil))
+	if w.Code != 404 {
+		t.Errorf("expected only assets to be served, got %d", w.Code)
+	}
+}
@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"errors"
+
+	gql "github.com/graphql-go/graphql"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// errInvalidFirst is returned when the page size of the recipes query is out of range
+var errInvalidFirst = errors.New("first must be between 1 and 100")
+
+// resolver resolves the fields of the Query type from the recipe service
+type resolver struct {
+	recipeService service.RecipeService
+}
+
+// recipe resolves a recipe by ID, scaled to the yield argument if one is given
+func (r *resolver) recipe(p gql.ResolveParams) (interface{}, error) {
+	rcp, err := r.recipeService.GetByID(p.Context, p.Args["id"].(string))
+	if err != nil {
+		return nil, graphError(err)
+	}
+	if rcp == nil {
+		return nil, nil
+	}
+	if yield, ok := p.Args["yield"].(float64); ok {
+		if rcp, err = scale(rcp, yield); err != nil {
+			return nil, graphError(err)
+		}
+	}
+	return rcp, nil
+}
+
+// template resolves the template of a recipe by ID
+func (r *resolver) template(p gql.ResolveParams) (interface{}, error) {
+	tr, err := r.recipeService.GetTemplateByID(p.Context, p.Args["id"].(string))
+	if errors.Is(err, recipe.ErrRecipeNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, graphError(err)
+	}
+	return tr, nil
+}
+
+// templateRecipe resolves the recipe field of a template
+func (r *resolver) templateRecipe(p gql.ResolveParams) (interface{}, error) {
+	rcp := p.Source.(*recipe.TemplateRecipe).ToRecipe(p.Args["yield"].(float64))
+	if rcp == nil {
+		return nil, graphError(recipe.ErrInvalidYield)
+	}
+	return rcp, nil
+}
+
+// connection is a page of recipes in the shape of the GraphQL cursor connections specification
+type connection struct {
+	Edges      []edge
+	Nodes      []*recipe.Recipe
+	PageInfo   pageInfo
+	TotalCount int64
+}
+
+type edge struct {
+	Cursor string
+	Node   *recipe.Recipe
+}
+
+type pageInfo struct {
+	HasNextPage bool
+	EndCursor   *string
+}
+
+// recipes resolves a page of the recipes that match the filter arguments in the sort order
+func (r *resolver) recipes(p gql.ResolveParams) (interface{}, error) {
+	first, _ := p.Args["first"].(int)
+	if first < 1 || first > recipe.MaxPageSize {
+		return nil, graphError(errInvalidFirst)
+	}
+	opts := recipe.ListOptions{Limit: int64(first)}
+	opts.Sort.Field, _ = p.Args["sort"].(recipe.SortField)
+	opts.Sort.Descending, _ = p.Args["descending"].(bool)
+	if after, ok := p.Args["after"].(string); ok {
+		cursor, err := recipe.ParseCursor(after, opts.Sort)
+		if err != nil {
+			return nil, graphError(err)
+		}
+		opts.After = &cursor
+	}
+	opts.Filter.Name, _ = p.Args["name"].(string)
+	opts.Filter.CreatedBy, _ = p.Args["createdBy"].(string)
+	opts.Filter.MinCacao, _ = p.Args["minCacao"].(float64)
+	opts.Filter.MaxCacao, _ = p.Args["maxCacao"].(float64)
+	opts.Filter.Status, _ = p.Args["status"].(recipe.Status)
+	opts.Filter.OriginCountry, _ = p.Args["originCountry"].(string)
+	opts.Filter.OriginRegion, _ = p.Args["originRegion"].(string)
+	opts.Filter.Variety, _ = p.Args["variety"].(string)
+	opts.Filter.Certification, _ = p.Args["certification"].(recipe.Certification)
+
+	page, err := r.recipeService.List(p.Context, opts)
+	if err != nil {
+		return nil, graphError(err)
+	}
+	conn := &connection{Edges: []edge{}, Nodes: []*recipe.Recipe{}, TotalCount: page.Total}
+	conn.PageInfo.HasNextPage = page.NextCursor != ""
+	yield, scaled := p.Args["yield"].(float64)
+	for _, rcp := range page.Recipes {
+		cursor := recipe.NewCursor(rcp, opts.Sort).Encode()
+		if scaled {
+			if rcp, err = scale(rcp, yield); err != nil {
+				return nil, graphError(err)
+			}
+		}
+		conn.Edges = append(conn.Edges, edge{Cursor: cursor, Node: rcp})
+		conn.Nodes = append(conn.Nodes, rcp)
+		conn.PageInfo.EndCursor = &cursor
+	}
+	return conn, nil
+}
+
+// scale returns a copy of the recipe with the ingredients recalculated for the yield in grams.
+// Unlike the scaled recipes of the REST API it keeps the name, status and history of the stored recipe.
+func scale(rcp *recipe.Recipe, yield float64) (*recipe.Recipe, error) {
+	scaled := rcp.ToTemplate().ToRecipe(yield)
+	if scaled == nil {
+		return nil, recipe.ErrInvalidYield
+	}
+	out := *rcp
+	out.Ingredients = scaled.Ingredients
+	out.Yield = scaled.Yield
+	out.Input = scaled.Input
+	return &out, nil
+}
@@ -0,0 +1,309 @@
+// Package graph serves the recipes over GraphQL, so clients can fetch a recipe together with its scaled ingredients,
+// allergens and cost in one request and select only the fields they need. It is read-only and built on the same
+// services as the REST API of the rest package.
+package graph
+
+import (
+	"strings"
+
+	gql "github.com/graphql-go/graphql"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// defaultFirst is the page size of the recipes query when first is not set
+const defaultFirst = 10
+
+// NewSchema creates the GraphQL schema over the recipe service
+func NewSchema(recipeService service.RecipeService) (gql.Schema, error) {
+	r := &resolver{recipeService: recipeService}
+	t := newTypes(r)
+	query := gql.NewObject(gql.ObjectConfig{
+		Name: "Query",
+		Fields: gql.Fields{
+			"recipe": &gql.Field{
+				Type:        t.recipe,
+				Description: "A recipe by ID, scaled to the yield in grams when one is given. Null if the recipe does not exist.",
+				Args: gql.FieldConfigArgument{
+					"id":    &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+					"yield": &gql.ArgumentConfig{Type: gql.Float, Description: "Yield in grams to scale the recipe to"},
+				},
+				Resolve: r.recipe,
+			},
+			"template": &gql.Field{
+				Type:        t.template,
+				Description: "The template of a recipe by ID, with the ingredients as percentages. Null if the recipe does not exist.",
+				Args: gql.FieldConfigArgument{
+					"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+				},
+				Resolve: r.template,
+			},
+			"recipes": &gql.Field{
+				Type:        gql.NewNonNull(t.recipeConnection),
+				Description: "Recipes matching the filter in the sort order, a page at a time. Pass the endCursor of a page as after to get the next page.",
+				Args: gql.FieldConfigArgument{
+					"first":         &gql.ArgumentConfig{Type: gql.Int, DefaultValue: defaultFirst, Description: "Number of recipes in the page, at most 100"},
+					"after":         &gql.ArgumentConfig{Type: gql.String, Description: "Cursor of the recipe to start after, from the same sort order"},
+					"sort":          &gql.ArgumentConfig{Type: t.sortField, DefaultValue: recipe.SortByCreated},
+					"descending":    &gql.ArgumentConfig{Type: gql.Boolean, DefaultValue: false},
+					"yield":         &gql.ArgumentConfig{Type: gql.Float, Description: "Yield in grams to scale every recipe to"},
+					"name":          &gql.ArgumentConfig{Type: gql.String, Description: "Part of the name of the recipe"},
+					"createdBy":     &gql.ArgumentConfig{Type: gql.String},
+					"minCacao":      &gql.ArgumentConfig{Type: gql.Float},
+					"maxCacao":      &gql.ArgumentConfig{Type: gql.Float},
+					"status":        &gql.ArgumentConfig{Type: t.status},
+					"originCountry": &gql.ArgumentConfig{Type: gql.String},
+					"originRegion":  &gql.ArgumentConfig{Type: gql.String},
+					"variety":       &gql.ArgumentConfig{Type: gql.String},
+					"certification": &gql.ArgumentConfig{Type: t.certification},
+				},
+				Resolve: r.recipes,
+			},
+		},
+	})
+	return gql.NewSchema(gql.SchemaConfig{Query: query})
+}
+
+// types holds the GraphQL types of the schema
+type types struct {
+	status           *gql.Enum
+	sortField        *gql.Enum
+	classification   *gql.Enum
+	certification    *gql.Enum
+	allergen         *gql.Enum
+	quantity         *gql.Object
+	origin           *gql.Object
+	processLoss      *gql.Object
+	temperingCurve   *gql.Object
+	nutrition        *gql.Object
+	ingredient       *gql.Object
+	templateIngr     *gql.Object
+	recipe           *gql.Object
+	template         *gql.Object
+	recipeConnection *gql.Object
+}
+
+func newTypes(r *resolver) *types {
+	t := &types{}
+	t.status = enum("Status", "Lifecycle status of a recipe", recipe.Draft, recipe.InReview, recipe.Approved, recipe.Archived)
+	t.sortField = enum("RecipeSortField", "Field to sort recipes by, recipes with the same value are sorted by ID",
+		recipe.SortByName, recipe.SortByCreated, recipe.SortByUpdated, recipe.SortByCacao)
+	t.classification = enum("Classification", "Type of chocolate a recipe produces", recipe.Dark, recipe.Milk, recipe.White)
+	t.certification = enum("Certification", "Certification held by a cacao producer", recipe.SupportedCertifications()...)
+	t.allergen = enum("Allergen", "Major food allergen detected in the name of an ingredient",
+		recipe.AllergenMilk, recipe.AllergenSoy, recipe.AllergenTreeNuts, recipe.AllergenPeanuts, recipe.AllergenGluten, recipe.AllergenEggs, recipe.AllergenSesame)
+
+	t.quantity = gql.NewObject(gql.ObjectConfig{
+		Name: "Quantity",
+		Fields: gql.Fields{
+			"amount": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"unit":   &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"text": &gql.Field{Type: gql.NewNonNull(gql.String), Description: "Amount and unit, e.g. 700 grams",
+				Resolve: func(p gql.ResolveParams) (interface{}, error) { return p.Source.(recipe.Quantity).String(), nil }},
+		},
+	})
+	t.origin = gql.NewObject(gql.ObjectConfig{
+		Name:        "Origin",
+		Description: "Where and how cacao beans were grown and processed",
+		Fields: gql.Fields{
+			"country":        &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"region":         &gql.Field{Type: gql.String},
+			"producer":       &gql.Field{Type: gql.String},
+			"variety":        &gql.Field{Type: gql.String},
+			"harvestYear":    &gql.Field{Type: gql.Int},
+			"fermentation":   &gql.Field{Type: gql.String},
+			"drying":         &gql.Field{Type: gql.String},
+			"certifications": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.certification)))},
+		},
+	})
+	t.processLoss = gql.NewObject(gql.ObjectConfig{
+		Name: "ProcessLoss",
+		Fields: gql.Fields{
+			"stage":      &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"percentage": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+		},
+	})
+	t.temperingCurve = gql.NewObject(gql.ObjectConfig{
+		Name:        "TemperingCurve",
+		Description: "Temperatures in degrees Celsius of the tempering process",
+		Fields: gql.Fields{
+			"meltTemperature": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"coolTemperature": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"workTemperature": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+		},
+	})
+	t.nutrition = gql.NewObject(gql.ObjectConfig{
+		Name:        "Nutrition",
+		Description: "Nutrition facts per 100 g, in kcal for energy, mg for sodium and g for everything else",
+		Fields: gql.Fields{
+			"energy":       &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"fat":          &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"saturatedFat": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"carbohydrate": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"sugar":        &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"protein":      &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"fiber":        &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"sodium":       &gql.Field{Type: gql.NewNonNull(gql.Float)},
+		},
+	})
+	t.ingredient = gql.NewObject(gql.ObjectConfig{
+		Name: "Ingredient",
+		Fields: gql.Fields{
+			"name":      &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"isCacao":   &gql.Field{Type: gql.NewNonNull(gql.Boolean)},
+			"quantity":  &gql.Field{Type: gql.NewNonNull(t.quantity)},
+			"cacaoId":   &gql.Field{Type: gql.ID},
+			"origin":    &gql.Field{Type: t.origin},
+			"costPerKg": &gql.Field{Type: gql.NewNonNull(gql.Float), Description: "Purchase price per kilogram, 0 if unknown"},
+			"cost": &gql.Field{Type: gql.NewNonNull(gql.Float), Description: "Cost of the quantity, 0 if the price is unknown or the quantity is a volume",
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return (&recipe.Recipe{Ingredients: []recipe.Ingredient{p.Source.(recipe.Ingredient)}}).Cost(), nil
+				}},
+			"allergens": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.allergen))),
+				Resolve: func(p gql.ResolveParams) (interface{}, error) { return p.Source.(recipe.Ingredient).Allergens(), nil }},
+		},
+	})
+	t.templateIngr = gql.NewObject(gql.ObjectConfig{
+		Name: "TemplateIngredient",
+		Fields: gql.Fields{
+			"name":       &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"isCacao":    &gql.Field{Type: gql.NewNonNull(gql.Boolean)},
+			"percentage": &gql.Field{Type: gql.NewNonNull(gql.Float), Description: "Percentage of the ingredient in the recipe"},
+			"cacaoId":    &gql.Field{Type: gql.ID},
+			"origin":     &gql.Field{Type: t.origin},
+			"costPerKg":  &gql.Field{Type: gql.NewNonNull(gql.Float)},
+			"allergens": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.allergen))),
+				Resolve: func(p gql.ResolveParams) (interface{}, error) {
+					return recipe.Ingredient{Name: p.Source.(recipe.TemplateIngredient).Name}.Allergens(), nil
+				}},
+		},
+	})
+
+	// Recipes and templates refer to each other, so their fields are created when the schema is built
+	t.recipe = gql.NewObject(gql.ObjectConfig{
+		Name:   "Recipe",
+		Fields: gql.FieldsThunk(func() gql.Fields { return t.recipeFields(r) }),
+	})
+	t.template = gql.NewObject(gql.ObjectConfig{
+		Name:        "Template",
+		Description: "A recipe with the ingredients as percentages, to scale it to any yield",
+		Fields:      gql.FieldsThunk(func() gql.Fields { return t.templateFields(r) }),
+	})
+
+	edge := gql.NewObject(gql.ObjectConfig{
+		Name: "RecipeEdge",
+		Fields: gql.Fields{
+			"cursor": &gql.Field{Type: gql.NewNonNull(gql.String)},
+			"node":   &gql.Field{Type: gql.NewNonNull(t.recipe)},
+		},
+	})
+	pageInfo := gql.NewObject(gql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: gql.Fields{
+			"hasNextPage": &gql.Field{Type: gql.NewNonNull(gql.Boolean)},
+			"endCursor":   &gql.Field{Type: gql.String},
+		},
+	})
+	t.recipeConnection = gql.NewObject(gql.ObjectConfig{
+		Name: "RecipeConnection",
+		Fields: gql.Fields{
+			"edges":      &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(edge)))},
+			"nodes":      &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.recipe)))},
+			"pageInfo":   &gql.Field{Type: gql.NewNonNull(pageInfo)},
+			"totalCount": &gql.Field{Type: gql.NewNonNull(gql.Int), Description: "Number of recipes matching the filter across all pages"},
+		},
+	})
+	return t
+}
+
+func (t *types) recipeFields(r *resolver) gql.Fields {
+	source := func(p gql.ResolveParams) *recipe.Recipe { return p.Source.(*recipe.Recipe) }
+	return gql.Fields{
+		"id":           &gql.Field{Type: gql.NewNonNull(gql.ID)},
+		"name":         &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"description":  &gql.Field{Type: gql.String},
+		"instructions": &gql.Field{Type: gql.String},
+		"status": &gql.Field{Type: gql.NewNonNull(t.status),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				// Recipes created before the lifecycle was introduced are drafts
+				if s := source(p).Status; s != "" {
+					return s, nil
+				}
+				return recipe.Draft, nil
+			}},
+		"revision":        &gql.Field{Type: gql.NewNonNull(gql.Int)},
+		"revisionOf":      &gql.Field{Type: gql.ID},
+		"cacaoPercentage": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+		"classification": &gql.Field{Type: gql.NewNonNull(t.classification), Description: "Type of chocolate, inferred from the ingredients when not set",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).ResolveClassification(), nil }},
+		"yield":       &gql.Field{Type: gql.NewNonNull(t.quantity), Description: "Batch size, net of process losses"},
+		"input":       &gql.Field{Type: gql.NewNonNull(t.quantity), Description: "Total gross input before process losses"},
+		"losses":      &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.processLoss)))},
+		"ingredients": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.ingredient)))},
+		"allergens": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.allergen))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).Allergens(), nil }},
+		"cost": &gql.Field{Type: gql.NewNonNull(gql.Float), Description: "Cost of the ingredients with a known price",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).Cost(), nil }},
+		"costPerKg": &gql.Field{Type: gql.NewNonNull(gql.Float), Description: "Cost of the ingredients per kilogram of yield",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).CostPerKg(), nil }},
+		"tempering": &gql.Field{Type: t.temperingCurve, Description: "Tempering curve for the classification with the overrides of the recipe applied",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				curve, err := source(p).TemperingCurve()
+				if err != nil {
+					return nil, graphError(err)
+				}
+				return curve, nil
+			}},
+		"nutrition": &gql.Field{Type: t.nutrition},
+		"origins": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.origin))), Description: "Distinct origins of the cacao ingredients",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).Origins(), nil }},
+		"singleOrigin": &gql.Field{Type: t.origin, Description: "The origin all cacao ingredients share, null for blends",
+			Resolve: func(p gql.ResolveParams) (interface{}, error) {
+				if o, ok := source(p).SingleOrigin(); ok {
+					return o, nil
+				}
+				return nil, nil
+			}},
+		"template": &gql.Field{Type: gql.NewNonNull(t.template),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).ToTemplate(), nil }},
+		"createdAt":  &gql.Field{Type: gql.DateTime},
+		"createdBy":  &gql.Field{Type: gql.String},
+		"updatedAt":  &gql.Field{Type: gql.DateTime},
+		"updatedBy":  &gql.Field{Type: gql.String},
+		"approvedAt": &gql.Field{Type: gql.DateTime},
+		"approvedBy": &gql.Field{Type: gql.String},
+	}
+}
+
+func (t *types) templateFields(r *resolver) gql.Fields {
+	source := func(p gql.ResolveParams) *recipe.TemplateRecipe { return p.Source.(*recipe.TemplateRecipe) }
+	return gql.Fields{
+		"recipeId":        &gql.Field{Type: gql.NewNonNull(gql.ID)},
+		"name":            &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"description":     &gql.Field{Type: gql.String},
+		"instructions":    &gql.Field{Type: gql.String},
+		"cacaoPercentage": &gql.Field{Type: gql.NewNonNull(gql.Float)},
+		"classification":  &gql.Field{Type: t.classification, Description: "Type of chocolate, null when it is inferred from the ingredients"},
+		"losses":          &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.processLoss)))},
+		"ingredients":     &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.templateIngr)))},
+		"allergens": &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(t.allergen))),
+			Resolve: func(p gql.ResolveParams) (interface{}, error) { return source(p).Allergens(), nil }},
+		"tempering": &gql.Field{Type: t.temperingCurve, Description: "Recipe specific overrides of the default tempering curve"},
+		"nutrition": &gql.Field{Type: t.nutrition},
+		"recipe": &gql.Field{Type: gql.NewNonNull(t.recipe), Description: "The recipe with the quantities for the yield in grams",
+			Args: gql.FieldConfigArgument{
+				"yield": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.Float)},
+			},
+			Resolve: r.templateRecipe},
+	}
+}
+
+// enum creates an enum type whose values are the constants of a domain type, named after them in upper case
+func enum[T ~string](name, description string, values ...T) *gql.Enum {
+	config := gql.EnumValueConfigMap{}
+	for _, v := range values {
+		config[strings.ToUpper(string(v))] = &gql.EnumValueConfig{Value: v}
+	}
+	return gql.NewEnum(gql.EnumConfig{Name: name, Description: description, Values: config})
+}
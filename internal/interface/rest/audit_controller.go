@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	gin "github.com/gin-gonic/gin"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	audit "github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+	bulk "github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+)
+
+// AuditController handles HTTP requests related to the audit log
+type AuditController struct {
+	auditService service.AuditService
+}
+
+// NewAuditController creates a new instance of AuditController
+func NewAuditController(auditService service.AuditService) *AuditController {
+	return &AuditController{
+		auditService: auditService,
+	}
+}
+
+// ListAuditEvents godoc
+// @Summary List audit events
+// @Description List the audit log of changes with pagination, most recent first.
+// @Description When the Accept header asks for application/x-ndjson, every matching event is exported as JSON lines in chronological order, ignoring pagination.
+// @Tags audit
+// @Produce json,application/x-ndjson
+// @Param resource_type query string false "Type of the changed resource" Enums(recipe)
+// @Param resource_id query string false "ID of the changed resource"
+// @Param actor query string false "User who made the change"
+// @Param action query string false "Kind of change" Enums(create, update, delete, restore, approve, status_change)
+// @Param request_id query string false "ID of the request that made the change"
+// @Param from query string false "Only changes at or after this time (RFC 3339)"
+// @Param to query string false "Only changes before this time (RFC 3339)"
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} audit.Event
+// @Failure 400
+// @Failure 500
+// @Router /audit [get]
+func (ac *AuditController) ListAuditEvents(ctx *gin.Context) {
+	filter, err := auditFilter(ctx)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	if ctx.NegotiateFormat(gin.MIMEJSON, bulk.MIMEJSONLines) == bulk.MIMEJSONLines {
+		ctx.Header("Content-Type", bulk.MIMEJSONLines)
+		ctx.Header("Content-Disposition", `attachment; filename="audit.jsonl"`)
+		ctx.Status(200)
+		w := audit.NewJSONLWriter(ctx.Writer)
+		err := ac.auditService.Export(ctx, filter, func(e *audit.Event) error {
+			if err := w.Write(e); err != nil {
+				return err
+			}
+			ctx.Writer.Flush()
+			return nil
+		})
+		if err != nil {
+			_ = ctx.Error(err)
+		}
+		return
+	}
+
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(ctx.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	events, err := ac.auditService.List(ctx, filter, limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, events)
+}
+
+// auditFilter builds an audit.Filter from the query parameters
+func auditFilter(ctx *gin.Context) (audit.Filter, error) {
+	filter := audit.Filter{
+		ResourceType: ctx.Query("resource_type"),
+		ResourceID:   ctx.Query("resource_id"),
+		Actor:        ctx.Query("actor"),
+		Action:       audit.Action(ctx.Query("action")),
+		RequestID:    ctx.Query("request_id"),
+	}
+	for param, t := range map[string]*time.Time{"from": &filter.From, "to": &filter.To} {
+		if v := ctx.Query(param); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, fmt.Errorf("Invalid %s time, expected RFC 3339", param)
+			}
+			*t = parsed
+		}
+	}
+	return filter, nil
+}
@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+)
+
+// BatchController handles HTTP requests related to production batches
+type BatchController struct {
+	batchService service.BatchService
+}
+
+// NewBatchController creates a new instance of BatchController
+func NewBatchController(batchService service.BatchService) *BatchController {
+	return &BatchController{
+		batchService: batchService,
+	}
+}
+
+// GetBatchByID godoc
+// @Summary Get a Batch by ID
+// @Description Get a production Batch with its scaled recipe by ID
+// @Tags production
+// @Produce json
+// @Param id path string true "Batch ID"
+// @Success 200 {object} production.Batch
+// @Failure 404
+// @Failure 500
+// @Router /batch/{id} [get]
+func (bc *BatchController) GetBatchByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	batch, err := bc.batchService.GetByID(ctx, id)
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Batch not found"})
+		return
+	}
+
+	ctx.JSON(200, batch)
+}
+
+// CreateBatch godoc
+// @Summary Start a new production Batch
+// @Description Start a production Batch of a recipe at the given yield. Rejected bean lots cannot be used.
+// @Tags production
+// @Accept json
+// @Produce json
+// @Param batch body command.BatchRequest true "Batch Request"
+// @Success 201 {object} production.Batch
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /batch [post]
+func (bc *BatchController) CreateBatch(ctx *gin.Context) {
+	var req command.BatchRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	batch, err := bc.batchService.Create(ctx, req.RecipeID, req.Yield, req.BeanLotIDs, req.Notes)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, batch)
+}
+
+// ListBatches godoc
+// @Summary List Batches
+// @Description List production Batches with pagination, most recent first
+// @Tags production
+// @Produce json
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} production.Batch
+// @Failure 500
+// @Router /batch [get]
+func (bc *BatchController) ListBatches(ctx *gin.Context) {
+	limitStr := ctx.DefaultQuery("limit", "10")
+	offsetStr := ctx.DefaultQuery("offset", "0")
+
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	batches, err := bc.batchService.List(ctx, limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, batches)
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	quality "github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+)
+
+// BeanLotController handles HTTP requests related to bean lots and their quality inspections
+type BeanLotController struct {
+	qualityService service.QualityService
+}
+
+// NewBeanLotController creates a new instance of BeanLotController
+func NewBeanLotController(qualityService service.QualityService) *BeanLotController {
+	return &BeanLotController{
+		qualityService: qualityService,
+	}
+}
+
+// GetBeanLotByID godoc
+// @Summary Get a Bean Lot by ID
+// @Description Get a Bean Lot with its quality inspections and grade by ID
+// @Tags quality
+// @Produce json
+// @Param id path string true "Bean Lot ID"
+// @Success 200 {object} quality.BeanLot
+// @Failure 404
+// @Failure 500
+// @Router /lot/{id} [get]
+func (bc *BeanLotController) GetBeanLotByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	lot, err := bc.qualityService.GetLotByID(ctx, id)
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Bean lot not found"})
+		return
+	}
+
+	ctx.JSON(200, lot)
+}
+
+// CreateBeanLot godoc
+// @Summary Register a new Bean Lot
+// @Description Register a newly received Bean Lot. The lot is ungraded until it is inspected.
+// @Tags quality
+// @Accept json
+// @Produce json
+// @Param lot body command.BeanLotRequest true "Bean Lot Request"
+// @Success 201 {object} quality.BeanLot
+// @Failure 400
+// @Failure 500
+// @Router /lot [post]
+func (bc *BeanLotController) CreateBeanLot(ctx *gin.Context) {
+	var req command.BeanLotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	lot := &quality.BeanLot{
+		LotNumber:  req.LotNumber,
+		CacaoID:    req.CacaoID,
+		Weight:     req.Weight,
+		ReceivedAt: req.ReceivedAt,
+	}
+
+	createdLot, err := bc.qualityService.CreateLot(ctx, lot)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, createdLot)
+}
+
+// InspectBeanLot godoc
+// @Summary Record a quality inspection
+// @Description Record the cut test and moisture measurement of a Bean Lot, inspected by the user making the request. The lot is graded according to the configured thresholds.
+// @Tags quality
+// @Accept json
+// @Produce json
+// @Param id path string true "Bean Lot ID"
+// @Param inspection body command.InspectionRequest true "Inspection Request"
+// @Success 200 {object} quality.BeanLot
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /lot/{id}/inspection [post]
+func (bc *BeanLotController) InspectBeanLot(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req command.InspectionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	lot, err := bc.qualityService.Inspect(ctx, id, req.CutTest(), req.Moisture, req.Notes)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, lot)
+}
+
+// ListBeanLots godoc
+// @Summary List Bean Lots
+// @Description List Bean Lots with pagination, most recently received first
+// @Tags quality
+// @Produce json
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} quality.BeanLot
+// @Failure 500
+// @Router /lot [get]
+func (bc *BeanLotController) ListBeanLots(ctx *gin.Context) {
+	limitStr := ctx.DefaultQuery("limit", "10")
+	offsetStr := ctx.DefaultQuery("offset", "0")
+
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	lots, err := bc.qualityService.ListLots(ctx, limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, lots)
+}
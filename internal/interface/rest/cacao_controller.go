@@ -0,0 +1,181 @@
+package rest
+
+import (
+	"errors"
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	recipe "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// CacaoController handles HTTP requests related to the cacao catalog
+type CacaoController struct {
+	cacaoService service.CacaoService
+}
+
+// NewCacaoController creates a new instance of CacaoController
+func NewCacaoController(cacaoService service.CacaoService) *CacaoController {
+	return &CacaoController{
+		cacaoService: cacaoService,
+	}
+}
+
+// GetCacaoByID godoc
+// @Summary Get a Cacao by ID
+// @Description Get an entry from the cacao catalog by ID
+// @Tags cacao
+// @Produce json
+// @Param id path string true "Cacao ID"
+// @Success 200 {object} recipe.Cacao
+// @Failure 404
+// @Failure 500
+// @Router /cacao/{id} [get]
+func (cc *CacaoController) GetCacaoByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	cacao, err := cc.cacaoService.GetByID(ctx, id)
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Cacao not found"})
+		return
+	}
+
+	ctx.JSON(200, cacao)
+}
+
+// CreateCacao godoc
+// @Summary Create a new Cacao
+// @Description Add new cacao with its origin to the cacao catalog
+// @Tags cacao
+// @Accept json
+// @Produce json
+// @Param cacao body command.CacaoRequest true "Cacao Request"
+// @Success 201 {object} recipe.Cacao
+// @Failure 400
+// @Failure 500
+// @Router /cacao [post]
+func (cc *CacaoController) CreateCacao(ctx *gin.Context) {
+	var req command.CacaoRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdCacao, err := cc.cacaoService.Create(ctx, req.ToDomain())
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, createdCacao)
+}
+
+// UpdateCacao godoc
+// @Summary Update a Cacao
+// @Description Update an entry in the cacao catalog
+// @Tags cacao
+// @Accept json
+// @Produce json
+// @Param id path string true "Cacao ID"
+// @Param cacao body command.CacaoRequest true "Cacao Request"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cacao/{id} [put]
+func (cc *CacaoController) UpdateCacao(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req command.CacaoRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	existing, err := cc.cacaoService.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, recipe.ErrCacaoNotFound) {
+			ctx.JSON(404, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated := req.ToDomain()
+	existing.Name = updated.Name
+	existing.Origin = updated.Origin
+
+	if err := cc.cacaoService.Update(ctx, existing); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// DeleteCacao godoc
+// @Summary Delete a Cacao
+// @Description Remove an entry from the cacao catalog
+// @Tags cacao
+// @Param id path string true "Cacao ID"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /cacao/{id} [delete]
+func (cc *CacaoController) DeleteCacao(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	if err := cc.cacaoService.Delete(ctx, id); err != nil {
+		ctx.JSON(404, gin.H{"error": "Cacao not found"})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// ListCacao godoc
+// @Summary List Cacao
+// @Description List the cacao catalog with pagination
+// @Tags cacao
+// @Produce json
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} recipe.Cacao
+// @Failure 500
+// @Router /cacao [get]
+func (cc *CacaoController) ListCacao(ctx *gin.Context) {
+	limitStr := ctx.DefaultQuery("limit", "10")
+	offsetStr := ctx.DefaultQuery("offset", "0")
+
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	entries, err := cc.cacaoService.List(ctx, limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, entries)
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"errors"
+
+	event "github.com/onasunnymorning/go-make-chocolate/pkg/event"
+	experiment "github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+	production "github.com/onasunnymorning/go-make-chocolate/pkg/production"
+	quality "github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+	recipe "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	tasting "github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// notFoundErrors are the domain errors that are reported as 404 Not Found
+var notFoundErrors = []error{
+	recipe.ErrRecipeNotFound,
+	recipe.ErrMoldNotFound,
+	recipe.ErrCacaoNotFound,
+	quality.ErrBeanLotNotFound,
+	production.ErrBatchNotFound,
+	tasting.ErrSessionNotFound,
+	experiment.ErrExperimentNotFound,
+	event.ErrWebhookNotFound,
+}
+
+// forbiddenErrors are the domain errors that are reported as 403 Forbidden
+var forbiddenErrors = []error{
+	recipe.ErrReviewerRequired,
+}
+
+// conflictErrors are the domain errors caused by the current state of a resource, reported as 409 Conflict
+var conflictErrors = []error{
+	recipe.ErrInvalidTransition,
+	recipe.ErrRecipeArchived,
+	recipe.ErrRecipeNotApproved,
+}
+
+// statusFor returns the HTTP status code for an error returned by a service.
+// Missing resources are reported as 404, missing permissions as 403, conflicts with the state of a resource as 409,
+// other domain errors as 400 and anything else as 500.
+func statusFor(err error) int {
+	if isAny(err, notFoundErrors) {
+		return 404
+	}
+	if isAny(err, forbiddenErrors) {
+		return 403
+	}
+	if isAny(err, conflictErrors) {
+		return 409
+	}
+	var (
+		recipeErr     *recipe.Error
+		qualityErr    *quality.Error
+		productionErr *production.Error
+		tastingErr    *tasting.Error
+		experimentErr *experiment.Error
+		eventErr      *event.Error
+	)
+	if errors.As(err, &recipeErr) || errors.As(err, &qualityErr) || errors.As(err, &productionErr) ||
+		errors.As(err, &tastingErr) || errors.As(err, &experimentErr) || errors.As(err, &eventErr) {
+		return 400
+	}
+	return 500
+}
+
+// isAny reports whether err matches any of the targets.
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
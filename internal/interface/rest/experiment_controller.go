@@ -0,0 +1,211 @@
+package rest
+
+import (
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+)
+
+// ExperimentController handles HTTP requests related to recipe experiments
+type ExperimentController struct {
+	experimentService service.ExperimentService
+}
+
+// NewExperimentController creates a new instance of ExperimentController
+func NewExperimentController(experimentService service.ExperimentService) *ExperimentController {
+	return &ExperimentController{
+		experimentService: experimentService,
+	}
+}
+
+// GetExperimentByID godoc
+// @Summary Get an Experiment by ID
+// @Description Get an Experiment with its variants by ID
+// @Tags experiments
+// @Produce json
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} experiment.Experiment
+// @Failure 404
+// @Failure 500
+// @Router /experiment/{id} [get]
+func (ec *ExperimentController) GetExperimentByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	exp, err := ec.experimentService.GetByID(ctx, id)
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Experiment not found"})
+		return
+	}
+
+	ctx.JSON(200, exp)
+}
+
+// CreateExperiment godoc
+// @Summary Create a new Experiment
+// @Description Create an Experiment testing a hypothesis with variants of a base recipe
+// @Tags experiments
+// @Accept json
+// @Produce json
+// @Param experiment body command.ExperimentRequest true "Experiment Request"
+// @Success 201 {object} experiment.Experiment
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /experiment [post]
+func (ec *ExperimentController) CreateExperiment(ctx *gin.Context) {
+	var req command.ExperimentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	createdExperiment, err := ec.experimentService.Create(ctx, req.ToDomain())
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, createdExperiment)
+}
+
+// UpdateExperiment godoc
+// @Summary Update an Experiment
+// @Description Update the name, hypothesis and conclusion of an Experiment
+// @Tags experiments
+// @Accept json
+// @Produce json
+// @Param id path string true "Experiment ID"
+// @Param experiment body command.UpdateExperimentRequest true "Update Experiment Request"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /experiment/{id} [put]
+func (ec *ExperimentController) UpdateExperiment(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req command.UpdateExperimentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	exp, err := ec.experimentService.GetByID(ctx, id)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+	exp.Name = req.Name
+	exp.Hypothesis = req.Hypothesis
+	exp.Conclusion = req.Conclusion
+
+	if err := ec.experimentService.Update(ctx, exp); err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// AddExperimentVariant godoc
+// @Summary Add a variant to an Experiment
+// @Description Add an existing recipe derived from the base recipe as a variant, together with the parameters that were changed
+// @Tags experiments
+// @Accept json
+// @Produce json
+// @Param id path string true "Experiment ID"
+// @Param variant body command.VariantRequest true "Variant Request"
+// @Success 200 {object} experiment.Experiment
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /experiment/{id}/variant [post]
+func (ec *ExperimentController) AddExperimentVariant(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req command.VariantRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	exp, err := ec.experimentService.AddVariant(ctx, id, req.ToDomain())
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, exp)
+}
+
+// CompareExperiment godoc
+// @Summary Compare the variants of an Experiment
+// @Description Get a side-by-side comparison of the composition, cost, tasting scores and process parameters of the base recipe and all variants
+// @Tags experiments
+// @Produce json
+// @Param id path string true "Experiment ID"
+// @Success 200 {object} experiment.Comparison
+// @Failure 404
+// @Failure 500
+// @Router /experiment/{id}/comparison [get]
+func (ec *ExperimentController) CompareExperiment(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	comparison, err := ec.experimentService.Compare(ctx, id)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, comparison)
+}
+
+// ListExperiments godoc
+// @Summary List Experiments
+// @Description List Experiments with pagination
+// @Tags experiments
+// @Produce json
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} experiment.Experiment
+// @Failure 500
+// @Router /experiment [get]
+func (ec *ExperimentController) ListExperiments(ctx *gin.Context) {
+	limitStr := ctx.DefaultQuery("limit", "10")
+	offsetStr := ctx.DefaultQuery("offset", "0")
+
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	experiments, err := ec.experimentService.List(ctx, limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, experiments)
+}
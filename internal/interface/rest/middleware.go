@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gin "github.com/gin-gonic/gin"
+	actor "github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	requestid "github.com/onasunnymorning/go-make-chocolate/internal/requestid"
+)
+
+// Identity headers set by the authenticating proxy in front of the API
+const (
+	UserHeader  = "X-User"
+	RolesHeader = "X-Roles"
+)
+
+// ProxySecretHeader carries the secret the authenticating proxy shares with the API
+const ProxySecretHeader = "X-Proxy-Secret"
+
+// RequestIDHeader carries the ID of a request, set by the client or generated by the API
+const RequestIDHeader = "X-Request-ID"
+
+// Identity returns a middleware that stores the actor identified by the X-User and X-Roles headers in the request context.
+// The headers are only trusted as the policy allows, requests without an X-User header or whose identity is not trusted
+// are handled as the anonymous actor without roles.
+// The engine must have ContextWithFallback enabled for services to see the actor through the gin.Context.
+func Identity(policy actor.Policy) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		a := policy.Identify(ctx.GetHeader(ProxySecretHeader), ctx.GetHeader(UserHeader), ctx.GetHeader(RolesHeader))
+		ctx.Request = ctx.Request.WithContext(actor.NewContext(ctx.Request.Context(), a))
+		ctx.Next()
+	}
+}
+
+// RequestID returns a middleware that stores the ID of the request in the request context and echoes it in the response.
+// The ID is taken from the X-Request-ID header when the client sets one, and generated otherwise.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := ctx.GetHeader(RequestIDHeader)
+		if id == "" || len(id) > 128 {
+			id = requestid.New()
+		}
+		ctx.Header(RequestIDHeader, id)
+		ctx.Request = ctx.Request.WithContext(requestid.NewContext(ctx.Request.Context(), id))
+		ctx.Next()
+	}
+}
+
+// Authenticate returns a middleware that rejects the requests the policy does not accept with 401 Unauthorized,
+// except those to the public routes, given as their gin paths. It must run after Identity.
+func Authenticate(policy actor.Policy, public ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if slices.Contains(public, ctx.FullPath()) {
+			ctx.Next()
+			return
+		}
+		if err := policy.Check(ctx.GetHeader(ProxySecretHeader), actor.FromContext(ctx.Request.Context())); err != nil {
+			ctx.AbortWithStatusJSON(401, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// CORSConfig configures which browser origins may call the API
+type CORSConfig struct {
+	AllowedOrigins []string // * allows any origin
+	AllowedMethods []string
+	AllowedHeaders []string
+	MaxAge         time.Duration
+}
+
+// exposedHeaders are the response headers browsers let scripts read
+var exposedHeaders = []string{RequestIDHeader, "Link", "Content-Disposition"}
+
+// CORS returns a middleware that lets browsers on the allowed origins call the API, answering their preflight requests.
+// It must run before any middleware rejecting requests, as preflight requests carry no credentials.
+func CORS(cfg CORSConfig) gin.HandlerFunc {
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*")
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	maxAge := strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	return func(ctx *gin.Context) {
+		origin := ctx.GetHeader("Origin")
+		if origin == "" {
+			ctx.Next()
+			return
+		}
+		ctx.Writer.Header().Add("Vary", "Origin")
+		if !anyOrigin && !slices.Contains(cfg.AllowedOrigins, origin) {
+			ctx.Next()
+			return
+		}
+
+		if anyOrigin {
+			ctx.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			ctx.Header("Access-Control-Allow-Origin", origin)
+		}
+		if ctx.Request.Method == "OPTIONS" && ctx.GetHeader("Access-Control-Request-Method") != "" {
+			ctx.Header("Access-Control-Allow-Methods", methods)
+			ctx.Header("Access-Control-Allow-Headers", headers)
+			ctx.Header("Access-Control-Max-Age", maxAge)
+			ctx.AbortWithStatus(204)
+			return
+		}
+		ctx.Header("Access-Control-Expose-Headers", strings.Join(exposedHeaders, ", "))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,191 @@
+package rest
+
+import (
+	"errors"
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	recipe "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// MoldController handles HTTP requests related to the mold catalog
+type MoldController struct {
+	moldService service.MoldService
+}
+
+// NewMoldController creates a new instance of MoldController
+func NewMoldController(moldService service.MoldService) *MoldController {
+	return &MoldController{
+		moldService: moldService,
+	}
+}
+
+// GetMoldByID godoc
+// @Summary Get a Mold by ID
+// @Description Get a Mold from the mold catalog by ID
+// @Tags molds
+// @Produce json
+// @Param id path string true "Mold ID"
+// @Success 200 {object} recipe.Mold
+// @Failure 404
+// @Failure 500
+// @Router /mold/{id} [get]
+func (mc *MoldController) GetMoldByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	mold, err := mc.moldService.GetByID(ctx, id)
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Mold not found"})
+		return
+	}
+
+	ctx.JSON(200, mold)
+}
+
+// CreateMold godoc
+// @Summary Create a new Mold
+// @Description Add a new Mold to the mold catalog
+// @Tags molds
+// @Accept json
+// @Produce json
+// @Param mold body command.MoldRequest true "Mold Request"
+// @Success 201 {object} recipe.Mold
+// @Failure 400
+// @Failure 500
+// @Router /mold [post]
+func (mc *MoldController) CreateMold(ctx *gin.Context) {
+	var req command.MoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	mold := &recipe.Mold{
+		Name:         req.Name,
+		Type:         req.Type,
+		Cavities:     req.Cavities,
+		CavityWeight: req.CavityWeight,
+		ShellWeight:  req.ShellWeight,
+	}
+
+	createdMold, err := mc.moldService.Create(ctx, mold)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, createdMold)
+}
+
+// UpdateMold godoc
+// @Summary Update a Mold
+// @Description Update a Mold in the mold catalog
+// @Tags molds
+// @Accept json
+// @Produce json
+// @Param id path string true "Mold ID"
+// @Param mold body command.MoldRequest true "Mold Request"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /mold/{id} [put]
+func (mc *MoldController) UpdateMold(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req command.MoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	existing, err := mc.moldService.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, recipe.ErrMoldNotFound) {
+			ctx.JSON(404, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	existing.Name = req.Name
+	existing.Type = req.Type
+	existing.Cavities = req.Cavities
+	existing.CavityWeight = req.CavityWeight
+	existing.ShellWeight = req.ShellWeight
+
+	if err := mc.moldService.Update(ctx, existing); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// DeleteMold godoc
+// @Summary Delete a Mold
+// @Description Remove a Mold from the mold catalog
+// @Tags molds
+// @Param id path string true "Mold ID"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /mold/{id} [delete]
+func (mc *MoldController) DeleteMold(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	if err := mc.moldService.Delete(ctx, id); err != nil {
+		ctx.JSON(404, gin.H{"error": "Mold not found"})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// ListMolds godoc
+// @Summary List Molds
+// @Description List the mold catalog with pagination
+// @Tags molds
+// @Produce json
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} recipe.Mold
+// @Failure 500
+// @Router /mold [get]
+func (mc *MoldController) ListMolds(ctx *gin.Context) {
+	limitStr := ctx.DefaultQuery("limit", "10")
+	offsetStr := ctx.DefaultQuery("offset", "0")
+
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	molds, err := mc.moldService.List(ctx, limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, molds)
+}
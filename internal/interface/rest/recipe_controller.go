@@ -1,37 +1,53 @@
 package rest
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
 	"strconv"
 
 	gin "github.com/gin-gonic/gin"
 	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
 	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	bulk "github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
 	recipe "github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	render "github.com/onasunnymorning/go-make-chocolate/pkg/render"
+	schemaorg "github.com/onasunnymorning/go-make-chocolate/pkg/schemaorg"
 )
 
 // RecipeController handles HTTP requests related to recipes
 type RecipeController struct {
 	recipeService service.RecipeService
+	moldService   service.MoldService
 }
 
 // NewRecipeController creates a new instance of RecipeController
-func NewRecipeController(recipeService service.RecipeService) *RecipeController {
+func NewRecipeController(recipeService service.RecipeService, moldService service.MoldService) *RecipeController {
 	return &RecipeController{
 		recipeService: recipeService,
+		moldService:   moldService,
 	}
 }
 
 // GetRecipyByID godoc
 // @Summary Get a Recipe by ID
 // @Description Get a Recipe by ID. If yield is specified, it returns the recipe scaled to that yield.
+// @Description Alternatively specify a mold from the mold catalog together with either a number of molds or a number of pieces to scale the recipe to fill them.
+// @Description Send Accept: application/ld+json to get the recipe as a schema.org Recipe, or application/yaml, text/markdown
+// @Description or text/plain to get it as YAML or as a printable recipe card.
 // @Tags recipes
-// @Produce json
+// @Produce json,application/ld+json,application/yaml,text/markdown,text/plain
 // @Param id path string true "Recipe ID"
 // @Param yield query string false "Yield"
+// @Param mold query string false "Mold ID"
+// @Param molds query int false "Number of molds to fill"
+// @Param pieces query int false "Number of pieces to produce"
 // @Success 200 {object} recipe.Recipe
+// @Failure 400
 // @Failure 404
 // @Failure 500
-// @Router /{id} [get]
+// @Router /recipe/{id} [get]
 func (rc *RecipeController) GetRecipeByID(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -40,18 +56,17 @@ func (rc *RecipeController) GetRecipeByID(ctx *gin.Context) {
 	}
 
 	recipe, err := rc.recipeService.GetByID(ctx, id)
-	if err != nil {
+	if err != nil || recipe == nil {
 		ctx.JSON(404, gin.H{"error": "Recipe not found"})
 		return
 	}
-	// Check if yield is requested
-	yieldStr := ctx.Query("yield")
-	if yieldStr != "" {
-		yield, err := strconv.ParseFloat(yieldStr, 64)
-		if err != nil {
-			ctx.JSON(400, gin.H{"error": "Invalid yield value"})
-			return
-		}
+
+	yield, scale, err := rc.requestedYield(ctx)
+	if err != nil {
+		ctx.JSON(yieldStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if scale {
 		// Use a TemplateRecipe to return the recipe with yield
 		templateRecipe := recipe.ToTemplate()
 		recipe = templateRecipe.ToRecipe(yield)
@@ -61,7 +76,234 @@ func (rc *RecipeController) GetRecipeByID(ctx *gin.Context) {
 		}
 	}
 
-	ctx.JSON(200, recipe)
+	switch ctx.NegotiateFormat(gin.MIMEJSON, schemaorg.MIMEJSONLD, render.MIMEYAML, gin.MIMEYAML, render.MIMEMarkdown, render.MIMEText) {
+	case schemaorg.MIMEJSONLD:
+		// gin keeps a Content-Type that is already set
+		ctx.Header("Content-Type", schemaorg.MIMEJSONLD+"; charset=utf-8")
+		ctx.JSON(200, schemaorg.FromRecipe(recipe))
+	case render.MIMEYAML, gin.MIMEYAML:
+		renderRecipe(ctx, render.YAML, recipe)
+	case render.MIMEMarkdown:
+		renderRecipe(ctx, render.Markdown, recipe)
+	case render.MIMEText:
+		renderRecipe(ctx, render.Text, recipe)
+	default:
+		ctx.JSON(200, recipe)
+	}
+}
+
+// renderRecipe responds with the recipe rendered in the given format
+func renderRecipe(ctx *gin.Context, format render.Format, rcp *recipe.Recipe) {
+	var buf bytes.Buffer
+	if err := render.Recipe(&buf, format, rcp); err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	ctx.Data(200, format.ContentType()+"; charset=utf-8", buf.Bytes())
+}
+
+// queryError is an invalid query parameter
+type queryError string
+
+func (e queryError) Error() string {
+	return string(e)
+}
+
+// yieldStatus returns the HTTP status code for an error of requestedYield.
+// Invalid query parameters are reported as 400, and errors looking up the mold as statusFor reports them.
+func yieldStatus(err error) int {
+	var qe queryError
+	if errors.As(err, &qe) {
+		return 400
+	}
+	return statusFor(err)
+}
+
+// requestedYield determines the yield a recipe should be scaled to from the yield or mold query parameters.
+// It returns false if no scaling was requested, a queryError for invalid parameters and the error of the mold lookup,
+// recipe.ErrMoldNotFound if there is no such mold.
+func (rc *RecipeController) requestedYield(ctx *gin.Context) (float64, bool, error) {
+	yieldStr := ctx.Query("yield")
+	moldID := ctx.Query("mold")
+	if yieldStr != "" && moldID != "" {
+		return 0, false, queryError("Specify either a yield or a mold, not both")
+	}
+
+	if yieldStr != "" {
+		yield, err := strconv.ParseFloat(yieldStr, 64)
+		if err != nil {
+			return 0, false, queryError("Invalid yield value")
+		}
+		return yield, true, nil
+	}
+
+	if moldID == "" {
+		return 0, false, nil
+	}
+	moldsStr, piecesStr := ctx.Query("molds"), ctx.Query("pieces")
+	if (moldsStr == "") == (piecesStr == "") {
+		return 0, false, queryError("Specify either a number of molds or a number of pieces")
+	}
+	countStr := moldsStr
+	if countStr == "" {
+		countStr = piecesStr
+	}
+	count, err := strconv.Atoi(countStr)
+	if err != nil || count < 1 {
+		return 0, false, queryError("Invalid number of molds or pieces")
+	}
+
+	mold, err := rc.moldService.GetByID(ctx, moldID)
+	if err != nil {
+		return 0, false, err
+	}
+	if moldsStr != "" {
+		return mold.YieldForMolds(count), true, nil
+	}
+	return mold.YieldForPieces(count), true, nil
+}
+
+// GetRecipeTempering godoc
+// @Summary Get the tempering plan for a Recipe
+// @Description Get the tempering curve for a Recipe based on its classification and overrides, and the amounts to use with the given tempering method.
+// @Description The batch size defaults to the recipe yield, and can be set with either yield or a mold together with a number of molds or pieces.
+// @Tags recipes
+// @Produce json
+// @Param id path string true "Recipe ID"
+// @Param method query string false "Tempering method (seed or tabling)" default(seed)
+// @Param yield query string false "Batch size in grams"
+// @Param mold query string false "Mold ID"
+// @Param molds query int false "Number of molds to fill"
+// @Param pieces query int false "Number of pieces to produce"
+// @Success 200 {object} recipe.TemperingPlan
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /recipe/{id}/tempering [get]
+func (rc *RecipeController) GetRecipeTempering(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	rcp, err := rc.recipeService.GetByID(ctx, id)
+	if err != nil || rcp == nil {
+		ctx.JSON(404, gin.H{"error": "Recipe not found"})
+		return
+	}
+
+	batchSize, scale, err := rc.requestedYield(ctx)
+	if err != nil {
+		ctx.JSON(yieldStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if !scale {
+		batchSize = rcp.Yield.Amount
+	}
+
+	method := recipe.TemperingMethod(ctx.DefaultQuery("method", string(recipe.SeedMethod)))
+	plan, err := rcp.TemperingPlan(method, batchSize)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, plan)
+}
+
+// GetRecipeBatchSheet godoc
+// @Summary Get a printable batch sheet for a Recipe
+// @Description Get a PDF production sheet for a batch of a Recipe, with the scaled quantities to weigh, fields for the weighed amounts and lot numbers,
+// @Description the tempering temperatures for the method and lines to sign off. The batch size defaults to the recipe yield,
+// @Description and can be set with either yield or a mold together with a number of molds or pieces.
+// @Tags recipes
+// @Produce application/pdf
+// @Param id path string true "Recipe ID"
+// @Param yield query string false "Batch size in grams"
+// @Param mold query string false "Mold ID"
+// @Param molds query int false "Number of molds to fill"
+// @Param pieces query int false "Number of pieces to produce"
+// @Param method query string false "Tempering method (seed or tabling)" default(seed)
+// @Success 200 {file} file
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /recipe/{id}/batch-sheet.pdf [get]
+func (rc *RecipeController) GetRecipeBatchSheet(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	rcp, err := rc.recipeService.GetByID(ctx, id)
+	if err != nil || rcp == nil {
+		ctx.JSON(404, gin.H{"error": "Recipe not found"})
+		return
+	}
+
+	batchSize, scale, err := rc.requestedYield(ctx)
+	if err != nil {
+		ctx.JSON(yieldStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+	if !scale {
+		batchSize = rcp.Yield.Amount
+	}
+
+	method := recipe.TemperingMethod(ctx.DefaultQuery("method", string(recipe.SeedMethod)))
+	var buf bytes.Buffer
+	if err := render.BatchSheet(&buf, rcp.ToTemplate(), batchSize, method); err != nil {
+		if errors.Is(err, render.ErrInvalidBatchSize) {
+			ctx.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Disposition", `inline; filename="batch-sheet-`+rcp.ID+`.pdf"`)
+	ctx.Data(200, render.MIMEPDF, buf.Bytes())
+}
+
+// OriginResponse describes the origin of the cacao in a recipe
+type OriginResponse struct {
+	SingleOrigin bool            `json:"single_origin"`
+	Origin       *recipe.Origin  `json:"origin,omitempty"` // Origin details shared by all cacao, only for single origin recipes
+	Origins      []recipe.Origin `json:"origins"`
+}
+
+// GetRecipeOrigin godoc
+// @Summary Get the origin of the cacao in a Recipe
+// @Description Get the distinct origins of the cacao ingredients of a Recipe, and whether the recipe can be labelled single origin.
+// @Tags recipes
+// @Produce json
+// @Param id path string true "Recipe ID"
+// @Success 200 {object} OriginResponse
+// @Failure 404
+// @Failure 500
+// @Router /recipe/{id}/origin [get]
+func (rc *RecipeController) GetRecipeOrigin(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	rcp, err := rc.recipeService.GetByID(ctx, id)
+	if err != nil || rcp == nil {
+		ctx.JSON(404, gin.H{"error": "Recipe not found"})
+		return
+	}
+
+	resp := OriginResponse{Origins: rcp.Origins()}
+	if origin, ok := rcp.SingleOrigin(); ok {
+		resp.SingleOrigin = true
+		resp.Origin = &origin
+	}
+
+	ctx.JSON(200, resp)
 }
 
 // GetRecipeTemplate godoc
@@ -73,7 +315,7 @@ func (rc *RecipeController) GetRecipeByID(ctx *gin.Context) {
 // @Success 200 {object} recipe.TemplateRecipe
 // @Failure 404
 // @Failure 500
-// @Router /{id}/template [get]
+// @Router /recipe/{id}/template [get]
 func (rc *RecipeController) GetRecipeTemplate(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -92,16 +334,22 @@ func (rc *RecipeController) GetRecipeTemplate(ctx *gin.Context) {
 
 // CreateRecipe godoc
 // @Summary Create a new Recipe
-// @Description Create a new Recipe
+// @Description Create a new Recipe. Send Content-Type: application/ld+json to create it from a schema.org Recipe,
+// @Description where every recipeIngredient starts with a quantity like "700 g Cacao nibs".
 // @Tags recipes
-// @Accept json
+// @Accept json,application/ld+json
 // @Produce json
 // @Param recipe body command.RecipeRequest true "Recipe Request"
 // @Success 201 {object} recipe.Recipe
 // @Failure 400
 // @Failure 500
-// @Router / [post]
+// @Router /recipe [post]
 func (rc *RecipeController) CreateRecipe(ctx *gin.Context) {
+	if ctx.ContentType() == schemaorg.MIMEJSONLD {
+		rc.createRecipeFromJSONLD(ctx)
+		return
+	}
+
 	var req command.RecipeRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(400, gin.H{"error": err.Error()})
@@ -109,10 +357,15 @@ func (rc *RecipeController) CreateRecipe(ctx *gin.Context) {
 	}
 
 	recipe := &recipe.Recipe{
-		Name:         req.Name,
-		Description:  req.Description,
-		Ingredients:  req.Ingredients,
-		Instructions: req.Instructions,
+		Name:           req.Name,
+		Description:    req.Description,
+		Ingredients:    req.Ingredients,
+		Instructions:   req.Instructions,
+		Losses:         req.Losses,
+		Classification: req.Classification,
+		Tempering:      req.Tempering,
+		Process:        req.Process,
+		Nutrition:      req.Nutrition,
 	}
 
 	createdRecipe, err := rc.recipeService.Create(ctx, recipe)
@@ -124,19 +377,46 @@ func (rc *RecipeController) CreateRecipe(ctx *gin.Context) {
 	ctx.JSON(201, createdRecipe)
 }
 
+// createRecipeFromJSONLD creates a recipe from a schema.org Recipe
+func (rc *RecipeController) createRecipeFromJSONLD(ctx *gin.Context) {
+	var doc schemaorg.Recipe
+	if err := ctx.ShouldBindJSON(&doc); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	rcp, err := schemaorg.ToRecipe(&doc)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	// The identifier belongs to the site the recipe came from
+	rcp.ID = ""
+
+	createdRecipe, err := rc.recipeService.Create(ctx, rcp)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, createdRecipe)
+}
+
 // UpdateRecipe godoc
 // @Summary Update a Recipe
-// @Description Update a Recipe
+// @Description Update a Recipe. Draft recipes are updated in place and recipes in review are sent back to draft.
+// @Description Approved recipes are locked, so the changes are saved as a new draft revision which is returned with its location.
 // @Tags recipes
 // @Accept json
 // @Produce json
 // @Param id path string true "Recipe ID"
 // @Param recipe body command.RecipeRequest true "Recipe Request"
+// @Success 201 {object} recipe.Recipe
 // @Success 204
 // @Failure 400
 // @Failure 404
+// @Failure 409
 // @Failure 500
-// @Router /{id} [put]
+// @Router /recipe/{id} [put]
 func (rc *RecipeController) UpdateRecipe(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -151,31 +431,75 @@ func (rc *RecipeController) UpdateRecipe(ctx *gin.Context) {
 	}
 
 	recipe := &recipe.Recipe{
-		ID:           id,
-		Name:         req.Name,
-		Description:  req.Description,
-		Ingredients:  req.Ingredients,
-		Instructions: req.Instructions,
+		ID:             id,
+		Name:           req.Name,
+		Description:    req.Description,
+		Ingredients:    req.Ingredients,
+		Instructions:   req.Instructions,
+		Losses:         req.Losses,
+		Classification: req.Classification,
+		Tempering:      req.Tempering,
+		Process:        req.Process,
+		Nutrition:      req.Nutrition,
 	}
 
-	if err := rc.recipeService.Update(ctx, recipe); err != nil {
-		ctx.JSON(400, gin.H{"error": err.Error()})
+	updated, err := rc.recipeService.Update(ctx, recipe)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+	if updated.ID != id {
+		ctx.Header("Location", "/recipe/"+updated.ID)
+		ctx.JSON(201, updated)
 		return
 	}
 
 	ctx.Status(204)
 }
 
+// TransitionRecipe godoc
+// @Summary Change the lifecycle status of a Recipe
+// @Description Move a Recipe through its lifecycle: draft -> in_review -> approved -> archived.
+// @Description Recipes in review can be sent back to draft, and archived recipes can be restored to draft.
+// @Description Approving or rejecting a recipe in review requires the reviewer role.
+// @Tags recipes
+// @Accept json
+// @Produce json
+// @Param id path string true "Recipe ID"
+// @Param status body command.RecipeStatusRequest true "Requested status"
+// @Success 200 {object} recipe.Recipe
+// @Failure 400
+// @Failure 403
+// @Failure 404
+// @Failure 409
+// @Failure 500
+// @Router /recipe/{id}/status [post]
+func (rc *RecipeController) TransitionRecipe(ctx *gin.Context) {
+	var req command.RecipeStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated, err := rc.recipeService.Transition(ctx, ctx.Param("id"), req.Status)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, updated)
+}
+
 // DeleteRecipe godoc
 // @Summary Delete a Recipe
-// @Description Delete a Recipe
+// @Description Move a Recipe to the trash. It can be restored until it is purged after the retention period.
 // @Tags recipes
 // @Param id path string true "Recipe ID"
 // @Success 204
 // @Failure 400
 // @Failure 404
 // @Failure 500
-// @Router /{id} [delete]
+// @Router /recipe/{id} [delete]
 func (rc *RecipeController) DeleteRecipe(ctx *gin.Context) {
 	id := ctx.Param("id")
 	if id == "" {
@@ -184,37 +508,54 @@ func (rc *RecipeController) DeleteRecipe(ctx *gin.Context) {
 	}
 
 	if err := rc.recipeService.Delete(ctx, id); err != nil {
-		ctx.JSON(404, gin.H{"error": "Recipe not found"})
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
 		return
 	}
 
 	ctx.Status(204)
 }
 
-// ListRecipes godoc
-// @Summary List Recipes
-// @Description List Recipes with pagination
+// RestoreRecipe godoc
+// @Summary Restore a Recipe from the trash
+// @Description Restore a deleted Recipe that has not been purged yet
+// @Tags recipes
+// @Produce json
+// @Param id path string true "Recipe ID"
+// @Success 200 {object} recipe.Recipe
+// @Failure 404
+// @Failure 500
+// @Router /recipe/{id}/restore [post]
+func (rc *RecipeController) RestoreRecipe(ctx *gin.Context) {
+	restored, err := rc.recipeService.Restore(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, restored)
+}
+
+// ListTrash godoc
+// @Summary List deleted Recipes
+// @Description List the Recipes in the trash with pagination, most recently deleted first
 // @Tags recipes
 // @Produce json
 // @Param limit query int false "Limit" default(10)
 // @Param offset query int false "Offset" default(0)
 // @Success 200 {array} recipe.Recipe
 // @Failure 500
-// @Router / [get]
-func (rc *RecipeController) ListRecipes(ctx *gin.Context) {
-	limitStr := ctx.DefaultQuery("limit", "10")
-	offsetStr := ctx.DefaultQuery("offset", "0")
-
-	limit, err := strconv.ParseInt(limitStr, 10, 64)
+// @Router /recipe/trash [get]
+func (rc *RecipeController) ListTrash(ctx *gin.Context) {
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 64)
 	if err != nil {
 		limit = 10
 	}
-	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	offset, err := strconv.ParseInt(ctx.DefaultQuery("offset", "0"), 10, 64)
 	if err != nil {
 		offset = 0
 	}
 
-	recipes, err := rc.recipeService.List(ctx, limit, offset)
+	recipes, err := rc.recipeService.Trash(ctx, limit, offset)
 	if err != nil {
 		ctx.JSON(500, gin.H{"error": err.Error()})
 		return
@@ -223,6 +564,201 @@ func (rc *RecipeController) ListRecipes(ctx *gin.Context) {
 	ctx.JSON(200, recipes)
 }
 
+// ListRecipes godoc
+// @Summary List Recipes
+// @Description List Recipes a page at a time, optionally filtered and sorted.
+// @Description The page holds the recipes, the total number of recipes matching the filters and the cursor of the next page,
+// @Description which is empty on the last page. Pass it as the cursor parameter, with the same sort, to get the next page.
+// @Description The Link header links the first and the next page.
+// @Tags recipes
+// @Produce json
+// @Param limit query int false "Number of recipes in the page, at most 100" default(10)
+// @Param cursor query string false "Cursor of the page, from the NextCursor of the previous page"
+// @Param sort query string false "Sort order, prefix with - for descending" Enums(name, -name, created, -created, updated, -updated, cacao, -cacao) default(created)
+// @Param name query string false "Part of the name of the recipe"
+// @Param created_by query string false "User that created the recipe"
+// @Param min_cacao query number false "Minimum cacao percentage"
+// @Param max_cacao query number false "Maximum cacao percentage"
+// @Param status query string false "Lifecycle status" Enums(draft, in_review, approved, archived)
+// @Param origin_country query string false "Country of origin of the cacao"
+// @Param origin_region query string false "Region of origin of the cacao"
+// @Param variety query string false "Cacao variety"
+// @Param certification query string false "Certification of the cacao" Enums(organic, fair_trade, rainforest_alliance, direct_trade)
+// @Success 200 {object} recipe.Page
+// @Header 200 {string} Link "Links to the first and the next page"
+// @Failure 400
+// @Failure 500
+// @Router /recipe [get]
+func (rc *RecipeController) ListRecipes(ctx *gin.Context) {
+	opts, err := listOptions(ctx)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := rc.recipeService.List(ctx, opts)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Link", pageLinks(ctx.Request.URL, page))
+	ctx.JSON(200, page)
+}
+
+// listOptions builds the recipe.ListOptions from the query parameters
+func listOptions(ctx *gin.Context) (recipe.ListOptions, error) {
+	var opts recipe.ListOptions
+	var err error
+	if opts.Filter, err = recipeFilter(ctx); err != nil {
+		return opts, err
+	}
+	if opts.Limit, err = strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 64); err != nil {
+		return opts, recipe.ErrInvalidLimit
+	}
+	if opts.Sort, err = recipe.ParseSort(ctx.Query("sort")); err != nil {
+		return opts, err
+	}
+	if c := ctx.Query("cursor"); c != "" {
+		after, err := recipe.ParseCursor(c, opts.Sort)
+		if err != nil {
+			return opts, err
+		}
+		opts.After = &after
+	}
+	return opts, nil
+}
+
+// pageLinks returns the Link header with the first and, unless this is the last page, the next page of a list,
+// keeping the other query parameters of the request
+func pageLinks(u *url.URL, page *recipe.Page) string {
+	query := u.Query()
+	query.Del("cursor")
+	link := func(rel string) string {
+		return fmt.Sprintf(`<%s?%s>; rel="%s"`, u.Path, query.Encode(), rel)
+	}
+	links := link("first")
+	if page.NextCursor != "" {
+		query.Set("cursor", page.NextCursor)
+		links += ", " + link("next")
+	}
+	return links
+}
+
+// recipeFilter builds a recipe.Filter from the query parameters
+func recipeFilter(ctx *gin.Context) (recipe.Filter, error) {
+	filter := recipe.Filter{
+		Name:          ctx.Query("name"),
+		CreatedBy:     ctx.Query("created_by"),
+		Status:        recipe.Status(ctx.Query("status")),
+		OriginCountry: ctx.Query("origin_country"),
+		OriginRegion:  ctx.Query("origin_region"),
+		Variety:       ctx.Query("variety"),
+		Certification: recipe.Certification(ctx.Query("certification")),
+	}
+	for param, value := range map[string]*float64{"min_cacao": &filter.MinCacao, "max_cacao": &filter.MaxCacao} {
+		if v := ctx.Query(param); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return filter, recipe.ErrInvalidCacaoRange
+			}
+			*value = f
+		}
+	}
+	return filter, filter.Validate()
+}
+
+// ImportRecipes godoc
+// @Summary Import Recipes
+// @Description Create Recipes from JSON lines (one recipe per line) or CSV (one row per ingredient, see the export for the columns).
+// @Description The format is taken from the format query parameter or the Content-Type header, and defaults to JSON lines.
+// @Description Every record is validated on its own: records that fail are reported without stopping the import.
+// @Description With dry_run, the records are only validated and nothing is saved.
+// @Tags recipes
+// @Accept application/x-ndjson,text/csv
+// @Produce json
+// @Param format query string false "Format of the body" Enums(jsonl, csv)
+// @Param dry_run query bool false "Only validate the records" default(false)
+// @Success 200 {object} bulk.Report
+// @Failure 400 {object} bulk.Report
+// @Failure 500
+// @Router /recipe/import [post]
+func (rc *RecipeController) ImportRecipes(ctx *gin.Context) {
+	format := bulk.Format(ctx.Query("format"))
+	if format == "" {
+		format = bulk.JSONLines
+		if ctx.ContentType() == bulk.MIMECSV {
+			format = bulk.CSV
+		}
+	}
+	if format != bulk.JSONLines && format != bulk.CSV {
+		ctx.JSON(400, gin.H{"error": "Format must be jsonl or csv"})
+		return
+	}
+	dryRun, err := strconv.ParseBool(ctx.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": "dry_run must be true or false"})
+		return
+	}
+
+	report, err := rc.recipeService.Import(ctx, bulk.NewReader(format, ctx.Request.Body), dryRun)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error(), "report": report})
+		return
+	}
+
+	ctx.JSON(200, report)
+}
+
+// ExportRecipes godoc
+// @Summary Export Recipes
+// @Description Stream every Recipe matching the filters as JSON lines or CSV with one row per ingredient.
+// @Description The format is taken from the format query parameter or the Accept header, and defaults to JSON lines.
+// @Description Tempering curves and process profiles are only included in JSON lines.
+// @Tags recipes
+// @Produce application/x-ndjson,text/csv
+// @Param format query string false "Format of the export" Enums(jsonl, csv)
+// @Param name query string false "Part of the name of the recipe"
+// @Param created_by query string false "User that created the recipe"
+// @Param min_cacao query number false "Minimum cacao percentage"
+// @Param max_cacao query number false "Maximum cacao percentage"
+// @Param status query string false "Lifecycle status" Enums(draft, in_review, approved, archived)
+// @Param origin_country query string false "Country of origin of the cacao"
+// @Param origin_region query string false "Region of origin of the cacao"
+// @Param variety query string false "Cacao variety"
+// @Param certification query string false "Certification of the cacao" Enums(organic, fair_trade, rainforest_alliance, direct_trade)
+// @Success 200
+// @Failure 400
+// @Failure 500
+// @Router /recipe/export [get]
+func (rc *RecipeController) ExportRecipes(ctx *gin.Context) {
+	format := bulk.Format(ctx.Query("format"))
+	if format == "" {
+		format = bulk.JSONLines
+		if ctx.NegotiateFormat(bulk.MIMEJSONLines, bulk.MIMECSV) == bulk.MIMECSV {
+			format = bulk.CSV
+		}
+	}
+	if format != bulk.JSONLines && format != bulk.CSV {
+		ctx.JSON(400, gin.H{"error": "Format must be jsonl or csv"})
+		return
+	}
+
+	filter, err := recipeFilter(ctx)
+	if err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Header("Content-Type", format.ContentType())
+	ctx.Header("Content-Disposition", `attachment; filename="recipes.`+string(format)+`"`)
+	ctx.Status(200)
+	if err := rc.recipeService.Export(ctx, filter, bulk.NewWriter(format, ctx.Writer)); err != nil {
+		// The response has already started, so the error can only be logged
+		_ = ctx.Error(err)
+	}
+}
+
 // CountRecipes godoc
 // @Summary CountRecipes Recipes
 // @Description CountRecipes total number of Recipes
@@ -230,7 +766,7 @@ func (rc *RecipeController) ListRecipes(ctx *gin.Context) {
 // @Produce json
 // @Success 200 {object} map[string]int64
 // @Failure 500
-// @Router /count [get]
+// @Router /recipe/count [get]
 func (rc *RecipeController) CountRecipes(ctx *gin.Context) {
 	count, err := rc.recipeService.Count(ctx)
 	if err != nil {
@@ -240,3 +776,41 @@ func (rc *RecipeController) CountRecipes(ctx *gin.Context) {
 
 	ctx.JSON(200, gin.H{"count": count})
 }
+
+// CreatePurchaseList godoc
+// @Summary Create a purchase list for a production plan
+// @Description Scale each recipe in the plan to its target yield, merge identical ingredients and subtract the on-hand inventory. Returns CSV when the Accept header asks for text/csv.
+// @Tags recipes
+// @Accept json
+// @Produce json,text/csv
+// @Param plan body command.PurchaseListRequest true "Production plan"
+// @Success 200 {object} recipe.PurchaseList
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /recipe/purchase-list [post]
+func (rc *RecipeController) CreatePurchaseList(ctx *gin.Context) {
+	var req command.PurchaseListRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	list, err := rc.recipeService.PurchaseList(ctx, req.ToDomain())
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	if ctx.NegotiateFormat(gin.MIMEJSON, "text/csv") == "text/csv" {
+		ctx.Header("Content-Type", "text/csv")
+		ctx.Header("Content-Disposition", `attachment; filename="purchase-list.csv"`)
+		ctx.Status(200)
+		if err := list.WriteCSV(ctx.Writer); err != nil {
+			_ = ctx.Error(err)
+		}
+		return
+	}
+
+	ctx.JSON(200, list)
+}
@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"io"
+	"time"
+
+	sse "github.com/gin-contrib/sse"
+	gin "github.com/gin-gonic/gin"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	event "github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// keepAliveInterval is how often a comment is sent on an idle event stream to keep proxies from closing it
+const keepAliveInterval = 15 * time.Second
+
+// RecipeEventController streams recipe changes to clients with Server-Sent Events
+type RecipeEventController struct {
+	hub *service.EventHub
+}
+
+// NewRecipeEventController creates a new instance of RecipeEventController
+func NewRecipeEventController(hub *service.EventHub) *RecipeEventController {
+	return &RecipeEventController{
+		hub: hub,
+	}
+}
+
+// StreamRecipeEvents godoc
+// @Summary Stream recipe changes
+// @Description Stream recipe.created, recipe.updated, recipe.deleted and recipe.restored events as Server-Sent Events.
+// @Description Every event carries its ID; reconnecting with the Last-Event-ID header (or the last_event_id query parameter)
+// @Description first replays the events that were missed since then.
+// @Tags recipes
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Param last_event_id query string false "ID of the last event received, for clients that can not set headers"
+// @Success 200 {object} event.Event
+// @Failure 500
+// @Router /recipe/events [get]
+func (ec *RecipeEventController) StreamRecipeEvents(ctx *gin.Context) {
+	// Subscribe before replaying so no event falls in between; duplicates are skipped by ID
+	events, unsubscribe := ec.hub.Subscribe()
+	defer unsubscribe()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	last := ctx.GetHeader("Last-Event-ID")
+	if last == "" {
+		last = ctx.Query("last_event_id")
+	}
+	// Events are ordered by when they were committed, not by ID, so the replayed ones are remembered to skip them live
+	replayed := make(map[string]bool)
+	if last != "" {
+		err := ec.hub.Replay(ctx, last, func(e *event.Event) error {
+			ctx.Render(-1, toSSE(e))
+			replayed[e.ID] = true
+			return nil
+		})
+		if err != nil {
+			// An unknown or malformed ID can not be resumed from, so only new events are sent
+			_ = ctx.Error(err)
+		}
+	}
+	ctx.Writer.WriteHeaderNow()
+	ctx.Writer.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				// Fell behind or the server is shutting down, the client reconnects with Last-Event-ID
+				return false
+			}
+			if replayed[e.ID] {
+				delete(replayed, e.ID)
+				return true
+			}
+			ctx.Render(-1, toSSE(e))
+			return true
+		case <-keepAlive.C:
+			_, err := io.WriteString(w, ": keep-alive\n\n")
+			return err == nil
+		case <-ctx.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// toSSE converts a recipe event to a Server-Sent Event named after its type
+func toSSE(e *event.Event) sse.Event {
+	return sse.Event{
+		Id:    e.ID,
+		Event: string(e.Type),
+		Data:  e,
+	}
+}
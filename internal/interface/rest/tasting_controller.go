@@ -0,0 +1,179 @@
+package rest
+
+import (
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+	tasting "github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// TastingController handles HTTP requests related to tasting panels
+type TastingController struct {
+	tastingService service.TastingService
+}
+
+// NewTastingController creates a new instance of TastingController
+func NewTastingController(tastingService service.TastingService) *TastingController {
+	return &TastingController{
+		tastingService: tastingService,
+	}
+}
+
+// GetTastingSessionByID godoc
+// @Summary Get a Tasting Session by ID
+// @Description Get a Tasting Session with the scores of all panelists by ID
+// @Tags tasting
+// @Produce json
+// @Param id path string true "Tasting Session ID"
+// @Success 200 {object} tasting.Session
+// @Failure 404
+// @Failure 500
+// @Router /tasting/{id} [get]
+func (tc *TastingController) GetTastingSessionByID(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	session, err := tc.tastingService.GetSession(ctx, id)
+	if err != nil {
+		ctx.JSON(404, gin.H{"error": "Tasting session not found"})
+		return
+	}
+
+	ctx.JSON(200, session)
+}
+
+// CreateTastingSession godoc
+// @Summary Create a new Tasting Session
+// @Description Create a Tasting Session for a batch or a recipe
+// @Tags tasting
+// @Accept json
+// @Produce json
+// @Param session body command.TastingSessionRequest true "Tasting Session Request"
+// @Success 201 {object} tasting.Session
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /tasting [post]
+func (tc *TastingController) CreateTastingSession(ctx *gin.Context) {
+	var req command.TastingSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	session := &tasting.Session{
+		BatchID:   req.BatchID,
+		RecipeID:  req.RecipeID,
+		HeldAt:    req.HeldAt,
+		Panelists: req.Panelists,
+	}
+
+	createdSession, err := tc.tastingService.CreateSession(ctx, session)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, createdSession)
+}
+
+// AddTastingScore godoc
+// @Summary Record the score of a panelist
+// @Description Record the flavor attribute ratings (0-10) and notes of a panelist in a Tasting Session
+// @Tags tasting
+// @Accept json
+// @Produce json
+// @Param id path string true "Tasting Session ID"
+// @Param score body command.ScoreRequest true "Score Request"
+// @Success 200 {object} tasting.Session
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /tasting/{id}/score [post]
+func (tc *TastingController) AddTastingScore(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		ctx.JSON(400, gin.H{"error": "ID is required"})
+		return
+	}
+
+	var req command.ScoreRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	score := tasting.Score{
+		Panelist: req.Panelist,
+		Ratings:  req.Ratings,
+		Notes:    req.Notes,
+	}
+
+	session, err := tc.tastingService.AddScore(ctx, id, score)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, session)
+}
+
+// ListTastingSessions godoc
+// @Summary List Tasting Sessions
+// @Description List Tasting Sessions with pagination, optionally for a batch or a recipe
+// @Tags tasting
+// @Produce json
+// @Param batch_id query string false "Batch ID"
+// @Param recipe_id query string false "Recipe ID"
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} tasting.Session
+// @Failure 500
+// @Router /tasting [get]
+func (tc *TastingController) ListTastingSessions(ctx *gin.Context) {
+	limitStr := ctx.DefaultQuery("limit", "10")
+	offsetStr := ctx.DefaultQuery("offset", "0")
+
+	limit, err := strconv.ParseInt(limitStr, 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	sessions, err := tc.tastingService.ListSessions(ctx, ctx.Query("batch_id"), ctx.Query("recipe_id"), limit, offset)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, sessions)
+}
+
+// GetTastingSummary godoc
+// @Summary Get the aggregated tasting scores
+// @Description Get the mean and standard deviation per flavor attribute, and spider chart data, over all Tasting Sessions of a batch or a recipe
+// @Tags tasting
+// @Produce json
+// @Param batch_id query string false "Batch ID"
+// @Param recipe_id query string false "Recipe ID"
+// @Success 200 {object} tasting.Summary
+// @Failure 400
+// @Failure 500
+// @Router /tasting/summary [get]
+func (tc *TastingController) GetTastingSummary(ctx *gin.Context) {
+	summary, err := tc.tastingService.Summary(ctx, ctx.Query("batch_id"), ctx.Query("recipe_id"))
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, summary)
+}
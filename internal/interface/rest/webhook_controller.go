@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"strconv"
+
+	gin "github.com/gin-gonic/gin"
+	command "github.com/onasunnymorning/go-make-chocolate/internal/command"
+	service "github.com/onasunnymorning/go-make-chocolate/internal/service"
+)
+
+// WebhookController handles HTTP requests related to webhooks
+type WebhookController struct {
+	webhookService service.WebhookService
+}
+
+// NewWebhookController creates a new instance of WebhookController
+func NewWebhookController(webhookService service.WebhookService) *WebhookController {
+	return &WebhookController{
+		webhookService: webhookService,
+	}
+}
+
+// CreateWebhook godoc
+// @Summary Register a Webhook
+// @Description Register an endpoint to receive recipe events. Every delivery is signed with an HMAC-SHA256 of the
+// @Description X-Chocolate-Timestamp header, a dot and the body, using the secret, in the X-Chocolate-Signature header.
+// @Description Failed deliveries are retried with exponential backoff.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body command.WebhookRequest true "Webhook Request"
+// @Success 201 {object} event.Webhook
+// @Failure 400
+// @Failure 500
+// @Router /webhook [post]
+func (wc *WebhookController) CreateWebhook(ctx *gin.Context) {
+	var req command.WebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	created, err := wc.webhookService.Create(ctx, req.ToDomain())
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(201, created)
+}
+
+// GetWebhookByID godoc
+// @Summary Get a Webhook by ID
+// @Description Get a registered Webhook by ID. The secret is never returned.
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} event.Webhook
+// @Failure 404
+// @Failure 500
+// @Router /webhook/{id} [get]
+func (wc *WebhookController) GetWebhookByID(ctx *gin.Context) {
+	w, err := wc.webhookService.GetByID(ctx, ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+// UpdateWebhook godoc
+// @Summary Update a Webhook
+// @Description Update the URL, event types or active state of a Webhook. The secret is kept when omitted.
+// @Tags webhooks
+// @Accept json
+// @Param id path string true "Webhook ID"
+// @Param webhook body command.WebhookRequest true "Webhook Request"
+// @Success 204
+// @Failure 400
+// @Failure 404
+// @Failure 500
+// @Router /webhook/{id} [put]
+func (wc *WebhookController) UpdateWebhook(ctx *gin.Context) {
+	var req command.WebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+
+	w := req.ToDomain()
+	w.ID = ctx.Param("id")
+	if err := wc.webhookService.Update(ctx, w); err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a Webhook
+// @Description Stop delivering events to a Webhook
+// @Tags webhooks
+// @Param id path string true "Webhook ID"
+// @Success 204
+// @Failure 404
+// @Failure 500
+// @Router /webhook/{id} [delete]
+func (wc *WebhookController) DeleteWebhook(ctx *gin.Context) {
+	if err := wc.webhookService.Delete(ctx, ctx.Param("id")); err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// ListWebhooks godoc
+// @Summary List Webhooks
+// @Description List all registered Webhooks
+// @Tags webhooks
+// @Produce json
+// @Success 200 {array} event.Webhook
+// @Failure 500
+// @Router /webhook [get]
+func (wc *WebhookController) ListWebhooks(ctx *gin.Context) {
+	webhooks, err := wc.webhookService.List(ctx)
+	if err != nil {
+		ctx.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, webhooks)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List the deliveries to a Webhook
+// @Description List the delivery log of a Webhook with every attempt, most recent first
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param limit query int false "Limit" default(10)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {array} event.Delivery
+// @Failure 404
+// @Failure 500
+// @Router /webhook/{id}/delivery [get]
+func (wc *WebhookController) ListWebhookDeliveries(ctx *gin.Context) {
+	limit, err := strconv.ParseInt(ctx.DefaultQuery("limit", "10"), 10, 64)
+	if err != nil {
+		limit = 10
+	}
+	offset, err := strconv.ParseInt(ctx.DefaultQuery("offset", "0"), 10, 64)
+	if err != nil {
+		offset = 0
+	}
+
+	deliveries, err := wc.webhookService.Deliveries(ctx, ctx.Param("id"), limit, offset)
+	if err != nil {
+		ctx.JSON(statusFor(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	ctx.JSON(200, deliveries)
+}
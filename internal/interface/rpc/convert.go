@@ -0,0 +1,339 @@
+package rpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipepb"
+)
+
+// The conversions between the recipe types and their protobuf messages.
+// Optional messages map to nil pointers, and zero times to unset timestamps.
+
+func toProtoTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toProtoTimePtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return toProtoTime(*t)
+}
+
+func toProtoQuantity(q recipe.Quantity) *recipepb.Quantity {
+	return &recipepb.Quantity{Amount: q.Amount, Unit: string(q.Unit)}
+}
+
+func fromProtoQuantity(q *recipepb.Quantity) recipe.Quantity {
+	return recipe.Quantity{Amount: q.GetAmount(), Unit: recipe.Unit(q.GetUnit())}
+}
+
+func toProtoOrigin(o *recipe.Origin) *recipepb.Origin {
+	if o == nil {
+		return nil
+	}
+	certifications := make([]string, len(o.Certifications))
+	for i, c := range o.Certifications {
+		certifications[i] = string(c)
+	}
+	return &recipepb.Origin{
+		Country:        o.Country,
+		Region:         o.Region,
+		Producer:       o.Producer,
+		Variety:        o.Variety,
+		HarvestYear:    int32(o.HarvestYear),
+		Fermentation:   o.Fermentation,
+		Drying:         o.Drying,
+		Certifications: certifications,
+	}
+}
+
+func fromProtoOrigin(o *recipepb.Origin) *recipe.Origin {
+	if o == nil {
+		return nil
+	}
+	var certifications []recipe.Certification
+	for _, c := range o.Certifications {
+		certifications = append(certifications, recipe.Certification(c))
+	}
+	return &recipe.Origin{
+		Country:        o.Country,
+		Region:         o.Region,
+		Producer:       o.Producer,
+		Variety:        o.Variety,
+		HarvestYear:    int(o.HarvestYear),
+		Fermentation:   o.Fermentation,
+		Drying:         o.Drying,
+		Certifications: certifications,
+	}
+}
+
+func toProtoIngredients(ingredients []recipe.Ingredient) []*recipepb.Ingredient {
+	pb := make([]*recipepb.Ingredient, len(ingredients))
+	for i, ing := range ingredients {
+		pb[i] = &recipepb.Ingredient{
+			Name:      ing.Name,
+			IsCacao:   ing.IsCacao,
+			Quantity:  toProtoQuantity(ing.Quantity),
+			CacaoId:   ing.CacaoID,
+			Origin:    toProtoOrigin(ing.Origin),
+			CostPerKg: ing.CostPerKg,
+		}
+	}
+	return pb
+}
+
+func fromProtoIngredients(pb []*recipepb.Ingredient) []recipe.Ingredient {
+	var ingredients []recipe.Ingredient
+	for _, ing := range pb {
+		ingredients = append(ingredients, recipe.Ingredient{
+			Name:      ing.GetName(),
+			IsCacao:   ing.GetIsCacao(),
+			Quantity:  fromProtoQuantity(ing.GetQuantity()),
+			CacaoID:   ing.GetCacaoId(),
+			Origin:    fromProtoOrigin(ing.GetOrigin()),
+			CostPerKg: ing.GetCostPerKg(),
+		})
+	}
+	return ingredients
+}
+
+func toProtoLosses(losses []recipe.ProcessLoss) []*recipepb.ProcessLoss {
+	pb := make([]*recipepb.ProcessLoss, len(losses))
+	for i, loss := range losses {
+		pb[i] = &recipepb.ProcessLoss{Stage: loss.Stage, Percentage: loss.Percentage}
+	}
+	return pb
+}
+
+func fromProtoLosses(pb []*recipepb.ProcessLoss) []recipe.ProcessLoss {
+	var losses []recipe.ProcessLoss
+	for _, loss := range pb {
+		losses = append(losses, recipe.ProcessLoss{Stage: loss.GetStage(), Percentage: loss.GetPercentage()})
+	}
+	return losses
+}
+
+func toProtoTempering(c *recipe.TemperingCurve) *recipepb.TemperingCurve {
+	if c == nil {
+		return nil
+	}
+	return &recipepb.TemperingCurve{MeltTemperature: c.MeltTemperature, CoolTemperature: c.CoolTemperature, WorkTemperature: c.WorkTemperature}
+}
+
+func fromProtoTempering(c *recipepb.TemperingCurve) *recipe.TemperingCurve {
+	if c == nil {
+		return nil
+	}
+	return &recipe.TemperingCurve{MeltTemperature: c.MeltTemperature, CoolTemperature: c.CoolTemperature, WorkTemperature: c.WorkTemperature}
+}
+
+func toProtoProcess(p *recipe.ProcessProfile) *recipepb.ProcessProfile {
+	if p == nil {
+		return nil
+	}
+	pb := &recipepb.ProcessProfile{WinnowingRatio: p.WinnowingRatio}
+	if p.Roast != nil {
+		pb.Roast = &recipepb.RoastProfile{}
+		for _, point := range p.Roast.Points {
+			pb.Roast.Points = append(pb.Roast.Points, &recipepb.CurvePoint{Minute: point.Minute, Temperature: point.Temperature})
+		}
+	}
+	if p.Refining != nil {
+		pb.Refining = &recipepb.RefiningProfile{DurationHours: p.Refining.DurationHours, TargetParticleSize: p.Refining.TargetParticleSize}
+	}
+	if p.Conching != nil {
+		pb.Conching = &recipepb.ConchingProfile{DurationHours: p.Conching.DurationHours, Temperature: p.Conching.Temperature}
+	}
+	return pb
+}
+
+func fromProtoProcess(pb *recipepb.ProcessProfile) *recipe.ProcessProfile {
+	if pb == nil {
+		return nil
+	}
+	p := &recipe.ProcessProfile{WinnowingRatio: pb.WinnowingRatio}
+	if pb.Roast != nil {
+		p.Roast = &recipe.RoastProfile{}
+		for _, point := range pb.Roast.Points {
+			p.Roast.Points = append(p.Roast.Points, recipe.CurvePoint{Minute: point.GetMinute(), Temperature: point.GetTemperature()})
+		}
+	}
+	if pb.Refining != nil {
+		p.Refining = &recipe.RefiningProfile{DurationHours: pb.Refining.DurationHours, TargetParticleSize: pb.Refining.TargetParticleSize}
+	}
+	if pb.Conching != nil {
+		p.Conching = &recipe.ConchingProfile{DurationHours: pb.Conching.DurationHours, Temperature: pb.Conching.Temperature}
+	}
+	return p
+}
+
+func toProtoNutrition(n *recipe.Nutrition) *recipepb.Nutrition {
+	if n == nil {
+		return nil
+	}
+	return &recipepb.Nutrition{
+		Energy:       n.Energy,
+		Fat:          n.Fat,
+		SaturatedFat: n.SaturatedFat,
+		Carbohydrate: n.Carbohydrate,
+		Sugar:        n.Sugar,
+		Protein:      n.Protein,
+		Fiber:        n.Fiber,
+		Sodium:       n.Sodium,
+	}
+}
+
+func fromProtoNutrition(pb *recipepb.Nutrition) *recipe.Nutrition {
+	if pb == nil {
+		return nil
+	}
+	return &recipe.Nutrition{
+		Energy:       pb.Energy,
+		Fat:          pb.Fat,
+		SaturatedFat: pb.SaturatedFat,
+		Carbohydrate: pb.Carbohydrate,
+		Sugar:        pb.Sugar,
+		Protein:      pb.Protein,
+		Fiber:        pb.Fiber,
+		Sodium:       pb.Sodium,
+	}
+}
+
+// toProtoRecipe converts a recipe to its protobuf message
+func toProtoRecipe(r *recipe.Recipe) *recipepb.Recipe {
+	return &recipepb.Recipe{
+		Id:              r.ID,
+		Name:            r.Name,
+		Description:     r.Description,
+		Ingredients:     toProtoIngredients(r.Ingredients),
+		Instructions:    r.Instructions,
+		CreatedAt:       toProtoTime(r.CreatedAt),
+		UpdatedAt:       toProtoTime(r.UpdatedAt),
+		CreatedBy:       r.CreatedBy,
+		UpdatedBy:       r.UpdatedBy,
+		CacaoPercentage: r.CacaoPercentage,
+		Yield:           toProtoQuantity(r.Yield),
+		Input:           toProtoQuantity(r.Input),
+		Losses:          toProtoLosses(r.Losses),
+		Classification:  string(r.Classification),
+		Tempering:       toProtoTempering(r.Tempering),
+		Process:         toProtoProcess(r.Process),
+		Nutrition:       toProtoNutrition(r.Nutrition),
+		Status:          string(r.Status),
+		Revision:        int32(r.Revision),
+		RevisionOf:      r.RevisionOf,
+		ApprovedBy:      r.ApprovedBy,
+		ApprovedAt:      toProtoTimePtr(r.ApprovedAt),
+		DeletedAt:       toProtoTimePtr(r.DeletedAt),
+		DeletedBy:       r.DeletedBy,
+	}
+}
+
+func toProtoRecipes(recipes []*recipe.Recipe) []*recipepb.Recipe {
+	pb := make([]*recipepb.Recipe, len(recipes))
+	for i, r := range recipes {
+		pb[i] = toProtoRecipe(r)
+	}
+	return pb
+}
+
+// fromProtoRecipe returns the fields of a recipe message that can be set by the caller, like the REST API reads them from a RecipeRequest
+func fromProtoRecipe(pb *recipepb.Recipe) *recipe.Recipe {
+	return &recipe.Recipe{
+		Name:           pb.GetName(),
+		Description:    pb.GetDescription(),
+		Ingredients:    fromProtoIngredients(pb.GetIngredients()),
+		Instructions:   pb.GetInstructions(),
+		Losses:         fromProtoLosses(pb.GetLosses()),
+		Classification: recipe.Classification(pb.GetClassification()),
+		Tempering:      fromProtoTempering(pb.GetTempering()),
+		Process:        fromProtoProcess(pb.GetProcess()),
+		Nutrition:      fromProtoNutrition(pb.GetNutrition()),
+	}
+}
+
+// toProtoTemplate converts a template recipe to its protobuf message
+func toProtoTemplate(tr *recipe.TemplateRecipe) *recipepb.TemplateRecipe {
+	ingredients := make([]*recipepb.TemplateIngredient, len(tr.Ingredients))
+	for i, ing := range tr.Ingredients {
+		ingredients[i] = &recipepb.TemplateIngredient{
+			Name:       ing.Name,
+			IsCacao:    ing.IsCacao,
+			Percentage: ing.Percentage,
+			CacaoId:    ing.CacaoID,
+			Origin:     toProtoOrigin(ing.Origin),
+			CostPerKg:  ing.CostPerKg,
+		}
+	}
+	return &recipepb.TemplateRecipe{
+		RecipeId:        tr.RecipeID,
+		Name:            tr.Name,
+		Description:     tr.Description,
+		Ingredients:     ingredients,
+		Instructions:    tr.Instructions,
+		CacaoPercentage: tr.CacaoPercentage,
+		Losses:          toProtoLosses(tr.Losses),
+		Classification:  string(tr.Classification),
+		Tempering:       toProtoTempering(tr.Tempering),
+		Process:         toProtoProcess(tr.Process),
+		Nutrition:       toProtoNutrition(tr.Nutrition),
+	}
+}
+
+func fromProtoFilter(pb *recipepb.RecipeFilter) recipe.Filter {
+	return recipe.Filter{
+		Name:          pb.GetName(),
+		CreatedBy:     pb.GetCreatedBy(),
+		MinCacao:      pb.GetMinCacao(),
+		MaxCacao:      pb.GetMaxCacao(),
+		Status:        recipe.Status(pb.GetStatus()),
+		OriginCountry: pb.GetOriginCountry(),
+		OriginRegion:  pb.GetOriginRegion(),
+		Variety:       pb.GetVariety(),
+		Certification: recipe.Certification(pb.GetCertification()),
+	}
+}
+
+func fromProtoPlan(req *recipepb.CreatePurchaseListRequest) *recipe.ProductionPlan {
+	plan := &recipe.ProductionPlan{Inventory: fromProtoIngredients(req.Inventory)}
+	for _, item := range req.Items {
+		plan.Items = append(plan.Items, recipe.PlanItem{RecipeID: item.GetRecipeId(), Yield: item.GetYield()})
+	}
+	return plan
+}
+
+func toProtoPurchaseList(list *recipe.PurchaseList) *recipepb.PurchaseList {
+	pb := &recipepb.PurchaseList{Items: make([]*recipepb.PurchaseItem, len(list.Items))}
+	for i, item := range list.Items {
+		pb.Items[i] = &recipepb.PurchaseItem{
+			Name:     item.Name,
+			IsCacao:  item.IsCacao,
+			Required: toProtoQuantity(item.Required),
+			OnHand:   toProtoQuantity(item.OnHand),
+			ToBuy:    toProtoQuantity(item.ToBuy),
+		}
+	}
+	return pb
+}
+
+func toProtoReport(report *bulk.Report) *recipepb.ImportReport {
+	pb := &recipepb.ImportReport{
+		DryRun:    report.DryRun,
+		Total:     int32(report.Total),
+		Succeeded: int32(report.Succeeded),
+		Failed:    int32(report.Failed),
+		Results:   make([]*recipepb.ImportResult, len(report.Results)),
+	}
+	for i, result := range report.Results {
+		pb.Results[i] = &recipepb.ImportResult{Line: int32(result.Line), Name: result.Name, Id: result.ID, Error: result.Error}
+	}
+	return pb
+}
@@ -0,0 +1,43 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// codeFor returns the gRPC status code for an error returned by a service, following the status codes of the REST API.
+// Missing resources are reported as NotFound, missing permissions as PermissionDenied, conflicts with the state of a resource
+// as FailedPrecondition, other domain errors as InvalidArgument and anything else as Internal.
+func codeFor(err error) codes.Code {
+	switch {
+	case errors.Is(err, recipe.ErrRecipeNotFound), errors.Is(err, recipe.ErrCacaoNotFound), errors.Is(err, recipe.ErrMoldNotFound):
+		return codes.NotFound
+	case errors.Is(err, recipe.ErrReviewerRequired):
+		return codes.PermissionDenied
+	case errors.Is(err, recipe.ErrInvalidTransition), errors.Is(err, recipe.ErrRecipeArchived), errors.Is(err, recipe.ErrRecipeNotApproved):
+		return codes.FailedPrecondition
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+	var recipeErr *recipe.Error
+	if errors.As(err, &recipeErr) {
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+// statusError converts an error returned by a service to a gRPC status error.
+// Errors that already have a status, such as those of a broken stream, are returned unchanged.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codeFor(err), err.Error())
+}
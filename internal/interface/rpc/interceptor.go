@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/requestid"
+)
+
+// Metadata keys identifying the caller and the request, the gRPC counterparts of the headers of the REST API
+const (
+	UserKey        = "x-user"
+	RolesKey       = "x-roles"
+	RequestIDKey   = "x-request-id"
+	ProxySecretKey = "x-proxy-secret"
+)
+
+// withIdentity returns a copy of ctx carrying the actor identified by the x-user and x-roles metadata,
+// and the ID of the request taken from the x-request-id metadata or generated.
+// Calls without x-user, or whose identity the policy does not trust, are handled as the anonymous actor without roles.
+// The request ID is sent back in the response header.
+func withIdentity(ctx context.Context, policy actor.Policy) context.Context {
+	first := func(key string) string { return firstMetadata(ctx, key) }
+
+	a := policy.Identify(first(ProxySecretKey), first(UserKey), first(RolesKey))
+	id := first(RequestIDKey)
+	if id == "" || len(id) > 128 {
+		id = requestid.New()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+	return requestid.NewContext(actor.NewContext(ctx, a), id)
+}
+
+// firstMetadata returns the first value of the incoming metadata key, or an empty string
+func firstMetadata(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// authenticate checks the call identified by withIdentity against the policy, reporting rejected calls as Unauthenticated
+func authenticate(ctx context.Context, policy actor.Policy) error {
+	if err := policy.Check(firstMetadata(ctx, ProxySecretKey), actor.FromContext(ctx)); err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return nil
+}
+
+// AuthUnaryInterceptor rejects the unary calls the policy does not accept, it must be chained after UnaryInterceptor
+func AuthUnaryInterceptor(policy actor.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authenticate(ctx, policy); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor rejects the streaming calls the policy does not accept, it must be chained after StreamInterceptor
+func AuthStreamInterceptor(policy actor.Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context(), policy); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// UnaryInterceptor identifies the caller of unary calls as the policy allows and logs every call with Zap
+func UnaryInterceptor(logger *zap.Logger, policy actor.Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx = withIdentity(ctx, policy)
+		resp, err := handler(ctx, req)
+		logCall(logger, ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamInterceptor identifies the caller of streaming calls as the policy allows and logs every call with Zap
+func StreamInterceptor(logger *zap.Logger, policy actor.Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		wrapped := &identifiedStream{ServerStream: ss, ctx: withIdentity(ss.Context(), policy)}
+		err := handler(srv, wrapped)
+		logCall(logger, wrapped.ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// identifiedStream is a server stream with the context returned by withIdentity
+type identifiedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identifiedStream) Context() context.Context {
+	return s.ctx
+}
+
+func logCall(logger *zap.Logger, ctx context.Context, method string, start time.Time, err error) {
+	logger.Info(method,
+		zap.String("code", status.Code(err).String()),
+		zap.Duration("latency", time.Since(start)),
+		zap.String("user", actor.FromContext(ctx).ID),
+		zap.String("request_id", requestid.FromContext(ctx)),
+	)
+}
@@ -0,0 +1,278 @@
+// Package rpc serves the Recipe API over gRPC, next to the REST API of the rest package and on top of the same services.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipepb"
+)
+
+// defaultLimit is the page size when a list request does not set a limit, like in the REST API
+const defaultLimit = 10
+
+// NewServer creates a gRPC server that identifies the caller of every call as the policy allows and logs it.
+// Interceptors chained in opts run after the caller is identified.
+func NewServer(logger *zap.Logger, policy actor.Policy, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryInterceptor(logger, policy)),
+		grpc.ChainStreamInterceptor(StreamInterceptor(logger, policy)),
+	}, opts...)
+	return grpc.NewServer(opts...)
+}
+
+// RecipeServer implements the RecipeService of recipe.proto
+type RecipeServer struct {
+	recipepb.UnimplementedRecipeServiceServer
+	recipeService service.RecipeService
+}
+
+// NewRecipeServer creates a new instance of RecipeServer
+func NewRecipeServer(recipeService service.RecipeService) *RecipeServer {
+	return &RecipeServer{recipeService: recipeService}
+}
+
+// CreateRecipe creates a draft recipe
+func (s *RecipeServer) CreateRecipe(ctx context.Context, req *recipepb.CreateRecipeRequest) (*recipepb.Recipe, error) {
+	if req.Recipe == nil {
+		return nil, status.Error(codes.InvalidArgument, "Recipe is required")
+	}
+
+	created, err := s.recipeService.Create(ctx, fromProtoRecipe(req.Recipe))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoRecipe(created), nil
+}
+
+// GetRecipe retrieves a recipe, scaled to the yield of the request if it has one
+func (s *RecipeServer) GetRecipe(ctx context.Context, req *recipepb.GetRecipeRequest) (*recipepb.Recipe, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+	if req.Yield < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid yield value")
+	}
+
+	rcp, err := s.recipeService.GetByID(ctx, req.Id)
+	if err != nil || rcp == nil {
+		return nil, status.Error(codes.NotFound, "Recipe not found")
+	}
+	if req.Yield > 0 {
+		rcp = rcp.ToTemplate().ToRecipe(req.Yield)
+		if rcp == nil {
+			return nil, status.Error(codes.InvalidArgument, "Recipe could not be rendered with the specified yield")
+		}
+	}
+	return toProtoRecipe(rcp), nil
+}
+
+// GetTemplate retrieves a recipe as a template
+func (s *RecipeServer) GetTemplate(ctx context.Context, req *recipepb.GetTemplateRequest) (*recipepb.TemplateRecipe, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	tr, err := s.recipeService.GetTemplateByID(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Recipe not found")
+	}
+	return toProtoTemplate(tr), nil
+}
+
+// UpdateRecipe updates a recipe, which is saved as a new draft revision if it is approved
+func (s *RecipeServer) UpdateRecipe(ctx context.Context, req *recipepb.UpdateRecipeRequest) (*recipepb.UpdateRecipeResponse, error) {
+	if req.Recipe.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	rcp := fromProtoRecipe(req.Recipe)
+	rcp.ID = req.Recipe.Id
+	updated, err := s.recipeService.Update(ctx, rcp)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &recipepb.UpdateRecipeResponse{Recipe: toProtoRecipe(updated), NewRevision: updated.ID != rcp.ID}, nil
+}
+
+// TransitionRecipe changes the lifecycle status of a recipe
+func (s *RecipeServer) TransitionRecipe(ctx context.Context, req *recipepb.TransitionRecipeRequest) (*recipepb.Recipe, error) {
+	switch recipe.Status(req.Status) {
+	case recipe.Draft, recipe.InReview, recipe.Approved, recipe.Archived:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Status must be draft, in_review, approved or archived")
+	}
+
+	updated, err := s.recipeService.Transition(ctx, req.Id, recipe.Status(req.Status))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoRecipe(updated), nil
+}
+
+// DeleteRecipe moves a recipe to the trash
+func (s *RecipeServer) DeleteRecipe(ctx context.Context, req *recipepb.DeleteRecipeRequest) (*emptypb.Empty, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "ID is required")
+	}
+
+	if err := s.recipeService.Delete(ctx, req.Id); err != nil {
+		return nil, statusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RestoreRecipe restores a recipe from the trash
+func (s *RecipeServer) RestoreRecipe(ctx context.Context, req *recipepb.RestoreRecipeRequest) (*recipepb.Recipe, error) {
+	restored, err := s.recipeService.Restore(ctx, req.Id)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoRecipe(restored), nil
+}
+
+// ListRecipes lists a page of the recipes matching the filter in the sort order, after the page token
+func (s *RecipeServer) ListRecipes(ctx context.Context, req *recipepb.ListRecipesRequest) (*recipepb.ListRecipesResponse, error) {
+	limit, err := pageLimit(req.Limit, 0)
+	if err != nil {
+		return nil, err
+	}
+	opts := recipe.ListOptions{Filter: fromProtoFilter(req.Filter), Limit: limit}
+	if opts.Sort, err = recipe.ParseSort(req.Sort); err != nil {
+		return nil, statusError(err)
+	}
+	if req.PageToken != "" {
+		after, err := recipe.ParseCursor(req.PageToken, opts.Sort)
+		if err != nil {
+			return nil, statusError(err)
+		}
+		opts.After = &after
+	}
+
+	page, err := s.recipeService.List(ctx, opts)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &recipepb.ListRecipesResponse{Recipes: toProtoRecipes(page.Recipes), NextPageToken: page.NextCursor, TotalSize: page.Total}, nil
+}
+
+// ListTrash lists the recipes in the trash with pagination
+func (s *RecipeServer) ListTrash(ctx context.Context, req *recipepb.ListTrashRequest) (*recipepb.ListTrashResponse, error) {
+	limit, err := pageLimit(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	recipes, err := s.recipeService.Trash(ctx, limit, req.Offset)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &recipepb.ListTrashResponse{Recipes: toProtoRecipes(recipes)}, nil
+}
+
+// pageLimit validates the pagination of a list request, returning the default limit if none is set
+func pageLimit(limit, offset int64) (int64, error) {
+	if limit < 0 || offset < 0 {
+		return 0, status.Error(codes.InvalidArgument, "Limit and offset must not be negative")
+	}
+	if limit == 0 {
+		return defaultLimit, nil
+	}
+	return limit, nil
+}
+
+// CountRecipes returns the total number of recipes
+func (s *RecipeServer) CountRecipes(ctx context.Context, req *recipepb.CountRecipesRequest) (*recipepb.CountRecipesResponse, error) {
+	count, err := s.recipeService.Count(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &recipepb.CountRecipesResponse{Count: count}, nil
+}
+
+// CreatePurchaseList creates the purchase list for a production plan
+func (s *RecipeServer) CreatePurchaseList(ctx context.Context, req *recipepb.CreatePurchaseListRequest) (*recipepb.PurchaseList, error) {
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Items are required")
+	}
+
+	list, err := s.recipeService.PurchaseList(ctx, fromProtoPlan(req))
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toProtoPurchaseList(list), nil
+}
+
+// ImportRecipes creates the recipes of the stream, or only validates them if the first message sets dry_run
+func (s *RecipeServer) ImportRecipes(stream grpc.ClientStreamingServer[recipepb.ImportRecipesRequest, recipepb.ImportReport]) error {
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	r := &streamReader{stream: stream, next: first}
+
+	report, err := s.recipeService.Import(stream.Context(), r, first.GetDryRun())
+	if err != nil {
+		return statusError(err)
+	}
+	return stream.SendAndClose(toProtoReport(report))
+}
+
+// streamReader reads the recipes of an import stream as bulk records, numbered from 1
+type streamReader struct {
+	stream grpc.ClientStreamingServer[recipepb.ImportRecipesRequest, recipepb.ImportReport]
+	next   *recipepb.ImportRecipesRequest // Message received before the reader was created
+	line   int
+}
+
+func (r *streamReader) Next() (*bulk.Record, error) {
+	msg := r.next
+	r.next = nil
+	if msg == nil {
+		var err error
+		if msg, err = r.stream.Recv(); err != nil {
+			return nil, err
+		}
+	}
+
+	r.line++
+	rec := &bulk.Record{Line: r.line}
+	if msg.Recipe == nil {
+		rec.Err = errors.New("recipe is required")
+		return rec, nil
+	}
+	rec.Recipe = fromProtoRecipe(msg.Recipe)
+	return rec, nil
+}
+
+// ExportRecipes streams every recipe matching the filter
+func (s *RecipeServer) ExportRecipes(req *recipepb.ExportRecipesRequest, stream grpc.ServerStreamingServer[recipepb.Recipe]) error {
+	if err := s.recipeService.Export(stream.Context(), fromProtoFilter(req.Filter), &streamWriter{stream: stream}); err != nil {
+		return statusError(err)
+	}
+	return nil
+}
+
+// streamWriter sends exported recipes on a stream
+type streamWriter struct {
+	stream grpc.ServerStreamingServer[recipepb.Recipe]
+}
+
+func (w *streamWriter) Write(r *recipe.Recipe) error {
+	return w.stream.Send(toProtoRecipe(r))
+}
+
+func (w *streamWriter) Flush() error {
+	return nil
+}
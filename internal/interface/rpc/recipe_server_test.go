@@ -0,0 +1,250 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/memory"
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipepb"
+)
+
+// newTestClient serves the RecipeServer over in-memory stores and returns a client for it, calling as alice with the reviewer role
+func newTestClient(t *testing.T, opts ...grpc.ServerOption) (recipepb.RecipeServiceClient, context.Context) {
+	t.Helper()
+	recipeService := service.NewRecipeService(memory.NewRecipeStore(), memory.NewCacaoStore(), memory.NewAuditStore(), memory.NewOutboxStore(), memory.NewTransactor())
+	srv := NewServer(zap.NewNop(), actor.Policy{TrustIdentity: true}, opts...)
+	recipepb.RegisterRecipeServiceServer(srv, NewRecipeServer(recipeService))
+
+	lis := bufconn.Listen(1 << 20)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), UserKey, "alice", RolesKey, "reviewer")
+	return recipepb.NewRecipeServiceClient(conn), ctx
+}
+
+func dark70() *recipepb.Recipe {
+	return &recipepb.Recipe{
+		Name: "Dark 70",
+		Ingredients: []*recipepb.Ingredient{
+			{Name: "Cacao nibs", IsCacao: true, Quantity: &recipepb.Quantity{Amount: 700, Unit: "g"}},
+			{Name: "Cane sugar", Quantity: &recipepb.Quantity{Amount: 300, Unit: "g"}},
+		},
+		Instructions: "Roast the beans.\nConche for 24 hours.",
+		Losses:       []*recipepb.ProcessLoss{{Stage: "overall", Percentage: 10}},
+		Tempering:    &recipepb.TemperingCurve{MeltTemperature: 50, CoolTemperature: 28, WorkTemperature: 31},
+	}
+}
+
+func TestRecipeServer(t *testing.T) {
+	client, ctx := newTestClient(t)
+
+	var header metadata.MD
+	created, err := client.CreateRecipe(ctx, &recipepb.CreateRecipeRequest{Recipe: dark70()}, grpc.Header(&header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Id == "" || created.CacaoPercentage != 70 || created.CreatedBy != "alice" || created.Status != "draft" || created.CreatedAt == nil {
+		t.Fatalf("unexpected created recipe %v", created)
+	}
+	if created.Tempering.GetCoolTemperature() != 28 || created.Yield.GetAmount() != 900 {
+		t.Errorf("expected the tempering curve and the yield net of losses, got %v", created)
+	}
+	if len(header.Get(RequestIDKey)) != 1 {
+		t.Errorf("expected a request ID in the response header, got %v", header)
+	}
+
+	scaled, err := client.GetRecipe(ctx, &recipepb.GetRecipeRequest{Id: created.Id, Yield: 450})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scaled.Ingredients[0].Quantity.Amount != 350 || scaled.Yield.Amount != 450 {
+		t.Errorf("expected 350 g of nibs for a yield of 450 g, got %v", scaled.Ingredients[0])
+	}
+
+	template, err := client.GetTemplate(ctx, &recipepb.GetTemplateRequest{Id: created.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.RecipeId != created.Id || template.Ingredients[1].Percentage != 30 {
+		t.Errorf("unexpected template %v", template)
+	}
+
+	for _, s := range []string{"in_review", "approved"} {
+		if _, err := client.TransitionRecipe(ctx, &recipepb.TransitionRecipeRequest{Id: created.Id, Status: s}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	updated, err := client.UpdateRecipe(ctx, &recipepb.UpdateRecipeRequest{Recipe: &recipepb.Recipe{
+		Id: created.Id, Name: "Dark 72", Ingredients: dark70().Ingredients, Instructions: "Temper.",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !updated.NewRevision || updated.Recipe.RevisionOf != created.Id || updated.Recipe.Revision != 2 {
+		t.Errorf("expected a new revision of the approved recipe, got %v", updated)
+	}
+
+	if _, err := client.DeleteRecipe(ctx, &recipepb.DeleteRecipeRequest{Id: updated.Recipe.Id}); err != nil {
+		t.Fatal(err)
+	}
+	trash, err := client.ListTrash(ctx, &recipepb.ListTrashRequest{})
+	if err != nil || len(trash.Recipes) != 1 || trash.Recipes[0].DeletedBy != "alice" {
+		t.Errorf("expected the revision in the trash, got %v, %v", trash, err)
+	}
+	list, err := client.ListRecipes(ctx, &recipepb.ListRecipesRequest{Filter: &recipepb.RecipeFilter{Status: "approved"}})
+	if err != nil || len(list.Recipes) != 1 || list.Recipes[0].Id != created.Id || list.TotalSize != 1 || list.NextPageToken != "" {
+		t.Errorf("expected only the approved recipe, got %v, %v", list, err)
+	}
+	count, err := client.CountRecipes(ctx, &recipepb.CountRecipesRequest{})
+	if err != nil || count.Count != 1 {
+		t.Errorf("expected 1 recipe, got %v, %v", count, err)
+	}
+
+	purchase, err := client.CreatePurchaseList(ctx, &recipepb.CreatePurchaseListRequest{
+		Items: []*recipepb.PlanItem{{RecipeId: created.Id, Yield: 1800}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(purchase.Items) != 2 || purchase.Items[0].ToBuy.Amount != 1400 {
+		t.Errorf("expected 1400 g of nibs to buy, got %v", purchase.Items)
+	}
+}
+
+func TestRecipeServerErrors(t *testing.T) {
+	client, ctx := newTestClient(t)
+	created, err := client.CreateRecipe(ctx, &recipepb.CreateRecipeRequest{Recipe: dark70()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		code codes.Code
+	}{
+		{"missing recipe", func() error {
+			_, err := client.GetRecipe(ctx, &recipepb.GetRecipeRequest{Id: "65f1c0ffee0000000000beef"})
+			return err
+		}, codes.NotFound},
+		{"invalid recipe", func() error {
+			_, err := client.CreateRecipe(ctx, &recipepb.CreateRecipeRequest{Recipe: &recipepb.Recipe{Name: "Empty"}})
+			return err
+		}, codes.InvalidArgument},
+		{"invalid transition", func() error {
+			_, err := client.TransitionRecipe(ctx, &recipepb.TransitionRecipeRequest{Id: created.Id, Status: "approved"})
+			return err
+		}, codes.FailedPrecondition},
+		{"missing reviewer role", func() error {
+			if _, err := client.TransitionRecipe(ctx, &recipepb.TransitionRecipeRequest{Id: created.Id, Status: "in_review"}); err != nil {
+				return err
+			}
+			anonymous := context.Background()
+			_, err := client.TransitionRecipe(anonymous, &recipepb.TransitionRecipeRequest{Id: created.Id, Status: "approved"})
+			return err
+		}, codes.PermissionDenied},
+		{"negative limit", func() error {
+			_, err := client.ListRecipes(ctx, &recipepb.ListRecipesRequest{Limit: -1})
+			return err
+		}, codes.InvalidArgument},
+		{"page token of another sort order", func() error {
+			token := recipe.NewCursor(&recipe.Recipe{ID: created.Id, Name: created.Name}, recipe.Sort{Field: recipe.SortByName}).Encode()
+			_, err := client.ListRecipes(ctx, &recipepb.ListRecipesRequest{Limit: 1, Sort: "-name", PageToken: token})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.call()); code != tt.code {
+				t.Errorf("expected %s, got %s", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestImportExport(t *testing.T) {
+	client, ctx := newTestClient(t)
+
+	stream, err := client.ImportRecipes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range []*recipepb.ImportRecipesRequest{
+		{Recipe: dark70()},
+		{Recipe: &recipepb.Recipe{Name: "Broken"}},
+		{},
+	} {
+		if err := stream.Send(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	report, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 3 || report.Succeeded != 1 || report.Failed != 2 || report.Results[1].Line != 2 || report.Results[1].Error == "" {
+		t.Errorf("unexpected report %v", report)
+	}
+
+	export, err := client.ExportRecipes(ctx, &recipepb.ExportRecipesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for {
+		rcp, err := export.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, rcp.Name)
+	}
+	if len(names) != 1 || names[0] != "Dark 70" {
+		t.Errorf("expected the imported recipe to be exported, got %v", names)
+	}
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	policy := actor.Policy{ProxySecret: "proxy-secret-value", RequireUser: true}
+	client, ctx := newTestClient(t, grpc.ChainUnaryInterceptor(AuthUnaryInterceptor(policy)), grpc.ChainStreamInterceptor(AuthStreamInterceptor(policy)))
+
+	_, err := client.ListRecipes(ctx, &recipepb.ListRecipesRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected a call without the proxy secret to be unauthenticated, got %v", err)
+	}
+
+	trusted := metadata.AppendToOutgoingContext(ctx, ProxySecretKey, "proxy-secret-value")
+	if _, err := client.ListRecipes(trusted, &recipepb.ListRecipesRequest{}); err != nil {
+		t.Errorf("expected a call of alice through the proxy to be accepted, got %v", err)
+	}
+
+	anonymous := metadata.AppendToOutgoingContext(context.Background(), ProxySecretKey, "proxy-secret-value")
+	if _, err := client.ListRecipes(anonymous, &recipepb.ListRecipesRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected an anonymous call to be unauthenticated, got %v", err)
+	}
+}
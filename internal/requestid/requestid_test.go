@@ -0,0 +1,20 @@
+package requestid
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	if id := FromContext(context.Background()); id != "" {
+		t.Errorf("Expected no request ID, got %q", id)
+	}
+
+	id := New()
+	if len(id) != 32 || id == New() {
+		t.Errorf("Expected a random 32 character ID, got %q", id)
+	}
+	if got := FromContext(NewContext(context.Background(), id)); got != id {
+		t.Errorf("Expected %q, got %q", id, got)
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+)
+
+// AuditService defines the contract for reading the audit log
+type AuditService interface {
+	List(ctx context.Context, filter audit.Filter, limit, offset int64) ([]*audit.Event, error)
+	Export(ctx context.Context, filter audit.Filter, fn func(*audit.Event) error) error
+}
+
+// auditService implements the AuditService interface
+type auditService struct {
+	store mongo.AuditStore
+}
+
+// NewAuditService creates a new AuditService
+func NewAuditService(store mongo.AuditStore) *auditService {
+	return &auditService{
+		store: store,
+	}
+}
+
+// List retrieves the audit events matching the filter with pagination, most recent first
+func (s *auditService) List(ctx context.Context, filter audit.Filter, limit, offset int64) ([]*audit.Event, error) {
+	return s.store.List(ctx, filter, limit, offset)
+}
+
+// Export calls fn for every audit event matching the filter in chronological order
+func (s *auditService) Export(ctx context.Context, filter audit.Filter, fn func(*audit.Event) error) error {
+	return s.store.Each(ctx, filter, fn)
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/production"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// BatchService defines the contract for production batch operations
+type BatchService interface {
+	Create(ctx context.Context, recipeID string, yield float64, beanLotIDs []string, notes string) (*production.Batch, error)
+	GetByID(ctx context.Context, id string) (*production.Batch, error)
+	List(ctx context.Context, limit, offset int64) ([]*production.Batch, error)
+}
+
+// batchService implements the BatchService interface
+type batchService struct {
+	store        mongo.BatchStore
+	recipeStore  mongo.RecipeStore
+	beanLotStore mongo.BeanLotStore
+}
+
+// NewBatchService creates a new BatchService
+func NewBatchService(store mongo.BatchStore, recipeStore mongo.RecipeStore, beanLotStore mongo.BeanLotStore) *batchService {
+	return &batchService{
+		store:        store,
+		recipeStore:  recipeStore,
+		beanLotStore: beanLotStore,
+	}
+}
+
+// Create starts a new production batch of a recipe at the given yield.
+// The recipe must be approved, and every bean lot used in the batch must exist and must not have been rejected during quality inspection.
+func (s *batchService) Create(ctx context.Context, recipeID string, yield float64, beanLotIDs []string, notes string) (*production.Batch, error) {
+	rcp, err := s.recipeStore.GetByID(ctx, recipeID)
+	if err != nil {
+		return nil, err
+	}
+	if rcp == nil {
+		return nil, recipe.ErrRecipeNotFound
+	}
+	if rcp.Status != recipe.Approved {
+		return nil, recipe.ErrRecipeNotApproved
+	}
+
+	for _, id := range beanLotIDs {
+		lot, err := s.beanLotStore.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if lot == nil {
+			return nil, quality.ErrBeanLotNotFound
+		}
+		if err := lot.UsableInProduction(); err != nil {
+			return nil, err
+		}
+	}
+
+	batch, err := production.NewBatch(rcp, yield, beanLotIDs, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Create(ctx, batch)
+}
+
+// GetByID retrieves a batch by its ID, returning production.ErrBatchNotFound if it does not exist
+func (s *batchService) GetByID(ctx context.Context, id string) (*production.Batch, error) {
+	batch, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, production.ErrBatchNotFound
+	}
+	return batch, nil
+}
+
+// List retrieves batches with pagination
+func (s *batchService) List(ctx context.Context, limit, offset int64) ([]*production.Batch, error) {
+	return s.store.List(ctx, limit, offset)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// CacaoService defines the contract for cacao catalog operations
+type CacaoService interface {
+	Create(ctx context.Context, cacao *recipe.Cacao) (*recipe.Cacao, error)
+	GetByID(ctx context.Context, id string) (*recipe.Cacao, error)
+	Update(ctx context.Context, cacao *recipe.Cacao) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, limit, offset int64) ([]*recipe.Cacao, error)
+}
+
+// cacaoService implements the CacaoService interface
+type cacaoService struct {
+	store mongo.CacaoStore
+}
+
+// NewCacaoService creates a new CacaoService
+func NewCacaoService(store mongo.CacaoStore) *cacaoService {
+	return &cacaoService{
+		store: store,
+	}
+}
+
+// Create adds a new entry to the cacao catalog
+func (s *cacaoService) Create(ctx context.Context, c *recipe.Cacao) (*recipe.Cacao, error) {
+	newCacao, err := recipe.NewCacao(c.Name, c.Origin)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Create(ctx, newCacao)
+}
+
+// GetByID retrieves a cacao catalog entry by its ID, returning recipe.ErrCacaoNotFound if it does not exist
+func (s *cacaoService) GetByID(ctx context.Context, id string) (*recipe.Cacao, error) {
+	c, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if c == nil {
+		return nil, recipe.ErrCacaoNotFound
+	}
+	return c, nil
+}
+
+// Update updates an existing cacao catalog entry
+func (s *cacaoService) Update(ctx context.Context, c *recipe.Cacao) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	c.UpdatedAt = time.Now()
+
+	return s.store.Update(ctx, c)
+}
+
+// Delete removes an entry from the cacao catalog
+func (s *cacaoService) Delete(ctx context.Context, id string) error {
+	return s.store.Delete(ctx, id)
+}
+
+// List retrieves cacao catalog entries with pagination
+func (s *cacaoService) List(ctx context.Context, limit, offset int64) ([]*recipe.Cacao, error) {
+	return s.store.List(ctx, limit, offset)
+}
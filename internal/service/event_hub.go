@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// subscriberBuffer is the number of events a subscriber can fall behind before it is dropped
+const subscriberBuffer = 64
+
+// EventHub follows the outbox and broadcasts new recipe events to its subscribers, such as Server-Sent Events streams.
+// Subscribers that fall behind are dropped rather than holding up the others; they can catch up with Replay.
+type EventHub struct {
+	outboxStore mongo.OutboxStore
+	interval    time.Duration
+	logger      *zap.Logger
+
+	mu          sync.Mutex
+	subscribers map[chan *event.Event]struct{}
+	closed      bool
+}
+
+// NewEventHub creates an EventHub that polls the outbox for new events every interval
+func NewEventHub(outboxStore mongo.OutboxStore, interval time.Duration, logger *zap.Logger) *EventHub {
+	return &EventHub{
+		outboxStore: outboxStore,
+		interval:    interval,
+		logger:      logger,
+		subscribers: make(map[chan *event.Event]struct{}),
+	}
+}
+
+// Run broadcasts the events committed to the outbox from now on, until the context is canceled.
+// The channels of all subscribers are closed when it returns.
+func (h *EventHub) Run(ctx context.Context) {
+	defer h.close()
+
+	cursor, err := h.outboxStore.LatestID(ctx)
+	for err != nil {
+		h.logger.Error("Failed to find the latest recipe event", zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.interval):
+		}
+		cursor, err = h.outboxStore.LatestID(ctx)
+	}
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		events, err := h.outboxStore.After(ctx, cursor, dispatchBatchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				h.logger.Error("Failed to read recipe events", zap.Error(err))
+			}
+			continue
+		}
+		for _, e := range events {
+			h.broadcast(e)
+			cursor = e.ID
+		}
+	}
+}
+
+// Subscribe returns a channel receiving every new event, and a function to stop receiving them.
+// The channel is closed when the subscriber falls behind or the hub stops.
+func (h *EventHub) Subscribe() (<-chan *event.Event, func()) {
+	ch := make(chan *event.Event, subscriberBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	h.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subscribers[ch]; ok {
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Replay calls fn for every event committed after the event with the given ID, oldest first
+func (h *EventHub) Replay(ctx context.Context, afterID string, fn func(*event.Event) error) error {
+	for {
+		events, err := h.outboxStore.After(ctx, afterID, dispatchBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+			afterID = e.ID
+		}
+		if len(events) < dispatchBatchSize {
+			return nil
+		}
+	}
+}
+
+func (h *EventHub) broadcast(e *event.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		select {
+		case ch <- e:
+		default:
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (h *EventHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+	h.closed = true
+}
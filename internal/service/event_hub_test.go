@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+func receive(t *testing.T, ch <-chan *event.Event) *event.Event {
+	t.Helper()
+	select {
+	case e, ok := <-ch:
+		if !ok {
+			t.Fatal("Expected an event, channel was closed")
+		}
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for an event")
+	}
+	return nil
+}
+
+func TestEventHubBroadcastsNewEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	outbox := &memoryOutbox{dispatched: map[string]bool{}}
+	outbox.Append(ctx, event.New(event.RecipeCreated, "old", "", nil))
+
+	hub := NewEventHub(outbox, time.Millisecond, zap.NewNop())
+	first, _ := hub.Subscribe()
+	second, unsubscribe := hub.Subscribe()
+	done := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	outbox.Append(ctx, event.New(event.RecipeUpdated, "r1", "", nil))
+	for _, ch := range []<-chan *event.Event{first, second} {
+		if e := receive(t, ch); e.ResourceID != "r1" || e.Type != event.RecipeUpdated {
+			t.Errorf("Expected only events appended after the hub started, got %+v", e)
+		}
+	}
+
+	unsubscribe()
+	if _, ok := <-second; ok {
+		t.Error("Expected the channel to be closed after unsubscribing")
+	}
+
+	cancel()
+	<-done
+	if _, ok := <-first; ok {
+		t.Error("Expected the channel to be closed when the hub stops")
+	}
+	if ch, _ := hub.Subscribe(); ch != nil {
+		if _, ok := <-ch; ok {
+			t.Error("Expected subscribing to a stopped hub to return a closed channel")
+		}
+	}
+}
+
+func TestEventHubDropsSlowSubscribers(t *testing.T) {
+	hub := NewEventHub(&memoryOutbox{}, time.Millisecond, zap.NewNop())
+	ch, _ := hub.Subscribe()
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		hub.broadcast(event.New(event.RecipeUpdated, "r1", "", nil))
+	}
+
+	received := 0
+	for range ch {
+		received++
+	}
+	if received != subscriberBuffer {
+		t.Errorf("Expected %d buffered events before the subscriber was dropped, got %d", subscriberBuffer, received)
+	}
+}
+
+func TestEventHubReplay(t *testing.T) {
+	ctx := context.Background()
+	outbox := &memoryOutbox{dispatched: map[string]bool{}}
+	for i := 0; i < dispatchBatchSize+5; i++ {
+		outbox.Append(ctx, event.New(event.RecipeUpdated, "r1", "", nil))
+	}
+	hub := NewEventHub(outbox, time.Millisecond, zap.NewNop())
+
+	var replayed []string
+	if err := hub.Replay(ctx, outbox.events[2].ID, func(e *event.Event) error {
+		replayed = append(replayed, e.ID)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed) != dispatchBatchSize+2 || replayed[0] != outbox.events[3].ID {
+		t.Errorf("Expected every event after the third to be replayed in order, got %d starting at %s", len(replayed), replayed[0])
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// ExperimentService defines the contract for recipe experiment operations
+type ExperimentService interface {
+	Create(ctx context.Context, exp *experiment.Experiment) (*experiment.Experiment, error)
+	GetByID(ctx context.Context, id string) (*experiment.Experiment, error)
+	Update(ctx context.Context, exp *experiment.Experiment) error
+	List(ctx context.Context, limit, offset int64) ([]*experiment.Experiment, error)
+	AddVariant(ctx context.Context, id string, variant experiment.Variant) (*experiment.Experiment, error)
+	Compare(ctx context.Context, id string) (*experiment.Comparison, error)
+}
+
+// experimentService implements the ExperimentService interface
+type experimentService struct {
+	store        mongo.ExperimentStore
+	recipeStore  mongo.RecipeStore
+	tastingStore mongo.TastingStore
+}
+
+// NewExperimentService creates a new ExperimentService
+func NewExperimentService(store mongo.ExperimentStore, recipeStore mongo.RecipeStore, tastingStore mongo.TastingStore) *experimentService {
+	return &experimentService{
+		store:        store,
+		recipeStore:  recipeStore,
+		tastingStore: tastingStore,
+	}
+}
+
+// Create creates a new experiment for an existing base recipe
+func (s *experimentService) Create(ctx context.Context, exp *experiment.Experiment) (*experiment.Experiment, error) {
+	newExperiment, err := experiment.NewExperiment(exp.Name, exp.BaseRecipeID, exp.Hypothesis)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recipeExists(ctx, exp.BaseRecipeID); err != nil {
+		return nil, err
+	}
+	for _, v := range exp.Variants {
+		if err := s.recipeExists(ctx, v.RecipeID); err != nil {
+			return nil, err
+		}
+		if err := newExperiment.AddVariant(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.store.Create(ctx, newExperiment)
+}
+
+// GetByID retrieves an experiment by its ID, returning experiment.ErrExperimentNotFound if it does not exist
+func (s *experimentService) GetByID(ctx context.Context, id string) (*experiment.Experiment, error) {
+	exp, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if exp == nil {
+		return nil, experiment.ErrExperimentNotFound
+	}
+	return exp, nil
+}
+
+// Update updates the description, hypothesis and conclusion of an experiment
+func (s *experimentService) Update(ctx context.Context, exp *experiment.Experiment) error {
+	if exp.Name == "" {
+		return experiment.ErrNameRequired
+	}
+
+	exp.UpdatedAt = time.Now()
+
+	return s.store.Update(ctx, exp)
+}
+
+// List retrieves experiments with pagination
+func (s *experimentService) List(ctx context.Context, limit, offset int64) ([]*experiment.Experiment, error) {
+	return s.store.List(ctx, limit, offset)
+}
+
+// AddVariant adds an existing recipe as a variant to an experiment
+func (s *experimentService) AddVariant(ctx context.Context, id string, variant experiment.Variant) (*experiment.Experiment, error) {
+	if variant.RecipeID == "" {
+		return nil, experiment.ErrVariantRequired
+	}
+	if err := s.recipeExists(ctx, variant.RecipeID); err != nil {
+		return nil, err
+	}
+	return s.store.AddVariant(ctx, id, variant)
+}
+
+// Compare builds a side-by-side comparison of the composition, cost, tasting scores and process parameters
+// of the base recipe and all variants of an experiment
+func (s *experimentService) Compare(ctx context.Context, id string) (*experiment.Comparison, error) {
+	exp, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recipes := make(map[string]*recipe.Recipe)
+	summaries := make(map[string]*tasting.Summary)
+	for _, recipeID := range exp.RecipeIDs() {
+		rcp, err := s.recipeStore.GetByID(ctx, recipeID)
+		if err != nil {
+			return nil, err
+		}
+		if rcp == nil {
+			// The recipe was deleted after it was added to the experiment
+			continue
+		}
+		recipes[recipeID] = rcp
+
+		sessions, err := s.tastingStore.List(ctx, "", recipeID, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		if len(sessions) > 0 {
+			summaries[recipeID] = tasting.Summarize(sessions)
+		}
+	}
+
+	return experiment.Compare(exp, recipes, summaries), nil
+}
+
+// recipeExists returns recipe.ErrRecipeNotFound if there is no recipe with the ID, or the error of looking it up
+func (s *experimentService) recipeExists(ctx context.Context, id string) error {
+	rcp, err := s.recipeStore.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rcp == nil {
+		return recipe.ErrRecipeNotFound
+	}
+	return nil
+}
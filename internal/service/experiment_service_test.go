@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/memory"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/experiment"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+func TestCreateExperimentReportsRecipeLookupErrors(t *testing.T) {
+	ctx := context.Background()
+	exp := &experiment.Experiment{Name: "Less sugar", BaseRecipeID: "65f1c0ffee0000000000abcd"}
+
+	s := NewExperimentService(memory.NewExperimentStore(), memory.NewRecipeStore(), memory.NewTastingStore())
+	if _, err := s.Create(ctx, exp); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("expected an unknown base recipe to be reported, got %v", err)
+	}
+
+	s = NewExperimentService(memory.NewExperimentStore(), failingRecipeStore{memory.NewRecipeStore()}, memory.NewTastingStore())
+	if _, err := s.Create(ctx, exp); !errors.Is(err, errUnavailable) {
+		t.Errorf("expected the database error, got %v", err)
+	}
+}
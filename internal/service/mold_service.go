@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// MoldService defines the contract for mold catalog operations
+type MoldService interface {
+	Create(ctx context.Context, mold *recipe.Mold) (*recipe.Mold, error)
+	GetByID(ctx context.Context, id string) (*recipe.Mold, error)
+	Update(ctx context.Context, mold *recipe.Mold) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, limit, offset int64) ([]*recipe.Mold, error)
+}
+
+// moldService implements the MoldService interface
+type moldService struct {
+	store mongo.MoldStore
+}
+
+// NewMoldService creates a new MoldService
+func NewMoldService(store mongo.MoldStore) *moldService {
+	return &moldService{
+		store: store,
+	}
+}
+
+// Create adds a new mold to the catalog
+func (s *moldService) Create(ctx context.Context, m *recipe.Mold) (*recipe.Mold, error) {
+	newMold, err := recipe.NewMold(m.Name, m.Type, m.Cavities, m.CavityWeight, m.ShellWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Create(ctx, newMold)
+}
+
+// GetByID retrieves a mold by its ID, returning recipe.ErrMoldNotFound if it does not exist
+func (s *moldService) GetByID(ctx context.Context, id string) (*recipe.Mold, error) {
+	m, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, recipe.ErrMoldNotFound
+	}
+	return m, nil
+}
+
+// Update updates an existing mold
+func (s *moldService) Update(ctx context.Context, m *recipe.Mold) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	m.UpdatedAt = time.Now()
+
+	return s.store.Update(ctx, m)
+}
+
+// Delete removes a mold from the catalog
+func (s *moldService) Delete(ctx context.Context, id string) error {
+	return s.store.Delete(ctx, id)
+}
+
+// List retrieves molds with pagination
+func (s *moldService) List(ctx context.Context, limit, offset int64) ([]*recipe.Mold, error) {
+	return s.store.List(ctx, limit, offset)
+}
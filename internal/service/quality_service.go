@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/quality"
+)
+
+// QualityService defines the contract for bean lot and quality inspection operations
+type QualityService interface {
+	CreateLot(ctx context.Context, lot *quality.BeanLot) (*quality.BeanLot, error)
+	GetLotByID(ctx context.Context, id string) (*quality.BeanLot, error)
+	ListLots(ctx context.Context, limit, offset int64) ([]*quality.BeanLot, error)
+	Inspect(ctx context.Context, lotID string, cutTest quality.CutTest, moisture float64, notes string) (*quality.BeanLot, error)
+}
+
+// qualityService implements the QualityService interface
+type qualityService struct {
+	store      mongo.BeanLotStore
+	thresholds quality.Thresholds
+}
+
+// NewQualityService creates a new QualityService grading inspections with the given thresholds
+func NewQualityService(store mongo.BeanLotStore, thresholds quality.Thresholds) (*qualityService, error) {
+	if err := thresholds.Validate(); err != nil {
+		return nil, err
+	}
+	return &qualityService{
+		store:      store,
+		thresholds: thresholds,
+	}, nil
+}
+
+// CreateLot registers a newly received bean lot
+func (s *qualityService) CreateLot(ctx context.Context, lot *quality.BeanLot) (*quality.BeanLot, error) {
+	newLot, err := quality.NewBeanLot(lot.LotNumber, lot.CacaoID, lot.Weight, lot.ReceivedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Create(ctx, newLot)
+}
+
+// GetLotByID retrieves a bean lot by its ID, returning quality.ErrBeanLotNotFound if it does not exist
+func (s *qualityService) GetLotByID(ctx context.Context, id string) (*quality.BeanLot, error) {
+	lot, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if lot == nil {
+		return nil, quality.ErrBeanLotNotFound
+	}
+	return lot, nil
+}
+
+// ListLots retrieves bean lots with pagination
+func (s *qualityService) ListLots(ctx context.Context, limit, offset int64) ([]*quality.BeanLot, error) {
+	return s.store.List(ctx, limit, offset)
+}
+
+// Inspect records the results of a quality inspection by the current user on a bean lot and grades the lot
+func (s *qualityService) Inspect(ctx context.Context, lotID string, cutTest quality.CutTest, moisture float64, notes string) (*quality.BeanLot, error) {
+	inspection, err := quality.NewInspection(cutTest, moisture, notes, actor.FromContext(ctx).ID, s.thresholds)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.AddInspection(ctx, lotID, *inspection)
+}
@@ -2,9 +2,18 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"time"
 
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
 	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/internal/requestid"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/audit"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
 	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
 )
 
@@ -13,26 +22,60 @@ type RecipeService interface {
 	Create(ctx context.Context, recipe *recipe.Recipe) (*recipe.Recipe, error)
 	GetByID(ctx context.Context, id string) (*recipe.Recipe, error)
 	GetTemplateByID(ctx context.Context, id string) (*recipe.TemplateRecipe, error)
-	Update(ctx context.Context, recipe *recipe.Recipe) error
+	Update(ctx context.Context, recipe *recipe.Recipe) (*recipe.Recipe, error)
+	Transition(ctx context.Context, id string, status recipe.Status) (*recipe.Recipe, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error)
+	Restore(ctx context.Context, id string) (*recipe.Recipe, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	List(ctx context.Context, opts recipe.ListOptions) (*recipe.Page, error)
+	Trash(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error)
 	Count(ctx context.Context) (int64, error)
+	PurchaseList(ctx context.Context, plan *recipe.ProductionPlan) (*recipe.PurchaseList, error)
+	Import(ctx context.Context, r bulk.Reader, dryRun bool) (*bulk.Report, error)
+	Export(ctx context.Context, filter recipe.Filter, w bulk.Writer) error
 }
 
 // recipeService implements the RecipeService interface
 type recipeService struct {
-	store mongo.RecipeStore
+	store       mongo.RecipeStore
+	cacaoStore  mongo.CacaoStore
+	auditStore  mongo.AuditStore
+	outboxStore mongo.OutboxStore
+	tx          mongo.Transactor
 }
 
-// NewRecipeService creates a new RecipeService
-func NewRecipeService(store mongo.RecipeStore) *recipeService {
+// NewRecipeService creates a new RecipeService.
+// Every change made through the service is recorded in the audit store and published as a domain event
+// through the outbox, in the same transaction as the change itself.
+func NewRecipeService(store mongo.RecipeStore, cacaoStore mongo.CacaoStore, auditStore mongo.AuditStore, outboxStore mongo.OutboxStore, tx mongo.Transactor) *recipeService {
 	return &recipeService{
-		store: store,
+		store:       store,
+		cacaoStore:  cacaoStore,
+		auditStore:  auditStore,
+		outboxStore: outboxStore,
+		tx:          tx,
 	}
 }
 
 // Create creates a new recipe
 func (s *recipeService) Create(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
+	newRecipe, err := s.prepare(ctx, rcp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.commit(ctx, audit.Create, event.RecipeCreated, nil, newRecipe, func(ctx context.Context) error {
+		_, err := s.store.Create(ctx, newRecipe)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newRecipe, nil
+}
+
+// prepare validates a recipe to create and returns the new draft recipe, without saving it
+func (s *recipeService) prepare(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
 	if rcp.Name == "" {
 		return nil, recipe.ErrNameRequired
 	}
@@ -43,15 +86,41 @@ func (s *recipeService) Create(ctx context.Context, rcp *recipe.Recipe) (*recipe
 		return nil, recipe.ErrInstructionsRequired
 	}
 
-	rcp.CreatedAt = time.Now()
-	rcp.UpdatedAt = time.Now()
+	if err := s.resolveOrigins(ctx, rcp.Ingredients); err != nil {
+		return nil, err
+	}
 
 	newRecipe, err := recipe.NewRecipe(rcp.Name, rcp.Description, rcp.Ingredients, rcp.Instructions)
 	if err != nil {
 		return nil, err
 	}
+	user := actor.FromContext(ctx).ID
+	newRecipe.CreatedAt = time.Now()
+	newRecipe.UpdatedAt = newRecipe.CreatedAt
+	newRecipe.CreatedBy = user
+	newRecipe.UpdatedBy = user
+	if err := newRecipe.SetLosses(rcp.Losses); err != nil {
+		return nil, err
+	}
+	newRecipe.Classification = rcp.Classification
+	newRecipe.Tempering = rcp.Tempering
+	if err := newRecipe.ValidateTempering(); err != nil {
+		return nil, err
+	}
+	if rcp.Process != nil {
+		if err := rcp.Process.Validate(); err != nil {
+			return nil, err
+		}
+		newRecipe.Process = rcp.Process
+	}
+	if rcp.Nutrition != nil {
+		if err := rcp.Nutrition.Validate(); err != nil {
+			return nil, err
+		}
+		newRecipe.Nutrition = rcp.Nutrition
+	}
 
-	return s.store.Create(ctx, newRecipe)
+	return newRecipe, nil
 }
 
 // GetByID retrieves a recipe by its ID
@@ -65,40 +134,417 @@ func (s *recipeService) GetTemplateByID(ctx context.Context, id string) (*recipe
 	if err != nil {
 		return nil, err
 	}
+	if rcp == nil {
+		return nil, recipe.ErrRecipeNotFound
+	}
 
 	template := rcp.ToTemplate()
 
 	return template, nil
 }
 
-// Update updates an existing recipe
-func (s *recipeService) Update(ctx context.Context, rcp *recipe.Recipe) error {
+// Update updates an existing recipe.
+// Draft recipes are updated in place and recipes in review are sent back to draft.
+// Approved recipes are locked, so the changes are saved as a new draft revision which is returned instead.
+func (s *recipeService) Update(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
 	if rcp.Name == "" {
-		return recipe.ErrNameRequired
+		return nil, recipe.ErrNameRequired
 	}
 	if len(rcp.Ingredients) == 0 {
-		return recipe.ErrIngredientsRequired
+		return nil, recipe.ErrIngredientsRequired
 	}
 	if rcp.Instructions == "" {
-		return recipe.ErrInstructionsRequired
+		return nil, recipe.ErrInstructionsRequired
+	}
+
+	existing, err := s.store.GetByID(ctx, rcp.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, recipe.ErrRecipeNotFound
+	}
+	if existing.Status == recipe.Archived {
+		return nil, recipe.ErrRecipeArchived
+	}
+
+	if err := rcp.ValidateQuantities(); err != nil {
+		return nil, err
+	}
+	if err := s.resolveOrigins(ctx, rcp.Ingredients); err != nil {
+		return nil, err
+	}
+	if err := rcp.SetLosses(rcp.Losses); err != nil {
+		return nil, err
+	}
+	if err := rcp.ValidateTempering(); err != nil {
+		return nil, err
+	}
+	if rcp.Process != nil {
+		if err := rcp.Process.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if rcp.Nutrition != nil {
+		if err := rcp.Nutrition.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	rcp.CacaoPercentage = rcp.CalculateCacaoPercentage()
+
+	user := actor.FromContext(ctx).ID
+	target := existing
+	if existing.Locked() {
+		// Changes go to the open revision of the recipe if it has one, so editing it twice does not start two revisions
+		if target, err = s.openRevision(ctx, existing.ID); err != nil {
+			return nil, err
+		}
+		if target == nil {
+			target = existing.NewRevision()
+			target.CreatedBy = user
+		}
+	}
+	// A new revision starts from the approved recipe, which is what the change is recorded against
+	previous := target
+	if target.ID == "" {
+		previous = existing
+	}
+	before, err := audit.Snapshot(previous)
+	if err != nil {
+		return nil, err
 	}
 
-	rcp.UpdatedAt = time.Now()
+	target.Name = rcp.Name
+	target.Description = rcp.Description
+	target.Ingredients = rcp.Ingredients
+	target.Instructions = rcp.Instructions
+	target.CacaoPercentage = rcp.CacaoPercentage
+	target.Yield = rcp.Yield
+	target.Input = rcp.Input
+	target.Losses = rcp.Losses
+	target.Classification = rcp.Classification
+	target.Tempering = rcp.Tempering
+	target.Process = rcp.Process
+	target.Nutrition = rcp.Nutrition
+	target.Status = recipe.Draft
+	target.UpdatedAt = time.Now()
+	target.UpdatedBy = user
 
-	return s.store.Update(ctx, rcp)
+	// A new revision is a new recipe as far as other systems are concerned.
+	// Decided once here, as the transaction may run the save again after the first attempt assigned an ID.
+	isRevision := target.ID == ""
+	eventType := event.RecipeUpdated
+	if isRevision {
+		eventType = event.RecipeCreated
+	}
+	err = s.commit(ctx, audit.Update, eventType, before, target, func(ctx context.Context) error {
+		if isRevision {
+			_, err := s.store.Create(ctx, target)
+			return err
+		}
+		return s.store.Update(ctx, target)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// openRevision returns the revision of the recipe that is still being worked on, or nil if it has none
+func (s *recipeService) openRevision(ctx context.Context, id string) (*recipe.Recipe, error) {
+	var open *recipe.Recipe
+	err := s.store.Each(ctx, recipe.Filter{RevisionOf: id}, func(r *recipe.Recipe) error {
+		if open == nil && !r.Locked() {
+			open = r
+		}
+		return nil
+	})
+	return open, err
 }
 
-// Delete removes a recipe by its ID
+// Transition moves a recipe to another lifecycle status.
+// Approving a recipe in review, or rejecting it back to draft, requires the reviewer role.
+// Approving a revision archives the approved recipe it was derived from, so only one revision is approved at a time.
+func (s *recipeService) Transition(ctx context.Context, id string, status recipe.Status) (*recipe.Recipe, error) {
+	rcp, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rcp == nil {
+		return nil, recipe.ErrRecipeNotFound
+	}
+
+	before, err := audit.Snapshot(rcp)
+	if err != nil {
+		return nil, err
+	}
+
+	user := actor.FromContext(ctx)
+	if recipe.RequiresReviewer(rcp.Status, status) && !user.HasRole(actor.Reviewer) {
+		return nil, recipe.ErrReviewerRequired
+	}
+	if err := rcp.Transition(status); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if status == recipe.Approved {
+		rcp.ApprovedBy = user.ID
+		rcp.ApprovedAt = &now
+	}
+	rcp.UpdatedAt = now
+	rcp.UpdatedBy = user.ID
+
+	var (
+		superseded       *recipe.Recipe
+		supersededBefore json.RawMessage
+	)
+	if status == recipe.Approved && rcp.RevisionOf != "" {
+		if superseded, err = s.store.GetByID(ctx, rcp.RevisionOf); err != nil {
+			return nil, err
+		}
+		if superseded != nil && superseded.Status == recipe.Approved {
+			if supersededBefore, err = audit.Snapshot(superseded); err != nil {
+				return nil, err
+			}
+			if err := superseded.Transition(recipe.Archived); err != nil {
+				return nil, err
+			}
+			superseded.UpdatedAt = now
+			superseded.UpdatedBy = user.ID
+		} else {
+			superseded = nil
+		}
+	}
+
+	action := audit.StatusChange
+	if status == recipe.Approved {
+		action = audit.Approve
+	}
+	err = s.commit(ctx, action, event.RecipeUpdated, before, rcp, func(ctx context.Context) error {
+		if err := s.store.Update(ctx, rcp); err != nil {
+			return err
+		}
+		if superseded == nil {
+			return nil
+		}
+		if err := s.store.Update(ctx, superseded); err != nil {
+			return err
+		}
+		return s.publish(ctx, audit.StatusChange, event.RecipeUpdated, superseded.ID, supersededBefore, superseded)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rcp, nil
+}
+
+// Delete moves a recipe to the trash, from where it can be restored until it is purged
 func (s *recipeService) Delete(ctx context.Context, id string) error {
-	return s.store.Delete(ctx, id)
+	rcp, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rcp == nil {
+		return recipe.ErrRecipeNotFound
+	}
+	before, err := audit.Snapshot(rcp)
+	if err != nil {
+		return err
+	}
+
+	return s.commit(ctx, audit.Delete, event.RecipeDeleted, before, rcp, func(ctx context.Context) error {
+		return s.store.Delete(ctx, id, actor.FromContext(ctx).ID)
+	})
+}
+
+// Restore takes a recipe out of the trash and returns it
+func (s *recipeService) Restore(ctx context.Context, id string) (*recipe.Recipe, error) {
+	var rcp *recipe.Recipe
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.store.Restore(ctx, id); err != nil {
+			return err
+		}
+		var err error
+		if rcp, err = s.store.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return s.publish(ctx, audit.Restore, event.RecipeRestored, id, nil, rcp)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rcp, nil
 }
 
-// List retrieves recipes with pagination
-func (s *recipeService) List(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error) {
-	return s.store.List(ctx, limit, offset)
+// Purge permanently removes the recipes that have been in the trash for longer than the retention period
+func (s *recipeService) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.store.Purge(ctx, time.Now().Add(-retention))
+}
+
+// List retrieves a page of the recipes matching the filter in the sort order, after the cursor of the previous page.
+// The page holds between 1 and recipe.MaxPageSize recipes.
+func (s *recipeService) List(ctx context.Context, opts recipe.ListOptions) (*recipe.Page, error) {
+	if opts.Limit < 1 || opts.Limit > recipe.MaxPageSize {
+		return nil, recipe.ErrInvalidLimit
+	}
+	if err := opts.Filter.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.Sort.Field == "" {
+		opts.Sort = recipe.DefaultSort
+	}
+	if opts.After != nil && opts.After.Sort != opts.Sort {
+		return nil, recipe.ErrInvalidCursor
+	}
+	return s.store.List(ctx, opts)
+}
+
+// Trash retrieves the recipes in the trash with pagination, most recently deleted first
+func (s *recipeService) Trash(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error) {
+	return s.store.ListDeleted(ctx, limit, offset)
 }
 
 // Count returns the total number of recipes
 func (s *recipeService) Count(ctx context.Context) (int64, error) {
 	return s.store.Count(ctx)
 }
+
+// PurchaseList scales every recipe in the production plan to its target yield, merges the ingredients and subtracts the on-hand inventory
+func (s *recipeService) PurchaseList(ctx context.Context, plan *recipe.ProductionPlan) (*recipe.PurchaseList, error) {
+	scaled := make([]*recipe.Recipe, 0, len(plan.Items))
+	for _, item := range plan.Items {
+		if item.Yield <= 0 {
+			return nil, recipe.ErrInvalidYield
+		}
+		rcp, err := s.store.GetByID(ctx, item.RecipeID)
+		if err != nil {
+			return nil, err
+		}
+		if rcp == nil {
+			return nil, recipe.ErrRecipeNotFound
+		}
+		scaled = append(scaled, rcp.ToTemplate().ToRecipe(item.Yield))
+	}
+
+	required, err := recipe.MergeIngredients(scaled...)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipe.NewPurchaseList(required, plan.Inventory)
+}
+
+// Import creates a recipe for every record read, validating each one like Create.
+// Records that fail are reported without stopping the import. With dryRun, records are only validated.
+// It returns an error only when reading fails, together with the report of the records imported until then.
+func (s *recipeService) Import(ctx context.Context, r bulk.Reader, dryRun bool) (*bulk.Report, error) {
+	report := &bulk.Report{DryRun: dryRun}
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		if rec.Err != nil {
+			report.Add(rec, "", rec.Err)
+			continue
+		}
+		if dryRun {
+			_, err := s.prepare(ctx, rec.Recipe)
+			report.Add(rec, "", err)
+			continue
+		}
+		created, err := s.Create(ctx, rec.Recipe)
+		if err != nil {
+			report.Add(rec, "", err)
+			continue
+		}
+		report.Add(rec, created.ID, nil)
+	}
+}
+
+// Export writes every recipe matching the filter, streaming them from the store
+func (s *recipeService) Export(ctx context.Context, filter recipe.Filter, w bulk.Writer) error {
+	if err := s.store.Each(ctx, filter, w.Write); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// resolveOrigins copies the origin of the referenced cacao catalog entry onto every ingredient with a CacaoID
+func (s *recipeService) resolveOrigins(ctx context.Context, ingredients []recipe.Ingredient) error {
+	for i := range ingredients {
+		ing := &ingredients[i]
+		if ing.CacaoID == "" {
+			if ing.Origin != nil {
+				if err := ing.Origin.Validate(); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		c, err := s.cacaoStore.GetByID(ctx, ing.CacaoID)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return recipe.ErrCacaoNotFound
+		}
+		origin := c.Origin
+		ing.Origin = &origin
+		ing.IsCacao = true
+		if ing.Name == "" {
+			ing.Name = c.Name
+		}
+	}
+	return nil
+}
+
+// commit saves a change to a recipe with save, and records it in the audit log and the outbox.
+// The three writes are made in a single transaction, so a change is never saved without its audit and domain events.
+// The recipe is the state after the change, except for deletions where it is the deleted recipe.
+func (s *recipeService) commit(ctx context.Context, action audit.Action, eventType event.Type, before json.RawMessage, rcp *recipe.Recipe, save func(ctx context.Context) error) error {
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := save(ctx); err != nil {
+			return err
+		}
+		after := rcp
+		if action == audit.Delete {
+			after = nil
+		}
+		return s.publish(ctx, action, eventType, rcp.ID, before, after)
+	})
+}
+
+// publish appends an audit event for a change made by the actor of the request, and a domain event to the outbox.
+// The domain event carries the recipe after the change, or the deleted recipe for deletions.
+func (s *recipeService) publish(ctx context.Context, action audit.Action, eventType event.Type, id string, before json.RawMessage, after *recipe.Recipe) error {
+	var snapshot json.RawMessage
+	if after != nil {
+		var err error
+		if snapshot, err = audit.Snapshot(after); err != nil {
+			return err
+		}
+	}
+	reqID := requestid.FromContext(ctx)
+
+	if err := s.auditStore.Append(ctx, audit.NewEvent(action, audit.RecipeResource, id, actor.FromContext(ctx).ID, reqID, before, snapshot)); err != nil {
+		return fmt.Errorf("recording audit event: %w", err)
+	}
+
+	data := snapshot
+	if after == nil {
+		data = before
+	}
+	if err := s.outboxStore.Append(ctx, event.New(eventType, id, reqID, data)); err != nil {
+		return fmt.Errorf("publishing %s event: %w", eventType, err)
+	}
+	return nil
+}
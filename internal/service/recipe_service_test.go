@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mongodriver "go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/memory"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// errTransient is a transient transaction error, after which retryingTx runs the function again
+var errTransient = errors.New("write conflict")
+
+// retryingTx runs the function again after a transient error, like MongoDB does with session.WithTransaction
+type retryingTx struct{}
+
+func (retryingTx) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); !errors.Is(err, errTransient) {
+		return err
+	}
+	return fn(ctx)
+}
+
+// conflictingRecipeStore fails the first Create with a transient error after assigning the ID, as an aborted insert does
+type conflictingRecipeStore struct {
+	*memory.RecipeStore
+	conflicted bool
+}
+
+func (s *conflictingRecipeStore) Create(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
+	if !s.conflicted {
+		s.conflicted = true
+		rcp.ID = "65f1c0ffee0000000000abcd"
+		return nil, errTransient
+	}
+	return s.RecipeStore.Create(ctx, rcp)
+}
+
+func newTestRecipeService(store *memory.RecipeStore) *recipeService {
+	return NewRecipeService(store, memory.NewCacaoStore(), memory.NewAuditStore(), memory.NewOutboxStore(), memory.NewTransactor())
+}
+
+func dark70() *recipe.Recipe {
+	return &recipe.Recipe{
+		Name: "Dark 70",
+		Ingredients: []recipe.Ingredient{
+			{Name: "Cacao nibs", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}},
+			{Name: "Cane sugar", Quantity: recipe.Quantity{Amount: 300, Unit: recipe.Gram}},
+		},
+		Instructions: "Roast the beans.\nConche for 24 hours.",
+	}
+}
+
+// approved creates a recipe and takes it through review to approval
+func approved(t *testing.T, ctx context.Context, s *recipeService) *recipe.Recipe {
+	t.Helper()
+	rcp, err := s.Create(ctx, dark70())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range []recipe.Status{recipe.InReview, recipe.Approved} {
+		if rcp, err = s.Transition(ctx, rcp.ID, status); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return rcp
+}
+
+func TestUpdateRevisionRetried(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice", Roles: []actor.Role{actor.Reviewer}})
+	store := memory.NewRecipeStore()
+	s := newTestRecipeService(store)
+	original := approved(t, ctx, s)
+
+	// Save the revision through a transaction that conflicts once and is retried
+	s.store = &conflictingRecipeStore{RecipeStore: store}
+	s.tx = retryingTx{}
+	changed := dark70()
+	changed.ID = original.ID
+	changed.Name = "Dark 72"
+	revision, err := s.Update(ctx, changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := store.GetByID(ctx, revision.ID)
+	if err != nil || saved == nil || saved.Name != "Dark 72" || saved.RevisionOf != original.ID {
+		t.Errorf("expected the retried revision to be saved, got %+v, %v", saved, err)
+	}
+}
+
+func TestUpdateApprovedRecipeTwice(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice", Roles: []actor.Role{actor.Reviewer}})
+	s := newTestRecipeService(memory.NewRecipeStore())
+	original := approved(t, ctx, s)
+
+	var revisions []*recipe.Recipe
+	for _, name := range []string{"Dark 72", "Dark 74"} {
+		changed := dark70()
+		changed.ID = original.ID
+		changed.Name = name
+		revision, err := s.Update(ctx, changed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if revisions[0].ID != revisions[1].ID || revisions[1].Revision != 2 || revisions[1].Name != "Dark 74" {
+		t.Errorf("expected the second edit to change the open revision, got %+v and %+v", revisions[0], revisions[1])
+	}
+	open, err := s.openRevision(ctx, original.ID)
+	if err != nil || open == nil || open.ID != revisions[0].ID {
+		t.Errorf("expected a single open revision, got %+v, %v", open, err)
+	}
+}
+
+func TestApproveRevisionArchivesPredecessor(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice", Roles: []actor.Role{actor.Reviewer}})
+	store := memory.NewRecipeStore()
+	s := newTestRecipeService(store)
+	original := approved(t, ctx, s)
+
+	changed := dark70()
+	changed.ID = original.ID
+	changed.Name = "Dark 72"
+	revision, err := s.Update(ctx, changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range []recipe.Status{recipe.InReview, recipe.Approved} {
+		if _, err := s.Transition(ctx, revision.ID, status); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	predecessor, _ := store.GetByID(ctx, original.ID)
+	if predecessor.Status != recipe.Archived {
+		t.Errorf("expected the approved predecessor to be archived, got %s", predecessor.Status)
+	}
+	events, _ := s.outboxStore.After(ctx, "", 100)
+	if archived := events[len(events)-2]; archived.ResourceID != original.ID || archived.Type != event.RecipeUpdated {
+		t.Errorf("expected an event for the archived predecessor, got %+v", archived)
+	}
+}
+
+// errUnavailable is the error of a database that can not be reached
+var errUnavailable = errors.New("server selection timeout")
+
+// failingCacaoStore fails every lookup, as an unreachable database does
+type failingCacaoStore struct {
+	*memory.CacaoStore
+}
+
+func (failingCacaoStore) GetByID(ctx context.Context, id string) (*recipe.Cacao, error) {
+	return nil, errUnavailable
+}
+
+func TestCreateReportsCacaoLookupErrors(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice"})
+	s := newTestRecipeService(memory.NewRecipeStore())
+
+	rcp := dark70()
+	rcp.Ingredients[0].CacaoID = "65f1c0ffee0000000000c0c0"
+	if _, err := s.Create(ctx, rcp); !errors.Is(err, recipe.ErrCacaoNotFound) {
+		t.Errorf("expected an unknown cacao to be reported, got %v", err)
+	}
+
+	s.cacaoStore = failingCacaoStore{memory.NewCacaoStore()}
+	rcp = dark70()
+	rcp.Ingredients[0].CacaoID = "65f1c0ffee0000000000c0c0"
+	if _, err := s.Create(ctx, rcp); !errors.Is(err, errUnavailable) {
+		t.Errorf("expected the database error, got %v", err)
+	}
+}
+
+// failingRecipeStore fails every lookup, as an unreachable database does
+type failingRecipeStore struct {
+	*memory.RecipeStore
+}
+
+func (failingRecipeStore) GetByID(ctx context.Context, id string) (*recipe.Recipe, error) {
+	return nil, errUnavailable
+}
+
+func TestDeleteReportsLookupErrors(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice"})
+	s := newTestRecipeService(memory.NewRecipeStore())
+	if err := s.Delete(ctx, "65f1c0ffee0000000000abcd"); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("expected an unknown recipe to be reported, got %v", err)
+	}
+
+	s.store = failingRecipeStore{memory.NewRecipeStore()}
+	if err := s.Delete(ctx, "65f1c0ffee0000000000abcd"); !errors.Is(err, errUnavailable) {
+		t.Errorf("expected the database error, got %v", err)
+	}
+}
+
+// unreachableDB returns a database on a server that does not exist.
+// The MongoDB stores check IDs that are not ObjectIDs before querying the server.
+func unreachableDB(t *testing.T) *mongodriver.Database {
+	t.Helper()
+	client, err := mongodriver.Connect(context.Background(), options.Client().ApplyURI("mongodb://127.0.0.1:1").SetServerSelectionTimeout(100*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Disconnect(context.Background()) })
+	return client.Database("recipe_test")
+}
+
+func TestDeleteInvalidID(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice"})
+	s := newTestRecipeService(memory.NewRecipeStore())
+	s.store = mongo.NewMongoDBRecipeStore(unreachableDB(t))
+	if err := s.Delete(ctx, "abc"); !errors.Is(err, recipe.ErrRecipeNotFound) {
+		t.Errorf("expected an invalid ID to be not found, got %v", err)
+	}
+}
+
+func TestCreateInvalidCacaoID(t *testing.T) {
+	ctx := actor.NewContext(context.Background(), actor.Actor{ID: "alice"})
+	s := newTestRecipeService(memory.NewRecipeStore())
+	s.cacaoStore = mongo.NewMongoDBCacaoStore(unreachableDB(t))
+	rcp := dark70()
+	rcp.Ingredients[0].CacaoID = "abc"
+	if _, err := s.Create(ctx, rcp); !errors.Is(err, recipe.ErrCacaoNotFound) {
+		t.Errorf("expected an invalid cacao ID to be not found, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/production"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// TastingService defines the contract for tasting panel operations
+type TastingService interface {
+	CreateSession(ctx context.Context, session *tasting.Session) (*tasting.Session, error)
+	GetSession(ctx context.Context, id string) (*tasting.Session, error)
+	AddScore(ctx context.Context, sessionID string, score tasting.Score) (*tasting.Session, error)
+	ListSessions(ctx context.Context, batchID, recipeID string, limit, offset int64) ([]*tasting.Session, error)
+	Summary(ctx context.Context, batchID, recipeID string) (*tasting.Summary, error)
+}
+
+// tastingService implements the TastingService interface
+type tastingService struct {
+	store      mongo.TastingStore
+	batchStore mongo.BatchStore
+}
+
+// NewTastingService creates a new TastingService
+func NewTastingService(store mongo.TastingStore, batchStore mongo.BatchStore) *tastingService {
+	return &tastingService{
+		store:      store,
+		batchStore: batchStore,
+	}
+}
+
+// CreateSession creates a new tasting session. When a batch is tasted the recipe is taken from the batch.
+func (s *tastingService) CreateSession(ctx context.Context, session *tasting.Session) (*tasting.Session, error) {
+	recipeID := session.RecipeID
+	if session.BatchID != "" {
+		batch, err := s.batchStore.GetByID(ctx, session.BatchID)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return nil, production.ErrBatchNotFound
+		}
+		recipeID = batch.RecipeID
+	}
+
+	newSession, err := tasting.NewSession(session.BatchID, recipeID, session.HeldAt, session.Panelists)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Create(ctx, newSession)
+}
+
+// GetSession retrieves a tasting session by its ID, returning tasting.ErrSessionNotFound if it does not exist
+func (s *tastingService) GetSession(ctx context.Context, id string) (*tasting.Session, error) {
+	session, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, tasting.ErrSessionNotFound
+	}
+	return session, nil
+}
+
+// AddScore records the score of a panelist in a tasting session
+func (s *tastingService) AddScore(ctx context.Context, sessionID string, score tasting.Score) (*tasting.Session, error) {
+	if err := score.Validate(); err != nil {
+		return nil, err
+	}
+	return s.store.AddScore(ctx, sessionID, score)
+}
+
+// ListSessions retrieves tasting sessions for a batch and/or recipe with pagination
+func (s *tastingService) ListSessions(ctx context.Context, batchID, recipeID string, limit, offset int64) ([]*tasting.Session, error) {
+	return s.store.List(ctx, batchID, recipeID, limit, offset)
+}
+
+// Summary aggregates the scores of all tasting sessions of a batch and/or recipe
+func (s *tastingService) Summary(ctx context.Context, batchID, recipeID string) (*tasting.Summary, error) {
+	if batchID == "" && recipeID == "" {
+		return nil, tasting.ErrSubjectRequired
+	}
+	sessions, err := s.store.List(ctx, batchID, recipeID, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return tasting.Summarize(sessions), nil
+}
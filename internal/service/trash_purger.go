@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultTrashRetention is how long deleted recipes are kept in the trash before they are purged
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// TrashPurger periodically purges the recipes that have been in the trash for longer than the retention period
+type TrashPurger struct {
+	recipes   RecipeService
+	retention time.Duration
+	interval  time.Duration
+	logger    *zap.Logger
+}
+
+// NewTrashPurger creates a TrashPurger that checks the trash every interval
+func NewTrashPurger(recipes RecipeService, retention, interval time.Duration, logger *zap.Logger) *TrashPurger {
+	return &TrashPurger{
+		recipes:   recipes,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+	}
+}
+
+// Run purges the trash once immediately and then every interval, until the context is canceled
+func (p *TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrashPurger) purge(ctx context.Context) {
+	purged, err := p.recipes.Purge(ctx, p.retention)
+	if err != nil {
+		p.logger.Error("Failed to purge recipe trash", zap.Error(err))
+		return
+	}
+	if purged > 0 {
+		p.logger.Info("Purged recipes from the trash", zap.Int64("count", purged), zap.Duration("retention", p.retention))
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// dispatchBatchSize is the number of events and deliveries handled per poll
+const dispatchBatchSize = 100
+
+// WebhookDispatcher delivers the domain events in the outbox to the webhooks subscribed to them.
+// Each event is turned into a delivery per webhook, and failed deliveries are retried with backoff.
+// Deliveries are at least once: receivers should discard events whose ID they have already seen.
+type WebhookDispatcher struct {
+	outboxStore   mongo.OutboxStore
+	webhookStore  mongo.WebhookStore
+	deliveryStore mongo.DeliveryStore
+	client        *http.Client
+	policy        event.RetryPolicy
+	interval      time.Duration
+	logger        *zap.Logger
+}
+
+// NewWebhookDispatcher creates a WebhookDispatcher that polls the outbox and the due deliveries every interval
+func NewWebhookDispatcher(outboxStore mongo.OutboxStore, webhookStore mongo.WebhookStore, deliveryStore mongo.DeliveryStore,
+	client *http.Client, policy event.RetryPolicy, interval time.Duration, logger *zap.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		outboxStore:   outboxStore,
+		webhookStore:  webhookStore,
+		deliveryStore: deliveryStore,
+		client:        client,
+		policy:        policy,
+		interval:      interval,
+		logger:        logger,
+	}
+}
+
+// Run dispatches events every interval until the context is canceled
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		if err := d.Dispatch(ctx); err != nil && ctx.Err() == nil {
+			d.logger.Error("Failed to dispatch webhook deliveries", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch schedules deliveries for the events in the outbox, and attempts the deliveries that are due
+func (d *WebhookDispatcher) Dispatch(ctx context.Context) error {
+	if err := d.schedule(ctx); err != nil {
+		return err
+	}
+
+	due, err := d.deliveryStore.Due(ctx, time.Now(), dispatchBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, delivery := range due {
+		if err := d.attempt(ctx, delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// schedule creates a delivery for every webhook subscribed to each undispatched event
+func (d *WebhookDispatcher) schedule(ctx context.Context) error {
+	events, err := d.outboxStore.Undispatched(ctx, dispatchBatchSize)
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	webhooks, err := d.webhookStore.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		for _, w := range webhooks {
+			if !w.Subscribed(e.Type) {
+				continue
+			}
+			if _, err := d.deliveryStore.Create(ctx, event.NewDelivery(w.ID, e)); err != nil {
+				return err
+			}
+		}
+		if err := d.outboxStore.MarkDispatched(ctx, e.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attempt sends a delivery to its webhook and logs the attempt
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery *event.Delivery) error {
+	w, err := d.webhookStore.GetByID(ctx, delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	a := event.Attempt{At: start}
+	switch {
+	case w == nil:
+		a.Error = "webhook was removed"
+		delivery.Record(a, false, event.RetryPolicy{MaxAttempts: 1})
+		return d.deliveryStore.Update(ctx, delivery)
+	case !w.Active:
+		a.Error = "webhook is inactive"
+		delivery.Record(a, false, event.RetryPolicy{MaxAttempts: 1})
+		return d.deliveryStore.Update(ctx, delivery)
+	}
+
+	a.StatusCode, err = d.send(ctx, w, delivery, start)
+	a.Duration = time.Since(start)
+	if err != nil {
+		a.Error = err.Error()
+	}
+	succeeded := err == nil && a.StatusCode >= 200 && a.StatusCode < 300
+	delivery.Record(a, succeeded, d.policy)
+
+	if delivery.Status == event.Failed {
+		d.logger.Warn("Giving up on webhook delivery",
+			zap.String("webhook", w.ID), zap.String("event", delivery.Event.ID), zap.Int("attempts", len(delivery.Attempts)))
+	}
+	return d.deliveryStore.Update(ctx, delivery)
+}
+
+// send posts the event of a delivery to the webhook, signed with its secret, and returns the response status
+func (d *WebhookDispatcher) send(ctx context.Context, w *event.Webhook, delivery *event.Delivery, at time.Time) (int, error) {
+	body, err := json.Marshal(delivery.Event)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(event.EventHeader, string(delivery.Event.Type))
+	req.Header.Set(event.EventIDHeader, delivery.Event.ID)
+	req.Header.Set(event.DeliveryHeader, delivery.ID)
+	req.Header.Set(event.TimestampHeader, strconv.FormatInt(at.Unix(), 10))
+	req.Header.Set(event.SignatureHeader, event.Sign(w.Secret, at, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// memoryOutbox is an in-memory mongo.OutboxStore.
+// Event IDs are zero padded sequence numbers so that they sort in the order the events were appended.
+type memoryOutbox struct {
+	mu         sync.Mutex
+	events     []*event.Event
+	dispatched map[string]bool
+}
+
+func (s *memoryOutbox) Append(_ context.Context, e *event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e.ID = fmt.Sprintf("%024d", len(s.events)+1)
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *memoryOutbox) After(_ context.Context, id string, limit int64) ([]*event.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []*event.Event
+	for _, e := range s.events {
+		if e.ID > id && int64(len(events)) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *memoryOutbox) LatestID(context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fmt.Sprintf("%024d", len(s.events)), nil
+}
+
+func (s *memoryOutbox) Undispatched(_ context.Context, limit int64) ([]*event.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []*event.Event
+	for _, e := range s.events {
+		if !s.dispatched[e.ID] && int64(len(events)) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *memoryOutbox) MarkDispatched(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dispatched[id] = true
+	return nil
+}
+
+// memoryWebhooks is an in-memory mongo.WebhookStore
+type memoryWebhooks struct {
+	webhooks []*event.Webhook
+}
+
+func (s *memoryWebhooks) Create(_ context.Context, w *event.Webhook) (*event.Webhook, error) {
+	w.ID = "w" + strconv.Itoa(len(s.webhooks)+1)
+	s.webhooks = append(s.webhooks, w)
+	return w, nil
+}
+
+func (s *memoryWebhooks) GetByID(_ context.Context, id string) (*event.Webhook, error) {
+	for _, w := range s.webhooks {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *memoryWebhooks) Update(context.Context, *event.Webhook) error { return nil }
+
+func (s *memoryWebhooks) Delete(_ context.Context, id string) error {
+	for i, w := range s.webhooks {
+		if w.ID == id {
+			s.webhooks = append(s.webhooks[:i], s.webhooks[i+1:]...)
+		}
+	}
+	return nil
+}
+
+func (s *memoryWebhooks) List(context.Context) ([]*event.Webhook, error) {
+	return s.webhooks, nil
+}
+
+// memoryDeliveries is an in-memory mongo.DeliveryStore
+type memoryDeliveries struct {
+	deliveries []*event.Delivery
+}
+
+func (s *memoryDeliveries) Create(_ context.Context, d *event.Delivery) (*event.Delivery, error) {
+	d.ID = "d" + strconv.Itoa(len(s.deliveries)+1)
+	s.deliveries = append(s.deliveries, d)
+	return d, nil
+}
+
+func (s *memoryDeliveries) Update(context.Context, *event.Delivery) error { return nil }
+
+func (s *memoryDeliveries) Due(_ context.Context, now time.Time, limit int64) ([]*event.Delivery, error) {
+	var due []*event.Delivery
+	for _, d := range s.deliveries {
+		if d.Status == event.Pending && !d.NextAttemptAt.After(now) && int64(len(due)) < limit {
+			due = append(due, d)
+		}
+	}
+	return due, nil
+}
+
+func (s *memoryDeliveries) List(_ context.Context, webhookID string, _, _ int64) ([]*event.Delivery, error) {
+	var deliveries []*event.Delivery
+	for _, d := range s.deliveries {
+		if d.WebhookID == webhookID {
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries, nil
+}
+
+// receiver is a local webhook endpoint recording the deliveries it accepts
+type receiver struct {
+	mu       sync.Mutex
+	secret   string
+	failures int // Number of requests to fail before accepting deliveries
+	received []*event.Event
+	invalid  int
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	body, _ := io.ReadAll(req.Body)
+	if !event.Verify(r.secret, req.Header.Get(event.TimestampHeader), req.Header.Get(event.SignatureHeader), body) {
+		r.invalid++
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	var e event.Event
+	if err := json.Unmarshal(body, &e); err != nil || req.Header.Get(event.EventHeader) != string(e.Type) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.received = append(r.received, &e)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func newTestDispatcher(policy event.RetryPolicy) (*WebhookDispatcher, *memoryOutbox, *memoryWebhooks, *memoryDeliveries) {
+	outbox := &memoryOutbox{dispatched: map[string]bool{}}
+	webhooks := &memoryWebhooks{}
+	deliveries := &memoryDeliveries{}
+	d := NewWebhookDispatcher(outbox, webhooks, deliveries, http.DefaultClient, policy, time.Second, zap.NewNop())
+	return d, outbox, webhooks, deliveries
+}
+
+func TestWebhookDispatcherDelivers(t *testing.T) {
+	rcv := &receiver{secret: "0123456789abcdef"}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	ctx := context.Background()
+	d, outbox, webhooks, deliveries := newTestDispatcher(event.DefaultRetryPolicy())
+	all, _ := event.NewWebhook(server.URL, rcv.secret, nil, "ERP")
+	deletions, _ := event.NewWebhook(server.URL+"/deleted", rcv.secret, []event.Type{event.RecipeDeleted}, "Web shop")
+	webhooks.Create(ctx, all)
+	webhooks.Create(ctx, deletions)
+
+	outbox.Append(ctx, event.New(event.RecipeCreated, "r1", "req-1", json.RawMessage(`{"Name":"Dark"}`)))
+	outbox.Append(ctx, event.New(event.RecipeDeleted, "r1", "req-2", json.RawMessage(`{"Name":"Dark"}`)))
+
+	if err := d.Dispatch(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The created event goes to the first webhook, the deleted event to both
+	if len(deliveries.deliveries) != 3 {
+		t.Fatalf("Expected 3 deliveries, got %d", len(deliveries.deliveries))
+	}
+	for _, delivery := range deliveries.deliveries {
+		if delivery.Status != event.Succeeded || len(delivery.Attempts) != 1 || delivery.Attempts[0].StatusCode != 204 {
+			t.Errorf("Expected delivery %s to succeed at the first attempt, got %s after %+v", delivery.ID, delivery.Status, delivery.Attempts)
+		}
+	}
+	if len(rcv.received) != 3 || rcv.invalid != 0 {
+		t.Fatalf("Expected 3 valid deliveries, got %d and %d with invalid signatures", len(rcv.received), rcv.invalid)
+	}
+	if got := rcv.received[0]; got.Type != event.RecipeCreated || got.ResourceID != "r1" || string(got.Data) != `{"Name":"Dark"}` {
+		t.Errorf("Unexpected event %+v", got)
+	}
+
+	// Dispatched events are not delivered again
+	if err := d.Dispatch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries.deliveries) != 3 || len(rcv.received) != 3 {
+		t.Errorf("Expected no new deliveries, got %d deliveries and %d received", len(deliveries.deliveries), len(rcv.received))
+	}
+}
+
+func TestWebhookDispatcherRetries(t *testing.T) {
+	rcv := &receiver{secret: "0123456789abcdef", failures: 2}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	ctx := context.Background()
+	policy := event.RetryPolicy{MaxAttempts: 5, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 2}
+	d, outbox, webhooks, deliveries := newTestDispatcher(policy)
+	w, _ := event.NewWebhook(server.URL, rcv.secret, nil, "")
+	webhooks.Create(ctx, w)
+	outbox.Append(ctx, event.New(event.RecipeUpdated, "r1", "", nil))
+
+	for i := 0; i < 3; i++ {
+		if err := d.Dispatch(ctx); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	delivery := deliveries.deliveries[0]
+	if delivery.Status != event.Succeeded || len(delivery.Attempts) != 3 {
+		t.Fatalf("Expected delivery to succeed at the third attempt, got %s after %d", delivery.Status, len(delivery.Attempts))
+	}
+	if delivery.Attempts[0].StatusCode != 503 || delivery.Attempts[0].Error == "" {
+		t.Errorf("Expected the failed attempt to be logged, got %+v", delivery.Attempts[0])
+	}
+	if len(rcv.received) != 1 {
+		t.Errorf("Expected the event to be received once, got %d", len(rcv.received))
+	}
+}
+
+func TestWebhookDispatcherGivesUp(t *testing.T) {
+	rcv := &receiver{secret: "a different secret"}
+	server := httptest.NewServer(rcv)
+	defer server.Close()
+
+	ctx := context.Background()
+	policy := event.RetryPolicy{MaxAttempts: 2, InitialDelay: time.Hour, MaxDelay: time.Hour, Multiplier: 2}
+	d, outbox, webhooks, deliveries := newTestDispatcher(policy)
+	w, _ := event.NewWebhook(server.URL, "0123456789abcdef", nil, "")
+	webhooks.Create(ctx, w)
+	outbox.Append(ctx, event.New(event.RecipeUpdated, "r1", "", nil))
+
+	if err := d.Dispatch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	delivery := deliveries.deliveries[0]
+	if delivery.Status != event.Pending || delivery.NextAttemptAt.Before(time.Now().Add(59*time.Minute)) {
+		t.Fatalf("Expected a retry in an hour, got %s at %v", delivery.Status, delivery.NextAttemptAt)
+	}
+
+	// Not due yet
+	if err := d.Dispatch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(delivery.Attempts) != 1 {
+		t.Fatalf("Expected no attempt before the backoff delay, got %d", len(delivery.Attempts))
+	}
+
+	delivery.NextAttemptAt = time.Now()
+	if err := d.Dispatch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Status != event.Failed || rcv.invalid != 2 {
+		t.Errorf("Expected delivery with a rejected signature to fail after 2 attempts, got %s after %d", delivery.Status, rcv.invalid)
+	}
+}
+
+func TestWebhookDispatcherRemovedWebhook(t *testing.T) {
+	ctx := context.Background()
+	d, outbox, webhooks, deliveries := newTestDispatcher(event.DefaultRetryPolicy())
+	w, _ := event.NewWebhook("http://127.0.0.1:1", "0123456789abcdef", nil, "")
+	webhooks.Create(ctx, w)
+	outbox.Append(ctx, event.New(event.RecipeUpdated, "r1", "", nil))
+	if err := d.schedule(ctx); err != nil {
+		t.Fatal(err)
+	}
+	webhooks.Delete(ctx, w.ID)
+
+	if err := d.Dispatch(ctx); err != nil {
+		t.Fatal(err)
+	}
+	delivery := deliveries.deliveries[0]
+	if delivery.Status != event.Failed {
+		t.Errorf("Expected delivery to a removed webhook to fail, got %s", delivery.Status)
+	}
+	if len(delivery.Attempts) != 1 || delivery.Attempts[0].At.IsZero() {
+		t.Errorf("Expected the failed attempt to be timestamped, got %+v", delivery.Attempts)
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/mongo"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/event"
+)
+
+// WebhookService defines the contract for webhook registration operations
+type WebhookService interface {
+	Create(ctx context.Context, w *event.Webhook) (*event.Webhook, error)
+	GetByID(ctx context.Context, id string) (*event.Webhook, error)
+	Update(ctx context.Context, w *event.Webhook) error
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]*event.Webhook, error)
+	Deliveries(ctx context.Context, id string, limit, offset int64) ([]*event.Delivery, error)
+}
+
+// webhookService implements the WebhookService interface
+type webhookService struct {
+	store         mongo.WebhookStore
+	deliveryStore mongo.DeliveryStore
+}
+
+// NewWebhookService creates a new WebhookService
+func NewWebhookService(store mongo.WebhookStore, deliveryStore mongo.DeliveryStore) *webhookService {
+	return &webhookService{
+		store:         store,
+		deliveryStore: deliveryStore,
+	}
+}
+
+// Create registers a new webhook
+func (s *webhookService) Create(ctx context.Context, w *event.Webhook) (*event.Webhook, error) {
+	newWebhook, err := event.NewWebhook(w.URL, w.Secret, w.EventTypes, w.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Create(ctx, newWebhook)
+}
+
+// GetByID retrieves a webhook by its ID, returning event.ErrWebhookNotFound if it does not exist
+func (s *webhookService) GetByID(ctx context.Context, id string) (*event.Webhook, error) {
+	w, err := s.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		return nil, event.ErrWebhookNotFound
+	}
+	return w, nil
+}
+
+// Update changes the URL, subscribed event types and active state of a webhook.
+// The secret is kept when none is given.
+func (s *webhookService) Update(ctx context.Context, w *event.Webhook) error {
+	existing, err := s.GetByID(ctx, w.ID)
+	if err != nil {
+		return err
+	}
+	if w.Secret == "" {
+		w.Secret = existing.Secret
+	}
+	if err := w.Validate(); err != nil {
+		return err
+	}
+	w.CreatedAt = existing.CreatedAt
+
+	return s.store.Update(ctx, w)
+}
+
+// Delete removes a webhook. Pending deliveries to it fail on their next attempt.
+func (s *webhookService) Delete(ctx context.Context, id string) error {
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return s.store.Delete(ctx, id)
+}
+
+// List retrieves all webhooks
+func (s *webhookService) List(ctx context.Context) ([]*event.Webhook, error) {
+	return s.store.List(ctx)
+}
+
+// Deliveries retrieves the delivery log of a webhook with pagination, most recent first
+func (s *webhookService) Deliveries(ctx context.Context, id string, limit, offset int64) ([]*event.Delivery, error) {
+	if _, err := s.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.deliveryStore.List(ctx, id, limit, offset)
+}
@@ -0,0 +1,121 @@
+// Package audit models the append-only log of changes made to resources, kept for food-safety audits.
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+)
+
+// Action is the kind of change recorded by an audit event.
+type Action string
+
+const (
+	Create       Action = "create"
+	Update       Action = "update"
+	Delete       Action = "delete"
+	Restore      Action = "restore"
+	Approve      Action = "approve"
+	StatusChange Action = "status_change" // Any lifecycle status change other than an approval
+)
+
+// Resource types recorded in audit events
+const (
+	RecipeResource = "recipe"
+)
+
+// Event records a single change to a resource.
+// Before and After are JSON snapshots of the resource, Before is empty for creations.
+type Event struct {
+	ID           string
+	Action       Action
+	ResourceType string
+	ResourceID   string
+	Actor        string
+	RequestID    string
+	Timestamp    time.Time
+	Before       json.RawMessage `json:",omitempty"`
+	After        json.RawMessage `json:",omitempty"`
+	Changes      []string        `json:",omitempty"` // Top level fields that differ between Before and After
+}
+
+// Filter narrows down the audit events to list. Empty fields match everything.
+type Filter struct {
+	ResourceType string
+	ResourceID   string
+	Actor        string
+	Action       Action
+	RequestID    string
+	From         time.Time // Inclusive
+	To           time.Time // Exclusive
+}
+
+// Matches reports whether the event meets the criteria of the filter.
+func (f Filter) Matches(e *Event) bool {
+	return (f.ResourceType == "" || e.ResourceType == f.ResourceType) &&
+		(f.ResourceID == "" || e.ResourceID == f.ResourceID) &&
+		(f.Actor == "" || e.Actor == f.Actor) &&
+		(f.Action == "" || e.Action == f.Action) &&
+		(f.RequestID == "" || e.RequestID == f.RequestID) &&
+		(f.From.IsZero() || !e.Timestamp.Before(f.From)) &&
+		(f.To.IsZero() || e.Timestamp.Before(f.To))
+}
+
+// Snapshot returns the JSON representation of a resource to record in an event.
+func Snapshot(v any) (json.RawMessage, error) {
+	return json.Marshal(v)
+}
+
+// NewEvent creates an event for a change to a resource, listing the fields that changed.
+func NewEvent(action Action, resourceType, resourceID, actor, requestID string, before, after json.RawMessage) *Event {
+	return &Event{
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Actor:        actor,
+		RequestID:    requestID,
+		Timestamp:    time.Now(),
+		Before:       before,
+		After:        after,
+		Changes:      Changes(before, after),
+	}
+}
+
+// Changes returns the sorted top level fields of two JSON objects that differ.
+// Missing or non-object snapshots are treated as empty objects.
+func Changes(before, after json.RawMessage) []string {
+	var b, a map[string]json.RawMessage
+	_ = json.Unmarshal(before, &b)
+	_ = json.Unmarshal(after, &a)
+
+	var changes []string
+	for field, value := range a {
+		if old, ok := b[field]; !ok || !bytes.Equal(old, value) {
+			changes = append(changes, field)
+		}
+	}
+	for field := range b {
+		if _, ok := a[field]; !ok {
+			changes = append(changes, field)
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// JSONLWriter writes events as JSON lines, one event per line.
+type JSONLWriter struct {
+	enc *json.Encoder
+}
+
+// NewJSONLWriter creates a JSONLWriter writing to w.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	return &JSONLWriter{enc: json.NewEncoder(w)}
+}
+
+// Write writes a single event followed by a newline.
+func (w *JSONLWriter) Write(e *Event) error {
+	return w.enc.Encode(e)
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChanges(t *testing.T) {
+	before := json.RawMessage(`{"Name":"Dark","Status":"draft","Yield":100,"Notes":"x"}`)
+	after := json.RawMessage(`{"Name":"Dark","Status":"approved","Yield":120,"ApprovedBy":"alice"}`)
+
+	got := Changes(before, after)
+	want := []string{"ApprovedBy", "Notes", "Status", "Yield"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected changes %v, got %v", want, got)
+	}
+
+	if got := Changes(nil, json.RawMessage(`{"Name":"Dark"}`)); !reflect.DeepEqual(got, []string{"Name"}) {
+		t.Errorf("Expected every field to change on creation, got %v", got)
+	}
+	if got := Changes(before, before); len(got) != 0 {
+		t.Errorf("Expected no changes for identical snapshots, got %v", got)
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	before, _ := Snapshot(map[string]string{"Status": "in_review"})
+	after, _ := Snapshot(map[string]string{"Status": "approved"})
+	e := NewEvent(Approve, RecipeResource, "abc", "alice", "req-1", before, after)
+
+	if e.Action != Approve || e.ResourceID != "abc" || e.Actor != "alice" || e.RequestID != "req-1" {
+		t.Errorf("Unexpected event %+v", e)
+	}
+	if e.Timestamp.IsZero() {
+		t.Error("Expected the event to be timestamped")
+	}
+	if !reflect.DeepEqual(e.Changes, []string{"Status"}) {
+		t.Errorf("Expected Status to change, got %v", e.Changes)
+	}
+}
+
+func TestJSONLWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewJSONLWriter(&buf)
+	for _, id := range []string{"a", "b"} {
+		if err := w.Write(&Event{ID: id, Action: Delete, Before: json.RawMessage(`{"Name":"Dark"}`)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d: %q", len(lines), buf.String())
+	}
+	var e Event
+	if err := json.Unmarshal([]byte(lines[1]), &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != "b" || string(e.Before) != `{"Name":"Dark"}` || e.After != nil {
+		t.Errorf("Unexpected event %+v", e)
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	at := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	e := &Event{Action: Update, ResourceType: RecipeResource, ResourceID: "r1", Actor: "alice", Timestamp: at}
+
+	tests := []struct {
+		filter Filter
+		want   bool
+	}{
+		{Filter{}, true},
+		{Filter{ResourceType: RecipeResource, ResourceID: "r1", Actor: "alice", Action: Update}, true},
+		{Filter{Actor: "bob"}, false},
+		{Filter{From: at, To: at.Add(time.Second)}, true},
+		{Filter{To: at}, false},
+		{Filter{From: at.Add(time.Second)}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Matches(e); got != tt.want {
+			t.Errorf("%+v: expected %v, got %v", tt.filter, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Package bulk reads and writes streams of recipes as JSON lines or CSV, for importing and exporting recipe libraries.
+package bulk
+
+import (
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// Format is a bulk file format.
+type Format string
+
+const (
+	JSONLines Format = "jsonl" // One recipe per line, in the JSON representation of the API
+	CSV       Format = "csv"   // One row per ingredient, recipe fields repeated on every row
+)
+
+// Content types of the formats
+const (
+	MIMEJSONLines = "application/x-ndjson"
+	MIMECSV       = "text/csv"
+)
+
+// ContentType returns the content type of the format.
+func (f Format) ContentType() string {
+	if f == CSV {
+		return MIMECSV
+	}
+	return MIMEJSONLines
+}
+
+// Record is a recipe read from a bulk file.
+// Err is set when the record could not be parsed; reading continues with the next record.
+type Record struct {
+	Line   int // Line the record starts on
+	Recipe *recipe.Recipe
+	Err    error
+}
+
+// Reader reads recipes one at a time. Next returns io.EOF after the last record.
+type Reader interface {
+	Next() (*Record, error)
+}
+
+// Writer writes recipes one at a time. Flush must be called after the last recipe.
+type Writer interface {
+	Write(r *recipe.Recipe) error
+	Flush() error
+}
+
+// Result is the outcome of importing a single record.
+type Result struct {
+	Line  int
+	Name  string
+	ID    string `json:",omitempty"` // ID of the created recipe, empty for a dry run or a failure
+	Error string `json:",omitempty"`
+}
+
+// Report summarizes an import. A record that fails does not stop the others from being imported.
+type Report struct {
+	DryRun    bool // The records were only validated, nothing was saved
+	Total     int
+	Succeeded int
+	Failed    int
+	Results   []Result
+}
+
+// Add records the outcome of importing a record.
+func (r *Report) Add(rec *Record, id string, err error) {
+	result := Result{Line: rec.Line, ID: id}
+	if rec.Recipe != nil {
+		result.Name = rec.Recipe.Name
+	}
+	r.Total++
+	if err != nil {
+		result.Error = err.Error()
+		r.Failed++
+	} else {
+		r.Succeeded++
+	}
+	r.Results = append(r.Results, result)
+}
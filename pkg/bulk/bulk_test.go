@@ -0,0 +1,154 @@
+package bulk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+func readAll(t *testing.T, r Reader) []*Record {
+	t.Helper()
+	var records []*Record
+	for {
+		rec, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+}
+
+func testRecipes() []*recipe.Recipe {
+	return []*recipe.Recipe{
+		{
+			ID:           "r1",
+			Name:         "Dark 70%",
+			Instructions: "Refine, conche, temper",
+			Losses:       []recipe.ProcessLoss{{Stage: "roasting", Percentage: 5}, {Stage: "winnowing", Percentage: 20}},
+			Ingredients: []recipe.Ingredient{
+				{Name: "Cacao nibs", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}, CostPerKg: 12.5},
+				{Name: "Sugar", Quantity: recipe.Quantity{Amount: 300, Unit: recipe.Gram}},
+			},
+		},
+		{
+			ID:             "r2",
+			Name:           "Milk, \"creamy\"",
+			Instructions:   "Mix\nTemper",
+			Classification: recipe.Milk,
+			Ingredients: []recipe.Ingredient{
+				{Name: "Cacao butter", IsCacao: true, Quantity: recipe.Quantity{Amount: 0.4, Unit: recipe.Kilogram}},
+			},
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []Format{JSONLines, CSV} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewWriter(format, &buf)
+			for _, rcp := range testRecipes() {
+				if err := w.Write(rcp); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			records := readAll(t, NewReader(format, &buf))
+			if len(records) != 2 {
+				t.Fatalf("Expected 2 records, got %d", len(records))
+			}
+			for i, want := range testRecipes() {
+				rec := records[i]
+				if rec.Err != nil {
+					t.Fatalf("Record %d: unexpected error %v", i, rec.Err)
+				}
+				got := rec.Recipe
+				if got.Name != want.Name || got.Instructions != want.Instructions || got.Classification != want.Classification {
+					t.Errorf("Record %d: expected %q, got %q", i, want.Name, got.Name)
+				}
+				if len(got.Ingredients) != len(want.Ingredients) || len(got.Losses) != len(want.Losses) {
+					t.Fatalf("Record %d: expected %d ingredients and %d losses, got %d and %d",
+						i, len(want.Ingredients), len(want.Losses), len(got.Ingredients), len(got.Losses))
+				}
+				for j, ing := range want.Ingredients {
+					if got.Ingredients[j] != ing {
+						t.Errorf("Record %d: expected ingredient %+v, got %+v", i, ing, got.Ingredients[j])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCSVReaderGroupsRowsByName(t *testing.T) {
+	input := `name,ingredient,amount,unit,is_cacao
+Dark,Nibs,700,g,true
+Dark,Sugar,300,g,
+Milk,Nibs,400,g,true
+Milk,Milk powder,abc,g,
+Milk,Sugar,300,g,
+White,Butter,500,g,true
+`
+	records := readAll(t, NewCSVReader(strings.NewReader(input)))
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+	if rec := records[0]; rec.Err != nil || rec.Line != 2 || len(rec.Recipe.Ingredients) != 2 {
+		t.Errorf("Expected Dark on line 2 with 2 ingredients, got %+v", rec)
+	}
+	// A bad row fails its recipe, but not the ones after it
+	if rec := records[1]; rec.Err == nil || !strings.Contains(rec.Err.Error(), "line 5") || rec.Recipe.Name != "Milk" {
+		t.Errorf("Expected Milk to fail on line 5, got %+v", rec)
+	}
+	if rec := records[2]; rec.Err != nil || rec.Line != 7 || rec.Recipe.Name != "White" {
+		t.Errorf("Expected White on line 7, got %+v", rec)
+	}
+}
+
+func TestCSVReaderRequiresColumns(t *testing.T) {
+	_, err := NewCSVReader(strings.NewReader("name,ingredient\nDark,Nibs\n")).Next()
+	if err == nil || !strings.Contains(err.Error(), "amount") {
+		t.Errorf("Expected missing amount column error, got %v", err)
+	}
+}
+
+func TestJSONLReaderInvalidLine(t *testing.T) {
+	input := `{"Name":"Dark"}
+
+not json
+{"name":"Milk"}
+`
+	records := readAll(t, NewJSONLReader(strings.NewReader(input)))
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+	if records[1].Err == nil || records[1].Line != 3 {
+		t.Errorf("Expected line 3 to be invalid, got %+v", records[1])
+	}
+	if records[2].Recipe == nil || records[2].Recipe.Name != "Milk" || records[2].Line != 4 {
+		t.Errorf("Expected Milk on line 4, got %+v", records[2])
+	}
+}
+
+func TestReport(t *testing.T) {
+	var report Report
+	report.Add(&Record{Line: 1, Recipe: &recipe.Recipe{Name: "Dark"}}, "r1", nil)
+	report.Add(&Record{Line: 2}, "", errors.New("invalid JSON"))
+
+	if report.Total != 2 || report.Succeeded != 1 || report.Failed != 1 {
+		t.Errorf("Unexpected totals %+v", report)
+	}
+	if report.Results[0].ID != "r1" || report.Results[1].Error != "invalid JSON" {
+		t.Errorf("Unexpected results %+v", report.Results)
+	}
+}
@@ -0,0 +1,283 @@
+package bulk
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// CSVHeader lists the CSV columns. Each row is one ingredient; consecutive rows with the same
+// recipe_id, or the same name when recipe_id is empty, form a recipe.
+// Losses are written as stage:percentage pairs separated by semicolons, e.g. "roasting:5;winnowing:20".
+var CSVHeader = []string{
+	"recipe_id", "name", "description", "instructions", "classification", "losses",
+	"ingredient", "is_cacao", "amount", "unit", "cacao_id", "cost_per_kg",
+}
+
+// column indexes of CSVHeader
+const (
+	colRecipeID = iota
+	colName
+	colDescription
+	colInstructions
+	colClassification
+	colLosses
+	colIngredient
+	colIsCacao
+	colAmount
+	colUnit
+	colCacaoID
+	colCostPerKg
+)
+
+// CSVReader reads recipes from CSV with the CSVHeader columns, in any order.
+type CSVReader struct {
+	r       *csv.Reader
+	columns map[string]int
+	pending []string // First row of the next recipe
+	line    int      // Line of the pending row
+}
+
+// NewCSVReader creates a CSVReader reading from r.
+func NewCSVReader(r io.Reader) *CSVReader {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	return &CSVReader{r: cr}
+}
+
+// Next returns the recipe made of the next group of rows.
+// A recipe with an invalid row is returned with Err set, after reading all of its rows.
+func (r *CSVReader) Next() (*Record, error) {
+	if r.columns == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+	if r.pending == nil {
+		if err := r.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	first := r.pending
+	rec := &Record{Line: r.line}
+	rcp := &recipe.Recipe{
+		ID:             r.field(first, colRecipeID),
+		Name:           r.field(first, colName),
+		Description:    r.field(first, colDescription),
+		Instructions:   r.field(first, colInstructions),
+		Classification: recipe.Classification(r.field(first, colClassification)),
+	}
+	losses, err := parseLosses(r.field(first, colLosses))
+	if err != nil {
+		rec.Err = fmt.Errorf("line %d: %w", r.line, err)
+	}
+	rcp.Losses = losses
+
+	for r.pending != nil && r.sameRecipe(first, r.pending) {
+		ing, err := r.ingredient(r.pending)
+		if err != nil && rec.Err == nil {
+			rec.Err = fmt.Errorf("line %d: %w", r.line, err)
+		}
+		rcp.Ingredients = append(rcp.Ingredients, ing)
+		if err := r.advance(); err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+	// The recipe ID is only used to group rows, imports always create new recipes
+	rcp.ID = ""
+	if rec.Err == nil {
+		rec.Recipe = rcp
+	} else {
+		rec.Recipe = &recipe.Recipe{Name: rcp.Name}
+	}
+	return rec, nil
+}
+
+// readHeader reads the header row and maps the column names to their position.
+func (r *CSVReader) readHeader() error {
+	header, err := r.r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return io.EOF
+		}
+		return err
+	}
+	r.columns = make(map[string]int, len(header))
+	for i, name := range header {
+		r.columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []int{colName, colIngredient, colAmount, colUnit} {
+		if _, ok := r.columns[CSVHeader[required]]; !ok {
+			return fmt.Errorf("missing CSV column %q", CSVHeader[required])
+		}
+	}
+	return nil
+}
+
+// advance reads the next row into pending, setting it to nil at the end of the input.
+func (r *CSVReader) advance() error {
+	row, err := r.r.Read()
+	if err != nil {
+		r.pending = nil
+		return err
+	}
+	r.line, _ = r.r.FieldPos(0)
+	r.pending = row
+	return nil
+}
+
+// field returns the value of a column in the row, or an empty string if the column is missing.
+func (r *CSVReader) field(row []string, col int) string {
+	i, ok := r.columns[CSVHeader[col]]
+	if !ok || i >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[i])
+}
+
+// sameRecipe reports whether two rows belong to the same recipe.
+func (r *CSVReader) sameRecipe(a, b []string) bool {
+	if id := r.field(a, colRecipeID); id != "" {
+		return id == r.field(b, colRecipeID)
+	}
+	return r.field(b, colRecipeID) == "" && r.field(a, colName) == r.field(b, colName)
+}
+
+// ingredient parses the ingredient columns of a row.
+func (r *CSVReader) ingredient(row []string) (recipe.Ingredient, error) {
+	ing := recipe.Ingredient{
+		Name:    r.field(row, colIngredient),
+		CacaoID: r.field(row, colCacaoID),
+		Quantity: recipe.Quantity{
+			Unit: recipe.Unit(r.field(row, colUnit)),
+		},
+	}
+	if ing.Name == "" && ing.CacaoID == "" {
+		return ing, errors.New("ingredient name is required")
+	}
+	amount, err := strconv.ParseFloat(r.field(row, colAmount), 64)
+	if err != nil {
+		return ing, fmt.Errorf("invalid amount %q", r.field(row, colAmount))
+	}
+	ing.Quantity.Amount = amount
+	if v := r.field(row, colIsCacao); v != "" {
+		if ing.IsCacao, err = strconv.ParseBool(v); err != nil {
+			return ing, fmt.Errorf("invalid is_cacao %q", v)
+		}
+	}
+	if v := r.field(row, colCostPerKg); v != "" {
+		if ing.CostPerKg, err = strconv.ParseFloat(v, 64); err != nil {
+			return ing, fmt.Errorf("invalid cost_per_kg %q", v)
+		}
+	}
+	return ing, nil
+}
+
+// parseLosses parses losses written as stage:percentage pairs separated by semicolons.
+func parseLosses(s string) ([]recipe.ProcessLoss, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var losses []recipe.ProcessLoss
+	for _, pair := range strings.Split(s, ";") {
+		stage, pct, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid loss %q, expected stage:percentage", pair)
+		}
+		percentage, err := strconv.ParseFloat(strings.TrimSpace(pct), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid loss percentage %q", pct)
+		}
+		losses = append(losses, recipe.ProcessLoss{Stage: strings.TrimSpace(stage), Percentage: percentage})
+	}
+	return losses, nil
+}
+
+// formatLosses writes losses as stage:percentage pairs separated by semicolons.
+func formatLosses(losses []recipe.ProcessLoss) string {
+	pairs := make([]string, len(losses))
+	for i, loss := range losses {
+		pairs[i] = loss.Stage + ":" + strconv.FormatFloat(loss.Percentage, 'f', -1, 64)
+	}
+	return strings.Join(pairs, ";")
+}
+
+// CSVWriter writes recipes as CSV with the CSVHeader columns, one row per ingredient.
+// Tempering curves and process profiles are not part of the CSV format.
+type CSVWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVWriter creates a CSVWriter writing to w.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w)}
+}
+
+// Write writes a row for every ingredient of the recipe, preceded by the header row for the first recipe.
+func (w *CSVWriter) Write(r *recipe.Recipe) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	for _, ing := range r.Ingredients {
+		row := make([]string, len(CSVHeader))
+		row[colRecipeID] = r.ID
+		row[colName] = r.Name
+		row[colDescription] = r.Description
+		row[colInstructions] = r.Instructions
+		row[colClassification] = string(r.Classification)
+		row[colLosses] = formatLosses(r.Losses)
+		row[colIngredient] = ing.Name
+		row[colIsCacao] = strconv.FormatBool(ing.IsCacao)
+		row[colAmount] = strconv.FormatFloat(ing.Quantity.Amount, 'f', -1, 64)
+		row[colUnit] = string(ing.Quantity.Unit)
+		row[colCacaoID] = ing.CacaoID
+		if ing.CostPerKg != 0 {
+			row[colCostPerKg] = strconv.FormatFloat(ing.CostPerKg, 'f', -1, 64)
+		}
+		if err := w.w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes the header if no recipe was written, and any buffered data.
+func (w *CSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *CSVWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.w.Write(CSVHeader)
+}
+
+// NewReader creates a Reader for the format.
+func NewReader(f Format, r io.Reader) Reader {
+	if f == CSV {
+		return NewCSVReader(r)
+	}
+	return NewJSONLReader(r)
+}
+
+// NewWriter creates a Writer for the format.
+func NewWriter(f Format, w io.Writer) Writer {
+	if f == CSV {
+		return NewCSVWriter(w)
+	}
+	return NewJSONLWriter(w)
+}
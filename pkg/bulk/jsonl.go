@@ -0,0 +1,72 @@
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// maxLineSize is the longest JSON line accepted, in bytes
+const maxLineSize = 1 << 20
+
+// JSONLReader reads recipes from JSON lines. Blank lines are skipped.
+type JSONLReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewJSONLReader creates a JSONLReader reading from r.
+func NewJSONLReader(r io.Reader) *JSONLReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxLineSize)
+	return &JSONLReader{scanner: scanner}
+}
+
+// Next returns the recipe on the next non-blank line.
+func (r *JSONLReader) Next() (*Record, error) {
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		rec := &Record{Line: r.line}
+		var rcp recipe.Recipe
+		if err := json.Unmarshal(line, &rcp); err != nil {
+			rec.Err = fmt.Errorf("invalid JSON: %w", err)
+		} else {
+			rec.Recipe = &rcp
+		}
+		return rec, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// JSONLWriter writes recipes as JSON lines.
+type JSONLWriter struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONLWriter creates a JSONLWriter writing to w.
+func NewJSONLWriter(w io.Writer) *JSONLWriter {
+	bw := bufio.NewWriter(w)
+	return &JSONLWriter{w: bw, enc: json.NewEncoder(bw)}
+}
+
+// Write writes a recipe followed by a newline.
+func (w *JSONLWriter) Write(r *recipe.Recipe) error {
+	return w.enc.Encode(r)
+}
+
+// Flush writes any buffered data.
+func (w *JSONLWriter) Flush() error {
+	return w.w.Flush()
+}
@@ -0,0 +1,294 @@
+// Package client is a typed Go client for the Recipe API.
+//
+// Every method takes a context and returns the types of the recipe package. Requests that are safe to repeat (reads and deletes)
+// are retried with exponential backoff when the API cannot be reached or is temporarily unavailable, and error responses
+// are returned as an *Error that can be matched with errors.Is against ErrNotFound, ErrBadRequest, ErrForbidden and ErrConflict.
+//
+//	c := client.New("http://localhost:8080", client.WithUser("alice", "reviewer"))
+//	for rcp, err := range c.Recipes(ctx, recipe.Filter{Status: recipe.Approved}, 0) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(rcp.Name)
+//	}
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+)
+
+// Defaults of a Client
+const (
+	DefaultRetries    = 3
+	DefaultBackoff    = 200 * time.Millisecond
+	DefaultMaxBackoff = 5 * time.Second
+	DefaultPageSize   = 100
+)
+
+// Errors that an *Error returned by the client matches with errors.Is, depending on the status code of the response
+var (
+	ErrBadRequest = errors.New("bad request")
+	ErrForbidden  = errors.New("forbidden")
+	ErrNotFound   = errors.New("not found")
+	ErrConflict   = errors.New("conflict")
+)
+
+// Error is an error response of the API
+type Error struct {
+	StatusCode int
+	Message    string       // Error message returned by the API
+	RequestID  string       // ID of the request, to find it in the logs of the API
+	Report     *bulk.Report // Records read before an import was rejected
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (%d %s)", e.Message, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Is reports whether the status code of the response corresponds to the target error
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
+}
+
+// Client makes requests to the Recipe API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	http       *http.Client
+	user       string
+	roles      []string
+	retries    int
+	backoff    time.Duration
+	maxBackoff time.Duration
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to make requests, for example to set a timeout or a transport
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.http = hc }
+}
+
+// WithUser makes requests as the given user with the given roles, which the API reads from the X-User and X-Roles headers
+func WithUser(user string, roles ...string) Option {
+	return func(c *Client) {
+		c.user = user
+		c.roles = roles
+	}
+}
+
+// WithRetries sets how many times an idempotent request is retried, and the backoff before the first retry.
+// The backoff doubles with every retry up to DefaultMaxBackoff. Zero retries disables retrying.
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+// New creates a client for the Recipe API at the given base URL, such as http://localhost:8080
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		http:       &http.Client{Timeout: time.Minute},
+		retries:    DefaultRetries,
+		backoff:    DefaultBackoff,
+		maxBackoff: DefaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// request describes a request to the API
+type request struct {
+	method      string
+	path        string
+	query       url.Values
+	accept      string
+	contentType string
+	body        []byte    // Sent again when the request is retried
+	stream      io.Reader // Sent instead of body, for requests that cannot be retried
+	noRetry     bool      // Set for requests that are idempotent by method but not by effect
+}
+
+// idempotent reports whether the request can safely be sent more than once
+func (r *request) idempotent() bool {
+	if r.noRetry {
+		return false
+	}
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return r.stream == nil
+	}
+	return false
+}
+
+// retryable reports whether a response with the status code may succeed when the request is sent again
+func retryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// do sends a request, retrying it if it is idempotent, and returns the response if its status is successful.
+// The caller has to close the body of the response.
+func (c *Client) do(ctx context.Context, r *request) (*http.Response, error) {
+	attempts := 1
+	if r.idempotent() {
+		attempts += max(c.retries, 0)
+	}
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, r)
+		last := attempt == attempts
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || last {
+				return nil, err
+			}
+		case retryable(resp.StatusCode) && !last:
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+			resp.Body.Close()
+		case resp.StatusCode >= 400:
+			defer resp.Body.Close()
+			return nil, decodeError(resp)
+		default:
+			return resp, nil
+		}
+
+		wait := c.backoffFor(attempt)
+		if resp != nil {
+			if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+				wait = min(time.Duration(seconds)*time.Second, c.maxBackoff)
+			}
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoffFor returns how long to wait after the given attempt failed
+func (c *Client) backoffFor(attempt int) time.Duration {
+	wait := c.backoff
+	for i := 1; i < attempt && wait < c.maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, c.maxBackoff)
+}
+
+// send sends a request once
+func (c *Client) send(ctx context.Context, r *request) (*http.Response, error) {
+	u := c.baseURL + r.path
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+	body := r.stream
+	if body == nil && r.body != nil {
+		body = bytes.NewReader(r.body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if r.accept != "" {
+		req.Header.Set("Accept", r.accept)
+	}
+	if r.contentType != "" {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+	if c.user != "" {
+		req.Header.Set("X-User", c.user)
+	}
+	if len(c.roles) > 0 {
+		req.Header.Set("X-Roles", strings.Join(c.roles, ","))
+	}
+	return c.http.Do(req)
+}
+
+// decodeError reads an error response, which carries the message in an error field
+func decodeError(resp *http.Response) error {
+	var body struct {
+		Error  string       `json:"error"`
+		Report *bulk.Report `json:"report"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if json.Unmarshal(data, &body) != nil || body.Error == "" {
+		body.Error = strings.TrimSpace(string(data))
+	}
+	if body.Error == "" {
+		body.Error = http.StatusText(resp.StatusCode)
+	}
+	return &Error{
+		StatusCode: resp.StatusCode,
+		Message:    body.Error,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+		Report:     body.Report,
+	}
+}
+
+// doJSON sends a request with an optional JSON body and decodes the JSON response into out, unless out is nil.
+// It returns the status code of the response.
+func (c *Client) doJSON(ctx context.Context, r *request, in, out any) (int, error) {
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return 0, err
+		}
+		r.body = data
+		if r.contentType == "" {
+			r.contentType = "application/json"
+		}
+	}
+	resp, err := c.do(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if out != nil && resp.StatusCode != http.StatusNoContent {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, fmt.Errorf("decoding response: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// doStream sends a request and copies the body of the response to w
+func (c *Client) doStream(ctx context.Context, r *request, w io.Writer) error {
+	resp, err := c.do(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -0,0 +1,341 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/onasunnymorning/go-make-chocolate/internal/actor"
+	"github.com/onasunnymorning/go-make-chocolate/internal/infra/db/memory"
+	"github.com/onasunnymorning/go-make-chocolate/internal/interface/rest"
+	"github.com/onasunnymorning/go-make-chocolate/internal/service"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/render"
+)
+
+// newTestAPI starts the recipe endpoints of the API over in-memory stores and returns a client for it
+func newTestAPI(t *testing.T, opts ...Option) *Client {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	recipeService := service.NewRecipeService(memory.NewRecipeStore(), memory.NewCacaoStore(), memory.NewAuditStore(), memory.NewOutboxStore(), memory.NewTransactor())
+	controller := rest.NewRecipeController(recipeService, service.NewMoldService(memory.NewMoldStore()))
+
+	r := gin.New()
+	r.ContextWithFallback = true
+	r.Use(rest.RequestID(), rest.Identity(actor.Policy{TrustIdentity: true}))
+	g := r.Group("/recipe")
+	g.POST("", controller.CreateRecipe)
+	g.GET(":id", controller.GetRecipeByID)
+	g.GET(":id/template", controller.GetRecipeTemplate)
+	g.GET(":id/origin", controller.GetRecipeOrigin)
+	g.GET(":id/tempering", controller.GetRecipeTempering)
+	g.GET(":id/batch-sheet.pdf", controller.GetRecipeBatchSheet)
+	g.PUT(":id", controller.UpdateRecipe)
+	g.POST(":id/status", controller.TransitionRecipe)
+	g.DELETE(":id", controller.DeleteRecipe)
+	g.POST(":id/restore", controller.RestoreRecipe)
+	g.POST("/import", controller.ImportRecipes)
+	g.GET("/export", controller.ExportRecipes)
+	g.GET("/trash", controller.ListTrash)
+	g.GET("", controller.ListRecipes)
+	g.GET("/count", controller.CountRecipes)
+	g.POST("/purchase-list", controller.CreatePurchaseList)
+
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	return New(srv.URL, append([]Option{WithUser("alice", "reviewer")}, opts...)...)
+}
+
+func dark70() *recipe.Recipe {
+	return &recipe.Recipe{
+		Name:        "Dark 70",
+		Description: "A dark bar",
+		Ingredients: []recipe.Ingredient{
+			{Name: "Cacao nibs", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}},
+			{Name: "Cane sugar", Quantity: recipe.Quantity{Amount: 300, Unit: recipe.Gram}},
+		},
+		Instructions:   "Roast the beans.\nConche for 24 hours.",
+		Classification: recipe.Dark,
+	}
+}
+
+func TestRecipeLifecycle(t *testing.T) {
+	ctx := context.Background()
+	c := newTestAPI(t)
+
+	created, err := c.CreateRecipe(ctx, dark70())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" || created.CacaoPercentage != 70 || created.CreatedBy != "alice" {
+		t.Fatalf("unexpected created recipe %+v", created)
+	}
+
+	scaled, err := c.ScaleRecipe(ctx, created.ID, Scale{Yield: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scaled.Ingredients[0].Quantity.Amount != 350 {
+		t.Errorf("expected 350 g of nibs for 500 g, got %+v", scaled.Ingredients[0])
+	}
+
+	// Drafts are updated in place
+	rcp := dark70()
+	rcp.ID = created.ID
+	rcp.Ingredients[1].Name = "Coconut sugar"
+	if revision, err := c.UpdateRecipe(ctx, rcp); err != nil || revision != nil {
+		t.Fatalf("expected the draft to be updated in place, got %+v, %v", revision, err)
+	}
+
+	for _, status := range []recipe.Status{recipe.InReview, recipe.Approved} {
+		if _, err := c.TransitionRecipe(ctx, created.ID, status); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err = c.TransitionRecipe(ctx, created.ID, recipe.InReview)
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("expected a conflict moving an approved recipe back to review, got %v", err)
+	}
+
+	// Approved recipes are locked, so the update is saved as a new revision
+	revision, err := c.UpdateRecipe(ctx, rcp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision == nil || revision.ID == created.ID || revision.Revision != 2 {
+		t.Fatalf("expected a new draft revision, got %+v", revision)
+	}
+
+	if err := c.DeleteRecipe(ctx, revision.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetRecipe(ctx, revision.ID)
+	var apiErr *Error
+	if !errors.Is(err, ErrNotFound) || !errors.As(err, &apiErr) || apiErr.Message != "Recipe not found" || apiErr.RequestID == "" {
+		t.Errorf("expected a not found error with the request ID, got %#v", err)
+	}
+	if restored, err := c.RestoreRecipe(ctx, revision.ID); err != nil || restored.ID != revision.ID {
+		t.Errorf("expected the revision to be restored, got %+v, %v", restored, err)
+	}
+	if count, err := c.CountRecipes(ctx); err != nil || count != 2 {
+		t.Errorf("expected 2 recipes, got %d, %v", count, err)
+	}
+}
+
+func TestRecipeViews(t *testing.T) {
+	ctx := context.Background()
+	c := newTestAPI(t)
+	created, err := c.CreateRecipe(ctx, dark70())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tr, err := c.GetTemplate(ctx, created.ID); err != nil || tr.RecipeID != created.ID || len(tr.Ingredients) != 2 {
+		t.Errorf("unexpected template %+v, %v", tr, err)
+	}
+	if origin, err := c.GetOrigin(ctx, created.ID); err != nil || origin.SingleOrigin {
+		t.Errorf("expected a recipe without origins, got %+v, %v", origin, err)
+	}
+	plan, err := c.GetTempering(ctx, created.ID, recipe.TablingMethod, Scale{Yield: 2000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.Method != recipe.TablingMethod || plan.BatchSize.Amount != 2000 {
+		t.Errorf("unexpected tempering plan %+v", plan)
+	}
+
+	doc, err := c.GetRecipeJSONLD(ctx, created.ID, Scale{})
+	if err != nil || doc.Name != "Dark 70" || len(doc.RecipeIngredient) != 2 {
+		t.Errorf("unexpected schema.org recipe %+v, %v", doc, err)
+	}
+	doc.Name = "Dark 70 (copy)"
+	if copied, err := c.CreateRecipeJSONLD(ctx, doc); err != nil || copied.Name != "Dark 70 (copy)" || copied.ID == created.ID {
+		t.Errorf("expected a copy of the recipe, got %+v, %v", copied, err)
+	}
+
+	var card bytes.Buffer
+	if err := c.RenderRecipe(ctx, created.ID, render.Markdown, Scale{}, &card); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(card.String(), "# Dark 70\n") {
+		t.Errorf("expected a Markdown recipe card, got\n%s", card.String())
+	}
+
+	var pdf bytes.Buffer
+	if err := c.BatchSheet(ctx, created.ID, "", Scale{}, &pdf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(pdf.Bytes(), []byte("%PDF-")) {
+		t.Errorf("expected a PDF, got %q", pdf.Bytes()[:min(pdf.Len(), 16)])
+	}
+	err = c.BatchSheet(ctx, created.ID, "", Scale{Yield: -1}, &pdf)
+	if !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a bad request for a negative batch size, got %v", err)
+	}
+	if _, err := c.ScaleRecipe(ctx, created.ID, Scale{MoldID: "65f1c0ffee0000000000abcd", Molds: 2}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected an unknown mold to be not found, got %v", err)
+	}
+	if _, err := c.ScaleRecipe(ctx, created.ID, Scale{MoldID: "65f1c0ffee0000000000abcd"}); !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a bad request for a mold without a count, got %v", err)
+	}
+
+	list, err := c.PurchaseList(ctx, &recipe.ProductionPlan{
+		Items:     []recipe.PlanItem{{RecipeID: created.ID, Yield: 2000}},
+		Inventory: []recipe.Ingredient{{Name: "Cane sugar", Quantity: recipe.Quantity{Amount: 100, Unit: recipe.Gram}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 {
+		t.Errorf("expected 2 ingredients to buy, got %+v", list.Items)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	ctx := context.Background()
+	c := newTestAPI(t)
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		rcp := dark70()
+		rcp.Name = name
+		if _, err := c.CreateRecipe(ctx, rcp); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	for rcp, err := range c.Recipes(ctx, recipe.Filter{}, recipe.Sort{Field: recipe.SortByName, Descending: true}, 2) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, rcp.Name)
+	}
+	if strings.Join(names, "") != "EDCBA" {
+		t.Errorf("expected all recipes in pages of 2 by name descending, got %v", names)
+	}
+
+	// Stopping the iteration early does not request further pages
+	for rcp := range c.Recipes(ctx, recipe.Filter{}, recipe.Sort{}, 2) {
+		if err := c.DeleteRecipe(ctx, rcp.ID); err != nil {
+			t.Fatal(err)
+		}
+		break
+	}
+	var trash []*recipe.Recipe
+	for rcp, err := range c.Trash(ctx, 0) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		trash = append(trash, rcp)
+	}
+	if len(trash) != 1 || trash[0].Name != "A" {
+		t.Errorf("expected only A in the trash, got %+v", trash)
+	}
+
+	approved, err := c.ListRecipes(ctx, recipe.Filter{Status: recipe.Approved}, recipe.Sort{}, 10, "")
+	if err != nil || len(approved.Recipes) != 0 || approved.Total != 0 {
+		t.Errorf("expected no approved recipes, got %+v, %v", approved, err)
+	}
+
+	first, err := c.ListRecipes(ctx, recipe.Filter{Name: "b"}, recipe.Sort{}, 1, "")
+	if err != nil || len(first.Recipes) != 1 || first.Recipes[0].Name != "B" || first.Total != 1 || first.NextCursor != "" {
+		t.Errorf("expected only B, got %+v, %v", first, err)
+	}
+
+	resp, err := http.Get(c.baseURL + "/recipe?limit=2&sort=-name&status=draft")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	link := resp.Header.Get("Link")
+	if !strings.HasPrefix(link, `</recipe?limit=2&sort=-name&status=draft>; rel="first", </recipe?cursor=`) || !strings.HasSuffix(link, `&limit=2&sort=-name&status=draft>; rel="next"`) {
+		t.Errorf("expected links to the first and the next page, got %s", link)
+	}
+
+	_, err = c.ListRecipes(ctx, recipe.Filter{}, recipe.Sort{Field: "price"}, 10, "")
+	if !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a bad request for an unknown sort, got %v", err)
+	}
+	_, err = c.ListRecipes(ctx, recipe.Filter{}, recipe.Sort{}, 1000, "")
+	if !errors.Is(err, ErrBadRequest) {
+		t.Errorf("expected a bad request for a limit over 100, got %v", err)
+	}
+}
+
+func TestImportExport(t *testing.T) {
+	ctx := context.Background()
+	c := newTestAPI(t)
+	if _, err := c.CreateRecipe(ctx, dark70()); err != nil {
+		t.Fatal(err)
+	}
+
+	var export bytes.Buffer
+	if err := c.ExportRecipes(ctx, bulk.JSONLines, recipe.Filter{}, &export); err != nil {
+		t.Fatal(err)
+	}
+	export.WriteString("{\"Name\": \"\"}\n")
+
+	report, err := c.ImportRecipes(ctx, bulk.JSONLines, &export, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 2 || report.Succeeded != 1 || report.Failed != 1 {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if count, _ := c.CountRecipes(ctx); count != 1 {
+		t.Errorf("expected a dry run not to create recipes, got %d recipes", count)
+	}
+
+	_, err = c.ImportRecipes(ctx, bulk.CSV, strings.NewReader("name\nMilk 40\n"), true)
+	var apiErr *Error
+	if !errors.Is(err, ErrBadRequest) || !errors.As(err, &apiErr) || apiErr.Report == nil {
+		t.Errorf("expected the report of the records read before the error, got %#v", err)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"count": 42}`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL, WithRetries(2, time.Millisecond))
+	count, err := c.CountRecipes(context.Background())
+	if err != nil || count != 42 {
+		t.Fatalf("expected the third attempt to succeed, got %d, %v", count, err)
+	}
+
+	// Requests that are not idempotent are sent once
+	calls.Store(0)
+	_, err = c.TransitionRecipe(context.Background(), "abc", recipe.Approved)
+	if err == nil || calls.Load() != 1 {
+		t.Errorf("expected a single attempt, got %d attempts and %v", calls.Load(), err)
+	}
+	calls.Store(0)
+	if err := c.DeleteRecipe(context.Background(), "abc"); err == nil || calls.Load() != 1 {
+		t.Errorf("expected a single attempt to delete, got %d attempts and %v", calls.Load(), err)
+	}
+
+	// Waiting for a retry stops when the context is done
+	calls.Store(-100)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = New(srv.URL, WithRetries(5, time.Hour)).CountRecipes(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to be exceeded, got %v", err)
+	}
+}
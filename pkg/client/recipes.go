@@ -0,0 +1,389 @@
+package client
+
+import (
+	"context"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/bulk"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/render"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/schemaorg"
+)
+
+// Scale sets the yield a recipe is scaled to: either a yield in grams, or a mold from the mold catalog
+// together with either a number of molds to fill or a number of pieces to produce.
+// The zero value does not scale the recipe.
+type Scale struct {
+	Yield  float64
+	MoldID string
+	Molds  int
+	Pieces int
+}
+
+// query returns the query parameters for the scale
+func (s Scale) query() url.Values {
+	q := url.Values{}
+	if s.Yield != 0 {
+		q.Set("yield", strconv.FormatFloat(s.Yield, 'f', -1, 64))
+	}
+	if s.MoldID != "" {
+		q.Set("mold", s.MoldID)
+	}
+	if s.Molds != 0 {
+		q.Set("molds", strconv.Itoa(s.Molds))
+	}
+	if s.Pieces != 0 {
+		q.Set("pieces", strconv.Itoa(s.Pieces))
+	}
+	return q
+}
+
+// Origin describes the origin of the cacao in a recipe
+type Origin struct {
+	SingleOrigin bool            `json:"single_origin"`
+	Origin       *recipe.Origin  `json:"origin,omitempty"` // Origin details shared by all cacao, only for single origin recipes
+	Origins      []recipe.Origin `json:"origins"`
+}
+
+// recipeRequest is the body of requests that create or update a recipe
+type recipeRequest struct {
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	Ingredients    []recipe.Ingredient    `json:"ingredients"`
+	Instructions   string                 `json:"instructions"`
+	Losses         []recipe.ProcessLoss   `json:"losses"`
+	Classification recipe.Classification  `json:"classification,omitempty"`
+	Tempering      *recipe.TemperingCurve `json:"tempering"`
+	Process        *recipe.ProcessProfile `json:"process"`
+	Nutrition      *recipe.Nutrition      `json:"nutrition"`
+}
+
+func newRecipeRequest(rcp *recipe.Recipe) *recipeRequest {
+	return &recipeRequest{
+		Name:           rcp.Name,
+		Description:    rcp.Description,
+		Ingredients:    rcp.Ingredients,
+		Instructions:   rcp.Instructions,
+		Losses:         rcp.Losses,
+		Classification: rcp.Classification,
+		Tempering:      rcp.Tempering,
+		Process:        rcp.Process,
+		Nutrition:      rcp.Nutrition,
+	}
+}
+
+// recipePath returns the path of a recipe, or of a resource of the recipe
+func recipePath(id string, resource ...string) string {
+	path := "/recipe/" + url.PathEscape(id)
+	for _, r := range resource {
+		path += "/" + r
+	}
+	return path
+}
+
+// filterQuery returns the query parameters for a recipe filter
+func filterQuery(f recipe.Filter) url.Values {
+	q := url.Values{}
+	for key, value := range map[string]string{
+		"name":           f.Name,
+		"created_by":     f.CreatedBy,
+		"status":         string(f.Status),
+		"origin_country": f.OriginCountry,
+		"origin_region":  f.OriginRegion,
+		"variety":        f.Variety,
+		"certification":  string(f.Certification),
+	} {
+		if value != "" {
+			q.Set(key, value)
+		}
+	}
+	if f.MinCacao != 0 {
+		q.Set("min_cacao", strconv.FormatFloat(f.MinCacao, 'f', -1, 64))
+	}
+	if f.MaxCacao != 0 {
+		q.Set("max_cacao", strconv.FormatFloat(f.MaxCacao, 'f', -1, 64))
+	}
+	return q
+}
+
+// GetRecipe retrieves a recipe by its ID
+func (c *Client) GetRecipe(ctx context.Context, id string) (*recipe.Recipe, error) {
+	return c.ScaleRecipe(ctx, id, Scale{})
+}
+
+// ScaleRecipe retrieves a recipe scaled to a yield, or to fill a number of molds
+func (c *Client) ScaleRecipe(ctx context.Context, id string, scale Scale) (*recipe.Recipe, error) {
+	var rcp recipe.Recipe
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: recipePath(id), query: scale.query()}, nil, &rcp); err != nil {
+		return nil, err
+	}
+	return &rcp, nil
+}
+
+// GetRecipeJSONLD retrieves a recipe as a schema.org Recipe
+func (c *Client) GetRecipeJSONLD(ctx context.Context, id string, scale Scale) (*schemaorg.Recipe, error) {
+	var doc schemaorg.Recipe
+	r := &request{method: http.MethodGet, path: recipePath(id), query: scale.query(), accept: schemaorg.MIMEJSONLD}
+	if _, err := c.doJSON(ctx, r, nil, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// RenderRecipe writes a recipe rendered by the API as YAML or as a Markdown or plain text recipe card to w
+func (c *Client) RenderRecipe(ctx context.Context, id string, format render.Format, scale Scale, w io.Writer) error {
+	return c.doStream(ctx, &request{method: http.MethodGet, path: recipePath(id), query: scale.query(), accept: format.ContentType()}, w)
+}
+
+// GetTemplate retrieves the template of a recipe, with the ingredients as percentages
+func (c *Client) GetTemplate(ctx context.Context, id string) (*recipe.TemplateRecipe, error) {
+	var tr recipe.TemplateRecipe
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: recipePath(id, "template")}, nil, &tr); err != nil {
+		return nil, err
+	}
+	return &tr, nil
+}
+
+// GetOrigin retrieves the origins of the cacao in a recipe
+func (c *Client) GetOrigin(ctx context.Context, id string) (*Origin, error) {
+	var origin Origin
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: recipePath(id, "origin")}, nil, &origin); err != nil {
+		return nil, err
+	}
+	return &origin, nil
+}
+
+// GetTempering retrieves the tempering plan of a recipe for a batch made with the given method.
+// The batch size is the yield of the recipe unless the scale says otherwise, and the API defaults to the seed method when the method is empty.
+func (c *Client) GetTempering(ctx context.Context, id string, method recipe.TemperingMethod, scale Scale) (*recipe.TemperingPlan, error) {
+	q := scale.query()
+	if method != "" {
+		q.Set("method", string(method))
+	}
+	var plan recipe.TemperingPlan
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: recipePath(id, "tempering"), query: q}, nil, &plan); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// BatchSheet writes the printable PDF batch sheet of a recipe to w.
+// The batch size and method default like for GetTempering.
+func (c *Client) BatchSheet(ctx context.Context, id string, method recipe.TemperingMethod, scale Scale, w io.Writer) error {
+	q := scale.query()
+	if method != "" {
+		q.Set("method", string(method))
+	}
+	return c.doStream(ctx, &request{method: http.MethodGet, path: recipePath(id, "batch-sheet.pdf"), query: q, accept: render.MIMEPDF}, w)
+}
+
+// CreateRecipe creates a recipe from its name, description, ingredients, instructions, losses, classification,
+// tempering curve, process profile and nutrition facts, and returns the created recipe
+func (c *Client) CreateRecipe(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
+	var created recipe.Recipe
+	if _, err := c.doJSON(ctx, &request{method: http.MethodPost, path: "/recipe"}, newRecipeRequest(rcp), &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// CreateRecipeJSONLD creates a recipe from a schema.org Recipe and returns the created recipe
+func (c *Client) CreateRecipeJSONLD(ctx context.Context, doc *schemaorg.Recipe) (*recipe.Recipe, error) {
+	var created recipe.Recipe
+	r := &request{method: http.MethodPost, path: "/recipe", contentType: schemaorg.MIMEJSONLD}
+	if _, err := c.doJSON(ctx, r, doc, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateRecipe updates the recipe with the ID of rcp.
+// Drafts are updated in place, and nil is returned. Approved recipes are locked, so the changes are saved as a new draft revision,
+// which is returned.
+// Updates are not retried, since sending an update of an approved recipe twice creates two revisions.
+func (c *Client) UpdateRecipe(ctx context.Context, rcp *recipe.Recipe) (*recipe.Recipe, error) {
+	var revision recipe.Recipe
+	r := &request{method: http.MethodPut, path: recipePath(rcp.ID), noRetry: true}
+	status, err := c.doJSON(ctx, r, newRecipeRequest(rcp), &revision)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusCreated {
+		return nil, nil
+	}
+	return &revision, nil
+}
+
+// TransitionRecipe moves a recipe to another status of its lifecycle, and returns the updated recipe
+func (c *Client) TransitionRecipe(ctx context.Context, id string, status recipe.Status) (*recipe.Recipe, error) {
+	body := struct {
+		Status recipe.Status `json:"status"`
+	}{status}
+	var updated recipe.Recipe
+	if _, err := c.doJSON(ctx, &request{method: http.MethodPost, path: recipePath(id, "status")}, body, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteRecipe moves a recipe to the trash.
+// Deletes are not retried, since sending a delete again after its response was lost reports the recipe as not found.
+func (c *Client) DeleteRecipe(ctx context.Context, id string) error {
+	_, err := c.doJSON(ctx, &request{method: http.MethodDelete, path: recipePath(id), noRetry: true}, nil, nil)
+	return err
+}
+
+// RestoreRecipe takes a recipe out of the trash, and returns the restored recipe
+func (c *Client) RestoreRecipe(ctx context.Context, id string) (*recipe.Recipe, error) {
+	var restored recipe.Recipe
+	if _, err := c.doJSON(ctx, &request{method: http.MethodPost, path: recipePath(id, "restore")}, nil, &restored); err != nil {
+		return nil, err
+	}
+	return &restored, nil
+}
+
+// ListRecipes retrieves a page of at most limit recipes matching the filter in the sort order.
+// Pass the NextCursor of a page, with the same sort, as cursor to get the next page, or an empty cursor for the first page.
+func (c *Client) ListRecipes(ctx context.Context, filter recipe.Filter, sort recipe.Sort, limit int64, cursor string) (*recipe.Page, error) {
+	q := filterQuery(filter)
+	q.Set("limit", strconv.FormatInt(limit, 10))
+	if sort.Field != "" {
+		q.Set("sort", sort.String())
+	}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+	var page recipe.Page
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: "/recipe", query: q}, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Recipes iterates over all recipes matching the filter in the sort order, requesting them in pages of pageSize,
+// or DefaultPageSize if it is 0. Recipes created or deleted during the iteration do not shift the following pages.
+// Iteration stops after the first error.
+func (c *Client) Recipes(ctx context.Context, filter recipe.Filter, sort recipe.Sort, pageSize int64) iter.Seq2[*recipe.Recipe, error] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return func(yield func(*recipe.Recipe, error) bool) {
+		cursor := ""
+		for {
+			page, err := c.ListRecipes(ctx, filter, sort, pageSize, cursor)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, rcp := range page.Recipes {
+				if !yield(rcp, nil) {
+					return
+				}
+			}
+			if page.NextCursor == "" {
+				return
+			}
+			cursor = page.NextCursor
+		}
+	}
+}
+
+// ListTrash retrieves a page of the recipes in the trash, most recently deleted first
+func (c *Client) ListTrash(ctx context.Context, limit, offset int64) ([]*recipe.Recipe, error) {
+	q := url.Values{"limit": {strconv.FormatInt(limit, 10)}, "offset": {strconv.FormatInt(offset, 10)}}
+	var recipes []*recipe.Recipe
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: "/recipe/trash", query: q}, nil, &recipes); err != nil {
+		return nil, err
+	}
+	return recipes, nil
+}
+
+// Trash iterates over all recipes in the trash, requesting them in pages of pageSize, or DefaultPageSize if it is 0.
+// Iteration stops after the first error.
+func (c *Client) Trash(ctx context.Context, pageSize int64) iter.Seq2[*recipe.Recipe, error] {
+	return paginate(pageSize, func(limit, offset int64) ([]*recipe.Recipe, error) {
+		return c.ListTrash(ctx, limit, offset)
+	})
+}
+
+// paginate iterates over the items returned by list a page at a time, until a page is not full.
+// Pages are requested by offset, so items created or deleted during the iteration may be skipped or returned twice.
+func paginate[T any](pageSize int64, list func(limit, offset int64) ([]T, error)) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return func(yield func(T, error) bool) {
+		for offset := int64(0); ; offset += pageSize {
+			page, err := list(pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if int64(len(page)) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// CountRecipes returns the total number of recipes
+func (c *Client) CountRecipes(ctx context.Context) (int64, error) {
+	var body struct {
+		Count int64 `json:"count"`
+	}
+	if _, err := c.doJSON(ctx, &request{method: http.MethodGet, path: "/recipe/count"}, nil, &body); err != nil {
+		return 0, err
+	}
+	return body.Count, nil
+}
+
+// ImportRecipes creates the recipes read from r in the given format, or only validates them with dryRun, and returns the report of every record.
+// When the file cannot be read, the returned *Error carries the report of the records read before.
+// Imports are never retried, since r cannot be read again.
+func (c *Client) ImportRecipes(ctx context.Context, format bulk.Format, r io.Reader, dryRun bool) (*bulk.Report, error) {
+	q := url.Values{"format": {string(format)}, "dry_run": {strconv.FormatBool(dryRun)}}
+	var report bulk.Report
+	req := &request{method: http.MethodPost, path: "/recipe/import", query: q, contentType: format.ContentType(), stream: r}
+	if _, err := c.doJSON(ctx, req, nil, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// ExportRecipes writes every recipe matching the filter to w in the given format
+func (c *Client) ExportRecipes(ctx context.Context, format bulk.Format, filter recipe.Filter, w io.Writer) error {
+	q := filterQuery(filter)
+	q.Set("format", string(format))
+	return c.doStream(ctx, &request{method: http.MethodGet, path: "/recipe/export", query: q, accept: format.ContentType()}, w)
+}
+
+// PurchaseList returns the consolidated list of ingredients to buy for a production plan
+func (c *Client) PurchaseList(ctx context.Context, plan *recipe.ProductionPlan) (*recipe.PurchaseList, error) {
+	type planItem struct {
+		RecipeID string  `json:"recipe_id"`
+		Yield    float64 `json:"yield"`
+	}
+	body := struct {
+		Items     []planItem          `json:"items"`
+		Inventory []recipe.Ingredient `json:"inventory"`
+	}{Inventory: plan.Inventory}
+	for _, item := range plan.Items {
+		body.Items = append(body.Items, planItem{RecipeID: item.RecipeID, Yield: item.Yield})
+	}
+	var list recipe.PurchaseList
+	r := &request{method: http.MethodPost, path: "/recipe/purchase-list", accept: "application/json"}
+	if _, err := c.doJSON(ctx, r, body, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
@@ -0,0 +1,93 @@
+package event
+
+import (
+	"math"
+	"time"
+)
+
+// DeliveryStatus is the state of the delivery of an event to a webhook.
+type DeliveryStatus string
+
+const (
+	Pending   DeliveryStatus = "pending"   // Waiting for its next attempt
+	Succeeded DeliveryStatus = "succeeded" // Accepted by the webhook
+	Failed    DeliveryStatus = "failed"    // Given up after the maximum number of attempts
+)
+
+// Attempt is a single try to deliver an event.
+type Attempt struct {
+	At         time.Time
+	StatusCode int    `json:",omitempty"` // HTTP status returned by the webhook, 0 if there was no response
+	Error      string `json:",omitempty"`
+	Duration   time.Duration
+}
+
+// Delivery is the delivery of an event to a webhook, logging every attempt.
+type Delivery struct {
+	ID            string
+	WebhookID     string
+	Event         *Event
+	Status        DeliveryStatus
+	Attempts      []Attempt
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewDelivery creates a pending delivery of the event to the webhook, due immediately.
+func NewDelivery(webhookID string, e *Event) *Delivery {
+	now := time.Now()
+	return &Delivery{
+		WebhookID:     webhookID,
+		Event:         e,
+		Status:        Pending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// Record logs an attempt. A successful attempt completes the delivery, a failed one schedules
+// the next attempt with the backoff policy, or fails the delivery when no attempts are left.
+func (d *Delivery) Record(a Attempt, succeeded bool, policy RetryPolicy) {
+	d.Attempts = append(d.Attempts, a)
+	d.UpdatedAt = a.At
+	switch {
+	case succeeded:
+		d.Status = Succeeded
+	case len(d.Attempts) >= policy.MaxAttempts:
+		d.Status = Failed
+	default:
+		d.NextAttemptAt = a.At.Add(policy.Delay(len(d.Attempts)))
+	}
+}
+
+// RetryPolicy controls how often and how quickly failed deliveries are retried.
+type RetryPolicy struct {
+	MaxAttempts  int
+	InitialDelay time.Duration // Delay after the first failed attempt
+	MaxDelay     time.Duration
+	Multiplier   float64 // Growth of the delay after every further failed attempt
+}
+
+// DefaultRetryPolicy tries a delivery 8 times over roughly 3 hours.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:  8,
+		InitialDelay: 30 * time.Second,
+		MaxDelay:     time.Hour,
+		Multiplier:   3,
+	}
+}
+
+// Delay returns how long to wait after the given number of failed attempts.
+func (p RetryPolicy) Delay(failures int) time.Duration {
+	if failures < 1 {
+		return 0
+	}
+	delay := float64(p.InitialDelay) * math.Pow(p.Multiplier, float64(failures-1))
+	if delay > float64(p.MaxDelay) {
+		return p.MaxDelay
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,57 @@
+// Package event models the domain events other systems can subscribe to, and their delivery to webhooks.
+package event
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Type is the kind of domain event.
+type Type string
+
+const (
+	RecipeCreated  Type = "recipe.created"
+	RecipeUpdated  Type = "recipe.updated" // Includes lifecycle status changes
+	RecipeDeleted  Type = "recipe.deleted"
+	RecipeRestored Type = "recipe.restored"
+)
+
+// SupportedTypes returns the event types that can be subscribed to.
+func SupportedTypes() []Type {
+	return []Type{RecipeCreated, RecipeUpdated, RecipeDeleted, RecipeRestored}
+}
+
+// Valid reports whether the event type is supported.
+func (t Type) Valid() bool {
+	for _, supported := range SupportedTypes() {
+		if t == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrEventNotFound is returned when reading the events after an event that does not exist
+var ErrEventNotFound = &Error{"event_not_found", "Event not found"}
+
+// Event is a change to a resource that other systems can react to.
+// Data is a JSON snapshot of the resource after the change, or before it for deletions.
+type Event struct {
+	ID         string
+	Type       Type
+	ResourceID string
+	RequestID  string `json:",omitempty"`
+	OccurredAt time.Time
+	Data       json.RawMessage
+}
+
+// New creates an event for a change to a resource.
+func New(t Type, resourceID, requestID string, data json.RawMessage) *Event {
+	return &Event{
+		Type:       t,
+		ResourceID: resourceID,
+		RequestID:  requestID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
@@ -0,0 +1,103 @@
+package event
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		secret  string
+		types   []Type
+		wantErr error
+	}{
+		{"valid", "https://erp.example.com/hooks", "0123456789abcdef", nil, nil},
+		{"subscribed types", "http://localhost:9000", "0123456789abcdef", []Type{RecipeCreated}, nil},
+		{"relative URL", "/hooks", "0123456789abcdef", nil, ErrInvalidURL},
+		{"unsupported scheme", "ftp://example.com", "0123456789abcdef", nil, ErrInvalidURL},
+		{"short secret", "https://example.com", "secret", nil, ErrSecretTooShort},
+		{"unknown type", "https://example.com", "0123456789abcdef", []Type{"recipe.eaten"}, ErrUnknownEventType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := NewWebhook(tt.url, tt.secret, tt.types, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && !w.Active {
+				t.Error("Expected a new webhook to be active")
+			}
+		})
+	}
+}
+
+func TestSubscribed(t *testing.T) {
+	all := &Webhook{Active: true}
+	some := &Webhook{Active: true, EventTypes: []Type{RecipeDeleted}}
+	inactive := &Webhook{}
+
+	if !all.Subscribed(RecipeCreated) {
+		t.Error("Expected a webhook without event types to receive every event")
+	}
+	if some.Subscribed(RecipeCreated) || !some.Subscribed(RecipeDeleted) {
+		t.Error("Expected a webhook with event types to receive only those")
+	}
+	if inactive.Subscribed(RecipeCreated) {
+		t.Error("Expected an inactive webhook to receive no events")
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	secret := "0123456789abcdef"
+	at := time.Unix(1700000000, 0)
+	body := []byte(`{"ID":"1"}`)
+
+	signature := Sign(secret, at, body)
+	if !Verify(secret, "1700000000", signature, body) {
+		t.Error("Expected signature to verify")
+	}
+	if Verify(secret, "1700000001", signature, body) {
+		t.Error("Expected signature for another timestamp to fail")
+	}
+	if Verify(secret, "1700000000", signature, []byte(`{"ID":"2"}`)) {
+		t.Error("Expected signature for another body to fail")
+	}
+	if Verify("another secret!!", "1700000000", signature, body) {
+		t.Error("Expected signature with another secret to fail")
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, InitialDelay: time.Second, MaxDelay: 5 * time.Second, Multiplier: 2}
+	want := []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for failures, expected := range want {
+		if got := p.Delay(failures); got != expected {
+			t.Errorf("Delay(%d): expected %v, got %v", failures, expected, got)
+		}
+	}
+}
+
+func TestDeliveryRecord(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 2, InitialDelay: time.Minute, MaxDelay: time.Hour, Multiplier: 2}
+	d := NewDelivery("w1", New(RecipeCreated, "r1", "", nil))
+	now := time.Now()
+
+	d.Record(Attempt{At: now, StatusCode: 500}, false, p)
+	if d.Status != Pending || !d.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("Expected a retry in a minute, got %s at %v", d.Status, d.NextAttemptAt)
+	}
+	d.Record(Attempt{At: now, Error: "connection refused"}, false, p)
+	if d.Status != Failed || len(d.Attempts) != 2 {
+		t.Errorf("Expected delivery to fail after 2 attempts, got %s after %d", d.Status, len(d.Attempts))
+	}
+
+	ok := NewDelivery("w1", New(RecipeCreated, "r1", "", nil))
+	ok.Record(Attempt{At: now, StatusCode: 204}, true, p)
+	if ok.Status != Succeeded {
+		t.Errorf("Expected delivery to succeed, got %s", ok.Status)
+	}
+}
@@ -0,0 +1,120 @@
+package event
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Error represents an event-specific error
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Error constants for webhooks
+var (
+	ErrInvalidURL       = &Error{"invalid_url", "Webhook URL must be an absolute http or https URL"}
+	ErrSecretTooShort   = &Error{"secret_too_short", "Webhook secret must be at least 16 characters"}
+	ErrUnknownEventType = &Error{"unknown_event_type", "Unknown event type"}
+	ErrWebhookNotFound  = &Error{"webhook_not_found", "Webhook not found"}
+)
+
+// MinSecretLength is the minimum length of a webhook secret
+const MinSecretLength = 16
+
+// Webhook is an endpoint events are delivered to.
+type Webhook struct {
+	ID          string
+	URL         string
+	Secret      string `json:"-"` // Key for the HMAC signature of deliveries, never returned
+	EventTypes  []Type // Event types to deliver, all types when empty
+	Description string
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewWebhook creates an active webhook delivering the event types to the URL.
+func NewWebhook(rawURL, secret string, types []Type, description string) (*Webhook, error) {
+	w := &Webhook{
+		URL:         rawURL,
+		Secret:      secret,
+		EventTypes:  types,
+		Description: description,
+		Active:      true,
+	}
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Validate checks the URL, secret and event types of the webhook.
+func (w *Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	if len(w.Secret) < MinSecretLength {
+		return ErrSecretTooShort
+	}
+	for _, t := range w.EventTypes {
+		if !t.Valid() {
+			return ErrUnknownEventType
+		}
+	}
+	return nil
+}
+
+// Subscribed reports whether events of the type are delivered to the webhook.
+func (w *Webhook) Subscribed(t Type) bool {
+	if !w.Active {
+		return false
+	}
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, subscribed := range w.EventTypes {
+		if subscribed == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Headers sent with every delivery
+const (
+	EventHeader     = "X-Chocolate-Event"     // Event type
+	EventIDHeader   = "X-Chocolate-Event-ID"  // Event ID, to discard duplicate deliveries
+	DeliveryHeader  = "X-Chocolate-Delivery"  // Delivery ID
+	TimestampHeader = "X-Chocolate-Timestamp" // Unix time the delivery was signed at
+	SignatureHeader = "X-Chocolate-Signature" // "sha256=" followed by the hex HMAC of the timestamp, a dot and the body
+)
+
+// Sign returns the signature of a delivery body sent at the given time, for the signature header.
+// Signing the timestamp with the body lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature and timestamp headers of a delivery match its body.
+func Verify(secret, timestamp, signature string, body []byte) bool {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	expected := Sign(secret, time.Unix(unix, 0), body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
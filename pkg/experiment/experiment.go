@@ -0,0 +1,173 @@
+// Package experiment models recipe development experiments: variants of a base recipe made to test a hypothesis.
+package experiment
+
+import (
+	"sort"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+// Error constants for experiments
+var (
+	ErrNameRequired       = &Error{"name_required", "Experiment name is required"}
+	ErrBaseRecipeRequired = &Error{"base_recipe_required", "Experiment base recipe is required"}
+	ErrVariantRequired    = &Error{"variant_recipe_required", "Variant recipe is required"}
+	ErrDuplicateVariant   = &Error{"duplicate_variant", "Recipe is already part of the experiment"}
+	ErrExperimentNotFound = &Error{"experiment_not_found", "Experiment not found"}
+)
+
+// Error represents an experiment-specific error
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Variant is a recipe derived from the base recipe of an experiment.
+type Variant struct {
+	RecipeID          string
+	Label             string            // Short name of the variant, e.g. "B" or "less sugar"
+	ChangedParameters map[string]string // Parameters changed from the base recipe and their value in this variant
+	Notes             string
+}
+
+// Experiment groups variants of a base recipe made to test a hypothesis.
+type Experiment struct {
+	ID           string
+	Name         string
+	BaseRecipeID string
+	Hypothesis   string
+	Variants     []Variant
+	Conclusion   string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewExperiment creates a new Experiment without variants.
+func NewExperiment(name, baseRecipeID, hypothesis string) (*Experiment, error) {
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	if baseRecipeID == "" {
+		return nil, ErrBaseRecipeRequired
+	}
+	return &Experiment{
+		Name:         name,
+		BaseRecipeID: baseRecipeID,
+		Hypothesis:   hypothesis,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}, nil
+}
+
+// AddVariant adds a variant to the experiment. Every recipe can only be part of the experiment once.
+func (e *Experiment) AddVariant(v Variant) error {
+	if v.RecipeID == "" {
+		return ErrVariantRequired
+	}
+	if v.RecipeID == e.BaseRecipeID {
+		return ErrDuplicateVariant
+	}
+	for _, existing := range e.Variants {
+		if existing.RecipeID == v.RecipeID {
+			return ErrDuplicateVariant
+		}
+	}
+	e.Variants = append(e.Variants, v)
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// RecipeIDs returns the IDs of the base recipe followed by the recipes of all variants.
+func (e *Experiment) RecipeIDs() []string {
+	ids := []string{e.BaseRecipeID}
+	for _, v := range e.Variants {
+		ids = append(ids, v.RecipeID)
+	}
+	return ids
+}
+
+// Column holds everything that is compared about a single recipe in the experiment.
+type Column struct {
+	Label             string
+	RecipeID          string
+	Name              string
+	ChangedParameters map[string]string
+	Composition       map[string]float64 // Percentage per ingredient name
+	CacaoPercentage   float64
+	Cost              float64 // Cost of the ingredients of the recipe as written
+	CostPerKg         float64 // Cost of the ingredients per kilogram of yield
+	Losses            []recipe.ProcessLoss
+	Process           *recipe.ProcessProfile
+	Tasting           map[tasting.Attribute]float64 // Mean rating per attribute over all tasting sessions
+	TastingScores     int                           // Number of panel scores the tasting means are based on
+}
+
+// Comparison is a side-by-side comparison of the base recipe and all variants of an experiment.
+type Comparison struct {
+	ExperimentID string
+	Name         string
+	Hypothesis   string
+	Ingredients  []string // All ingredients used by any of the recipes, the rows of the composition table
+	Columns      []Column // The base recipe followed by the variants
+}
+
+// Compare builds a side-by-side comparison of the experiment.
+// The recipes and tasting summaries are keyed by recipe ID; recipes without tasting summary have no tasting scores.
+func Compare(e *Experiment, recipes map[string]*recipe.Recipe, summaries map[string]*tasting.Summary) *Comparison {
+	labels := map[string]string{e.BaseRecipeID: "base"}
+	changes := map[string]map[string]string{}
+	for _, v := range e.Variants {
+		labels[v.RecipeID] = v.Label
+		changes[v.RecipeID] = v.ChangedParameters
+	}
+
+	c := &Comparison{
+		ExperimentID: e.ID,
+		Name:         e.Name,
+		Hypothesis:   e.Hypothesis,
+	}
+	ingredients := map[string]bool{}
+	for _, id := range e.RecipeIDs() {
+		rcp, ok := recipes[id]
+		if !ok {
+			continue
+		}
+		column := Column{
+			Label:             labels[id],
+			RecipeID:          id,
+			Name:              rcp.Name,
+			ChangedParameters: changes[id],
+			Composition:       map[string]float64{},
+			CacaoPercentage:   rcp.CacaoPercentage,
+			Cost:              rcp.Cost(),
+			CostPerKg:         rcp.CostPerKg(),
+			Losses:            rcp.Losses,
+			Process:           rcp.Process,
+		}
+		for _, ing := range rcp.ToTemplate().Ingredients {
+			column.Composition[ing.Name] += ing.Percentage
+			ingredients[ing.Name] = true
+		}
+		if summary, ok := summaries[id]; ok && summary != nil {
+			column.TastingScores = summary.Scores
+			column.Tasting = map[tasting.Attribute]float64{}
+			for _, a := range summary.Attributes {
+				if a.Count > 0 {
+					column.Tasting[a.Attribute] = a.Mean
+				}
+			}
+		}
+		c.Columns = append(c.Columns, column)
+	}
+	for name := range ingredients {
+		c.Ingredients = append(c.Ingredients, name)
+	}
+	sort.Strings(c.Ingredients)
+	return c
+}
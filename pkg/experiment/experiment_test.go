@@ -0,0 +1,77 @@
+package experiment
+
+import (
+	"testing"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+	"github.com/onasunnymorning/go-make-chocolate/pkg/tasting"
+)
+
+func TestAddVariant(t *testing.T) {
+	e, err := NewExperiment("Less sugar", "base", "Less sugar brings out the fruit")
+	if err != nil {
+		t.Fatalf("NewExperiment() error = %v", err)
+	}
+	if err := e.AddVariant(Variant{RecipeID: "b", Label: "B"}); err != nil {
+		t.Errorf("AddVariant() error = %v", err)
+	}
+	if err := e.AddVariant(Variant{RecipeID: "b", Label: "B again"}); err != ErrDuplicateVariant {
+		t.Errorf("AddVariant() error = %v, want %v", err, ErrDuplicateVariant)
+	}
+	if err := e.AddVariant(Variant{RecipeID: "base"}); err != ErrDuplicateVariant {
+		t.Errorf("AddVariant() error = %v, want %v", err, ErrDuplicateVariant)
+	}
+	if err := e.AddVariant(Variant{Label: "C"}); err != ErrVariantRequired {
+		t.Errorf("AddVariant() error = %v, want %v", err, ErrVariantRequired)
+	}
+	if ids := e.RecipeIDs(); len(ids) != 2 || ids[0] != "base" || ids[1] != "b" {
+		t.Errorf("RecipeIDs() = %v", ids)
+	}
+
+	if _, err := NewExperiment("", "base", ""); err != ErrNameRequired {
+		t.Errorf("NewExperiment() error = %v, want %v", err, ErrNameRequired)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	e := &Experiment{ID: "exp", Name: "Less sugar", BaseRecipeID: "base"}
+	_ = e.AddVariant(Variant{RecipeID: "b", Label: "B", ChangedParameters: map[string]string{"sugar": "25%"}})
+
+	recipes := map[string]*recipe.Recipe{
+		"base": {Name: "Dark 70", CacaoPercentage: 70, Ingredients: []recipe.Ingredient{
+			{Name: "Cacao Mass", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}, CostPerKg: 10},
+			{Name: "Sugar", Quantity: recipe.Quantity{Amount: 300, Unit: recipe.Gram}, CostPerKg: 2},
+		}},
+		"b": {Name: "Dark 75", CacaoPercentage: 75, Ingredients: []recipe.Ingredient{
+			{Name: "Cacao Mass", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}, CostPerKg: 10},
+			{Name: "Sugar", Quantity: recipe.Quantity{Amount: 200, Unit: recipe.Gram}, CostPerKg: 2},
+			{Name: "Vanilla", Quantity: recipe.Quantity{Amount: 100, Unit: recipe.Gram}},
+		}},
+	}
+	summaries := map[string]*tasting.Summary{
+		"b": tasting.Summarize([]*tasting.Session{{Scores: []tasting.Score{
+			{Panelist: "ana", Ratings: map[tasting.Attribute]float64{tasting.Fruity: 6}},
+		}}}),
+	}
+
+	c := Compare(e, recipes, summaries)
+	if len(c.Columns) != 2 {
+		t.Fatalf("expected 2 columns, got %d", len(c.Columns))
+	}
+	if want := []string{"Cacao Mass", "Sugar", "Vanilla"}; len(c.Ingredients) != 3 || c.Ingredients[2] != want[2] {
+		t.Errorf("Ingredients = %v, want %v", c.Ingredients, want)
+	}
+	base, variant := c.Columns[0], c.Columns[1]
+	if base.Label != "base" || base.Composition["Sugar"] != 30 || base.Cost != 7.6 {
+		t.Errorf("unexpected base column %+v", base)
+	}
+	if base.Tasting != nil {
+		t.Errorf("expected no tasting scores for the base recipe, got %v", base.Tasting)
+	}
+	if variant.Label != "B" || variant.ChangedParameters["sugar"] != "25%" || variant.Composition["Vanilla"] != 10 {
+		t.Errorf("unexpected variant column %+v", variant)
+	}
+	if variant.Tasting[tasting.Fruity] != 6 || variant.TastingScores != 1 {
+		t.Errorf("unexpected variant tasting %v", variant.Tasting)
+	}
+}
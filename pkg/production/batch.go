@@ -0,0 +1,57 @@
+// Package production models production batches made from recipes.
+package production
+
+import (
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// Error constants for production batches
+var (
+	ErrRecipeRequired = &Error{"recipe_required", "A batch must be made from a recipe"}
+	ErrInvalidYield   = &Error{"invalid_yield", "Batch yield must be greater than zero"}
+	ErrBatchNotFound  = &Error{"batch_not_found", "Batch not found"}
+)
+
+// Error represents a production-specific error
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Batch is a production run of a recipe at a given yield.
+type Batch struct {
+	ID         string
+	RecipeID   string
+	Recipe     *recipe.Recipe // The recipe scaled to the yield of the batch
+	BeanLotIDs []string       // Bean lots used in the batch
+	Notes      string
+	CreatedAt  time.Time
+	CreatedBy  string
+}
+
+// NewBatch creates a new batch of the recipe scaled to the given yield in grams.
+func NewBatch(rcp *recipe.Recipe, yield float64, beanLotIDs []string, notes string) (*Batch, error) {
+	if rcp == nil {
+		return nil, ErrRecipeRequired
+	}
+	if yield <= 0 {
+		return nil, ErrInvalidYield
+	}
+	scaled := rcp.ToTemplate().ToRecipe(yield)
+	if scaled == nil {
+		return nil, ErrInvalidYield
+	}
+	return &Batch{
+		RecipeID:   rcp.ID,
+		Recipe:     scaled,
+		BeanLotIDs: beanLotIDs,
+		Notes:      notes,
+		CreatedAt:  time.Now(),
+	}, nil
+}
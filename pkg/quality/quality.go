@@ -0,0 +1,211 @@
+// Package quality models the inspection of cacao bean lots before they are accepted for production.
+package quality
+
+import (
+	"time"
+)
+
+// Error constants for quality inspection
+var (
+	ErrLotNumberRequired = &Error{"lot_number_required", "Lot number is required"}
+	ErrInvalidSampleSize = &Error{"invalid_sample_size", "Cut test counts must not be negative and must not exceed the sample size"}
+	ErrInvalidMoisture   = &Error{"invalid_moisture", "Moisture must be between 0 and 100 percent"}
+	ErrInvalidThresholds = &Error{"invalid_thresholds", "Grade I limits must not exceed grade II limits"}
+	ErrBeanLotNotFound   = &Error{"bean_lot_not_found", "Bean lot not found"}
+	ErrBeanLotRejected   = &Error{"bean_lot_rejected", "Bean lot is rejected and cannot be used in production"}
+)
+
+// Error represents a quality-specific error
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// DefaultSampleSize is the number of beans cut open in a standard cut test.
+const DefaultSampleSize = 300
+
+// Grade is the quality grade assigned to a bean lot after inspection.
+type Grade string
+
+const (
+	Ungraded Grade = ""         // Not inspected yet
+	GradeI   Grade = "grade_1"  // Good fermented beans
+	GradeII  Grade = "grade_2"  // Fair fermented beans
+	Rejected Grade = "rejected" // Not fit for production
+)
+
+// CutTest holds the results of a cut test: the number of beans in the sample per category.
+type CutTest struct {
+	SampleSize    int // Number of beans cut, DefaultSampleSize if 0
+	FullyBrown    int // Well fermented beans
+	Purple        int // Under fermented beans
+	Slaty         int // Unfermented beans
+	Moldy         int
+	InsectDamaged int
+}
+
+// size returns the sample size of the cut test.
+func (c CutTest) size() int {
+	if c.SampleSize == 0 {
+		return DefaultSampleSize
+	}
+	return c.SampleSize
+}
+
+// Validate checks that the counts are consistent with the sample size.
+func (c CutTest) Validate() error {
+	counts := []int{c.FullyBrown, c.Purple, c.Slaty, c.Moldy, c.InsectDamaged}
+	total := 0
+	for _, n := range counts {
+		if n < 0 {
+			return ErrInvalidSampleSize
+		}
+		total += n
+	}
+	if c.SampleSize < 0 || total > c.size() {
+		return ErrInvalidSampleSize
+	}
+	return nil
+}
+
+// Percentage returns the count as a percentage of the sample size.
+func (c CutTest) Percentage(count int) float64 {
+	return float64(count) / float64(c.size()) * 100
+}
+
+// Limits are the maximum percentages of defective beans allowed for a grade.
+type Limits struct {
+	Moldy         float64
+	Slaty         float64
+	InsectDamaged float64
+}
+
+// Thresholds configure how inspections are graded.
+type Thresholds struct {
+	GradeI      Limits
+	GradeII     Limits
+	MaxMoisture float64 // Maximum moisture percentage, lots above it are rejected
+}
+
+// DefaultThresholds returns thresholds based on the commonly used cocoa grading standards.
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		GradeI:      Limits{Moldy: 3, Slaty: 3, InsectDamaged: 3},
+		GradeII:     Limits{Moldy: 4, Slaty: 8, InsectDamaged: 6},
+		MaxMoisture: 7.5,
+	}
+}
+
+// Validate checks that grade I is at least as strict as grade II.
+func (t Thresholds) Validate() error {
+	if t.GradeI.Moldy > t.GradeII.Moldy || t.GradeI.Slaty > t.GradeII.Slaty || t.GradeI.InsectDamaged > t.GradeII.InsectDamaged {
+		return ErrInvalidThresholds
+	}
+	if t.MaxMoisture <= 0 || t.MaxMoisture > 100 {
+		return ErrInvalidMoisture
+	}
+	return nil
+}
+
+// within reports whether the cut test results fall within the limits.
+func (l Limits) within(c CutTest) bool {
+	return c.Percentage(c.Moldy) <= l.Moldy &&
+		c.Percentage(c.Slaty) <= l.Slaty &&
+		c.Percentage(c.InsectDamaged) <= l.InsectDamaged
+}
+
+// Grade computes the grade of the cut test results and moisture percentage.
+func (t Thresholds) Grade(c CutTest, moisture float64) Grade {
+	switch {
+	case moisture > t.MaxMoisture:
+		return Rejected
+	case t.GradeI.within(c):
+		return GradeI
+	case t.GradeII.within(c):
+		return GradeII
+	default:
+		return Rejected
+	}
+}
+
+// Inspection is the record of a quality inspection of a bean lot.
+type Inspection struct {
+	CutTest     CutTest
+	Moisture    float64 // Moisture percentage
+	Grade       Grade
+	Notes       string
+	InspectedAt time.Time
+	InspectedBy string
+}
+
+// NewInspection validates the results and grades them according to the thresholds.
+func NewInspection(cutTest CutTest, moisture float64, notes, inspectedBy string, thresholds Thresholds) (*Inspection, error) {
+	if err := cutTest.Validate(); err != nil {
+		return nil, err
+	}
+	if moisture < 0 || moisture > 100 {
+		return nil, ErrInvalidMoisture
+	}
+	if cutTest.SampleSize == 0 {
+		cutTest.SampleSize = DefaultSampleSize
+	}
+	return &Inspection{
+		CutTest:     cutTest,
+		Moisture:    moisture,
+		Grade:       thresholds.Grade(cutTest, moisture),
+		Notes:       notes,
+		InspectedAt: time.Now(),
+		InspectedBy: inspectedBy,
+	}, nil
+}
+
+// BeanLot is a delivery of cacao beans, tracked by lot number.
+type BeanLot struct {
+	ID          string
+	LotNumber   string
+	CacaoID     string  // ID of the cacao catalog entry the beans belong to
+	Weight      float64 // Weight of the lot in kilograms
+	ReceivedAt  time.Time
+	Inspections []Inspection
+	Grade       Grade // Grade of the most recent inspection
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewBeanLot creates a new, ungraded bean lot.
+func NewBeanLot(lotNumber, cacaoID string, weight float64, receivedAt time.Time) (*BeanLot, error) {
+	if lotNumber == "" {
+		return nil, ErrLotNumberRequired
+	}
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+	return &BeanLot{
+		LotNumber:  lotNumber,
+		CacaoID:    cacaoID,
+		Weight:     weight,
+		ReceivedAt: receivedAt,
+		Grade:      Ungraded,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}, nil
+}
+
+// AddInspection records an inspection, which determines the grade of the lot.
+func (l *BeanLot) AddInspection(inspection Inspection) {
+	l.Inspections = append(l.Inspections, inspection)
+	l.Grade = inspection.Grade
+	l.UpdatedAt = time.Now()
+}
+
+// UsableInProduction returns ErrBeanLotRejected if the lot has been rejected.
+func (l *BeanLot) UsableInProduction() error {
+	if l.Grade == Rejected {
+		return ErrBeanLotRejected
+	}
+	return nil
+}
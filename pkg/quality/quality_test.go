@@ -0,0 +1,95 @@
+package quality
+
+import "testing"
+
+func TestThresholdsGrade(t *testing.T) {
+	thresholds := DefaultThresholds()
+	tests := []struct {
+		name     string
+		cutTest  CutTest
+		moisture float64
+		want     Grade
+	}{
+		{
+			name:     "Well fermented",
+			cutTest:  CutTest{FullyBrown: 270, Purple: 24, Slaty: 3, Moldy: 3},
+			moisture: 6.5,
+			want:     GradeI,
+		},
+		{
+			name:     "Too many slaty beans for grade I",
+			cutTest:  CutTest{FullyBrown: 250, Purple: 30, Slaty: 18, Moldy: 2},
+			moisture: 7,
+			want:     GradeII,
+		},
+		{
+			name:     "Too many moldy beans",
+			cutTest:  CutTest{FullyBrown: 270, Purple: 15, Moldy: 15},
+			moisture: 6,
+			want:     Rejected,
+		},
+		{
+			name:     "Too wet",
+			cutTest:  CutTest{FullyBrown: 290, Purple: 10},
+			moisture: 9,
+			want:     Rejected,
+		},
+		{
+			name:     "Custom sample size",
+			cutTest:  CutTest{SampleSize: 100, FullyBrown: 90, Slaty: 3, InsectDamaged: 7},
+			moisture: 7,
+			want:     Rejected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := thresholds.Grade(tt.cutTest, tt.moisture); got != tt.want {
+				t.Errorf("Grade() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewInspection(t *testing.T) {
+	thresholds := DefaultThresholds()
+	if _, err := NewInspection(CutTest{FullyBrown: 280, Purple: 30}, 7, "", "", thresholds); err != ErrInvalidSampleSize {
+		t.Errorf("NewInspection() error = %v, want %v", err, ErrInvalidSampleSize)
+	}
+	if _, err := NewInspection(CutTest{FullyBrown: 280}, 120, "", "", thresholds); err != ErrInvalidMoisture {
+		t.Errorf("NewInspection() error = %v, want %v", err, ErrInvalidMoisture)
+	}
+
+	inspection, err := NewInspection(CutTest{FullyBrown: 280, Moldy: 20}, 7, "musty smell", "inspector", thresholds)
+	if err != nil {
+		t.Fatalf("NewInspection() error = %v", err)
+	}
+	if inspection.CutTest.SampleSize != DefaultSampleSize {
+		t.Errorf("expected default sample size %d, got %d", DefaultSampleSize, inspection.CutTest.SampleSize)
+	}
+
+	lot, err := NewBeanLot("LOT-2025-001", "", 640, inspection.InspectedAt)
+	if err != nil {
+		t.Fatalf("NewBeanLot() error = %v", err)
+	}
+	if err := lot.UsableInProduction(); err != nil {
+		t.Errorf("expected an ungraded lot to be usable, got %v", err)
+	}
+	lot.AddInspection(*inspection)
+	if lot.Grade != Rejected {
+		t.Errorf("expected lot to be rejected, got %q", lot.Grade)
+	}
+	if err := lot.UsableInProduction(); err != ErrBeanLotRejected {
+		t.Errorf("UsableInProduction() error = %v, want %v", err, ErrBeanLotRejected)
+	}
+}
+
+func TestThresholdsValidate(t *testing.T) {
+	if err := DefaultThresholds().Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+	thresholds := DefaultThresholds()
+	thresholds.GradeI.Slaty = 10
+	if err := thresholds.Validate(); err != ErrInvalidThresholds {
+		t.Errorf("Validate() error = %v, want %v", err, ErrInvalidThresholds)
+	}
+}
@@ -0,0 +1,106 @@
+package recipe
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Allergen is one of the major food allergens that have to be declared on the label.
+type Allergen string
+
+const (
+	AllergenMilk     Allergen = "milk"
+	AllergenSoy      Allergen = "soy"
+	AllergenTreeNuts Allergen = "tree_nuts"
+	AllergenPeanuts  Allergen = "peanuts"
+	AllergenGluten   Allergen = "gluten"
+	AllergenEggs     Allergen = "eggs"
+	AllergenSesame   Allergen = "sesame"
+)
+
+// allergenRule detects an allergen from the words of an ingredient name.
+type allergenRule struct {
+	allergen Allergen
+	words    []string // Words that indicate the allergen, also matched in their plural form
+	excluded []string // Phrases that contain one of the words without containing the allergen
+}
+
+// allergenRules are checked in order, which is also the order Allergens are returned in.
+var allergenRules = []allergenRule{
+	{AllergenMilk, []string{"milk", "cream", "butter", "buttermilk", "whey", "lactose", "casein", "yogurt", "yoghurt", "cheese", "dairy"},
+		[]string{"cocoa butter", "cacao butter", "coconut milk", "coconut cream", "almond milk", "oat milk", "rice milk", "soy milk", "peanut butter", "shea butter", "nut butter"}},
+	{AllergenSoy, []string{"soy", "soya", "soybean", "edamame", "tofu"}, nil},
+	{AllergenTreeNuts, []string{"almond", "hazelnut", "walnut", "pecan", "cashew", "pistachio", "macadamia", "brazil nut", "nut", "praline", "gianduja", "marzipan", "nougat"}, []string{"nutmeg", "coconut"}},
+	{AllergenPeanuts, []string{"peanut", "groundnut"}, nil},
+	{AllergenGluten, []string{"wheat", "barley", "rye", "oat", "spelt", "malt", "gluten", "biscuit", "cookie", "wafer"}, nil},
+	{AllergenEggs, []string{"egg", "albumen"}, nil},
+	{AllergenSesame, []string{"sesame", "tahini"}, nil},
+}
+
+// Allergens returns the allergens detected in the name of the ingredient, like "Whole milk powder" or "Roasted hazelnuts".
+// Detection is based on the name only, so it supports but does not replace the declarations of the suppliers.
+func (i Ingredient) Allergens() []Allergen {
+	return nameAllergens(i.Name)
+}
+
+// Allergens returns the distinct allergens of the ingredients of the recipe.
+func (r *Recipe) Allergens() []Allergen {
+	found := map[Allergen]bool{}
+	for _, ing := range r.Ingredients {
+		for _, a := range ing.Allergens() {
+			found[a] = true
+		}
+	}
+	allergens := []Allergen{}
+	for _, rule := range allergenRules {
+		if found[rule.allergen] {
+			allergens = append(allergens, rule.allergen)
+		}
+	}
+	return allergens
+}
+
+// Allergens returns the distinct allergens of the ingredients of the template.
+func (tr *TemplateRecipe) Allergens() []Allergen {
+	r := &Recipe{}
+	for _, ing := range tr.Ingredients {
+		r.Ingredients = append(r.Ingredients, Ingredient{Name: ing.Name})
+	}
+	return r.Allergens()
+}
+
+func nameAllergens(name string) []Allergen {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool { return !unicode.IsLetter(r) })
+	text := " " + strings.Join(words, " ") + " "
+	allergens := []Allergen{}
+	for _, rule := range allergenRules {
+		t := text
+		for _, phrase := range rule.excluded {
+			t = replaceWord(t, phrase)
+		}
+		if containsWord(t, rule.words) {
+			allergens = append(allergens, rule.allergen)
+		}
+	}
+	return allergens
+}
+
+// replaceWord blanks out a word or phrase, in singular or plural, on word boundaries of the space padded text.
+func replaceWord(text, phrase string) string {
+	for _, form := range []string{phrase, phrase + "s", phrase + "es"} {
+		text = strings.ReplaceAll(text, " "+form+" ", "  ")
+	}
+	return text
+}
+
+// containsWord reports whether the space padded text contains one of the words or phrases, in singular or plural.
+func containsWord(text string, words []string) bool {
+	for _, w := range words {
+		for _, form := range []string{w, w + "s", w + "es"} {
+			if strings.Contains(text, " "+form+" ") {
+				return true
+			}
+		}
+	}
+	return false
+}
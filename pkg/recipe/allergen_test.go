@@ -0,0 +1,52 @@
+package recipe
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIngredientAllergens(t *testing.T) {
+	tests := []struct {
+		name string
+		want []Allergen
+	}{
+		{"Cacao nibs", []Allergen{}},
+		{"Cocoa butter", []Allergen{}},
+		{"Whole Milk Powder", []Allergen{AllergenMilk}},
+		{"Coconut milk powder", []Allergen{}},
+		{"Butter", []Allergen{AllergenMilk}},
+		{"Soy lecithin", []Allergen{AllergenSoy}},
+		{"Sunflower lecithin", []Allergen{}},
+		{"Roasted hazelnuts", []Allergen{AllergenTreeNuts}},
+		{"Nutmeg", []Allergen{}},
+		{"Peanut butter", []Allergen{AllergenPeanuts}},
+		{"Oat milk powder", []Allergen{AllergenGluten}},
+		{"Goat milk", []Allergen{AllergenMilk}},
+		{"Crushed wafers", []Allergen{AllergenGluten}},
+		{"Almond praline with egg whites", []Allergen{AllergenTreeNuts, AllergenEggs}},
+		{"Black sesame seeds", []Allergen{AllergenSesame}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Ingredient{Name: tt.name}).Allergens(); !slices.Equal(got, tt.want) {
+				t.Errorf("Allergens() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecipeAllergens(t *testing.T) {
+	r := &Recipe{Ingredients: []Ingredient{
+		{Name: "Hazelnut paste"},
+		{Name: "Cacao mass", IsCacao: true},
+		{Name: "Milk powder"},
+		{Name: "Almonds"},
+	}}
+	want := []Allergen{AllergenMilk, AllergenTreeNuts}
+	if got := r.Allergens(); !slices.Equal(got, want) {
+		t.Errorf("Allergens() = %v, want %v", got, want)
+	}
+	if got := r.ToTemplate().Allergens(); !slices.Equal(got, want) {
+		t.Errorf("template Allergens() = %v, want %v", got, want)
+	}
+}
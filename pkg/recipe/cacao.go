@@ -0,0 +1,185 @@
+package recipe
+
+import (
+	"strings"
+	"time"
+)
+
+// Error constants for cacao catalog validation
+var (
+	ErrCacaoNameRequired     = &Error{"cacao_name_required", "Cacao name is required"}
+	ErrOriginCountryRequired = &Error{"origin_country_required", "Origin country is required"}
+	ErrInvalidCertification  = &Error{"invalid_certification", "Unknown certification"}
+	ErrCacaoNotFound         = &Error{"cacao_not_found", "Cacao not found"}
+)
+
+// Certification is a certification held by a cacao producer.
+type Certification string
+
+const (
+	Organic            Certification = "organic"
+	FairTrade          Certification = "fair_trade"
+	RainforestAlliance Certification = "rainforest_alliance"
+	DirectTrade        Certification = "direct_trade"
+)
+
+// SupportedCertifications returns the list of all defined certifications.
+func SupportedCertifications() []Certification {
+	return []Certification{
+		Organic,
+		FairTrade,
+		RainforestAlliance,
+		DirectTrade,
+	}
+}
+
+// Origin describes where and how cacao beans were grown and processed.
+type Origin struct {
+	Country        string
+	Region         string
+	Producer       string // Farm or cooperative
+	Variety        string // e.g. Criollo, Trinitario, Nacional
+	HarvestYear    int
+	Fermentation   string // Fermentation method, e.g. "wooden boxes, 6 days"
+	Drying         string // Drying method, e.g. "sun dried on raised beds"
+	Certifications []Certification
+}
+
+// Validate checks that the origin has a country and only known certifications.
+func (o *Origin) Validate() error {
+	if o.Country == "" {
+		return ErrOriginCountryRequired
+	}
+	for _, c := range o.Certifications {
+		if !isSupportedCertification(c) {
+			return ErrInvalidCertification
+		}
+	}
+	return nil
+}
+
+// HasCertification reports whether the origin holds the given certification.
+func (o *Origin) HasCertification(c Certification) bool {
+	for _, held := range o.Certifications {
+		if held == c {
+			return true
+		}
+	}
+	return false
+}
+
+func isSupportedCertification(c Certification) bool {
+	for _, supported := range SupportedCertifications() {
+		if c == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// Cacao is an entry in the cacao catalog: a kind of cacao beans (or products made from them) with their origin.
+// Ingredients reference catalog entries by ID to carry the origin of the cacao they use.
+type Cacao struct {
+	ID        string
+	Name      string
+	Origin    Origin
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewCacao creates a new cacao catalog entry after validating its origin.
+func NewCacao(name string, origin Origin) (*Cacao, error) {
+	c := &Cacao{
+		Name:      name,
+		Origin:    origin,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Validate checks that the catalog entry has a name and a valid origin.
+func (c *Cacao) Validate() error {
+	if c.Name == "" {
+		return ErrCacaoNameRequired
+	}
+	return c.Origin.Validate()
+}
+
+// Origins returns the distinct origins of the cacao ingredients of the recipe.
+func (r *Recipe) Origins() []Origin {
+	var origins []Origin
+	seen := make(map[string]bool)
+	for _, ing := range r.Ingredients {
+		if !ing.IsCacao || ing.Origin == nil {
+			continue
+		}
+		key := strings.ToLower(ing.Origin.Country + "|" + ing.Origin.Region + "|" + ing.Origin.Producer)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		origins = append(origins, *ing.Origin)
+	}
+	return origins
+}
+
+// SingleOrigin reports whether all cacao in the recipe comes from one country, and returns the origin details all cacao ingredients have in common.
+// Recipes with a cacao ingredient of unknown origin are never single origin.
+func (r *Recipe) SingleOrigin() (Origin, bool) {
+	var common *Origin
+	for _, ing := range r.Ingredients {
+		if !ing.IsCacao {
+			continue
+		}
+		if ing.Origin == nil {
+			return Origin{}, false
+		}
+		if common == nil {
+			o := *ing.Origin
+			o.Certifications = append([]Certification(nil), ing.Origin.Certifications...)
+			common = &o
+			continue
+		}
+		if !strings.EqualFold(common.Country, ing.Origin.Country) {
+			return Origin{}, false
+		}
+		common.intersect(ing.Origin)
+	}
+	if common == nil {
+		return Origin{}, false
+	}
+	return *common, true
+}
+
+// intersect clears every detail of the origin that differs from other.
+func (o *Origin) intersect(other *Origin) {
+	if !strings.EqualFold(o.Region, other.Region) {
+		o.Region = ""
+	}
+	if !strings.EqualFold(o.Producer, other.Producer) {
+		o.Producer = ""
+	}
+	if !strings.EqualFold(o.Variety, other.Variety) {
+		o.Variety = ""
+	}
+	if o.HarvestYear != other.HarvestYear {
+		o.HarvestYear = 0
+	}
+	if o.Fermentation != other.Fermentation {
+		o.Fermentation = ""
+	}
+	if o.Drying != other.Drying {
+		o.Drying = ""
+	}
+	certifications := o.Certifications[:0]
+	for _, c := range o.Certifications {
+		if other.HasCertification(c) {
+			certifications = append(certifications, c)
+		}
+	}
+	o.Certifications = certifications
+}
@@ -0,0 +1,53 @@
+package recipe
+
+import "testing"
+
+func TestOriginValidate(t *testing.T) {
+	if err := (&Origin{Country: "Peru", Certifications: []Certification{Organic}}).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+	if err := (&Origin{}).Validate(); err != ErrOriginCountryRequired {
+		t.Errorf("Validate() error = %v, want %v", err, ErrOriginCountryRequired)
+	}
+	if err := (&Origin{Country: "Peru", Certifications: []Certification{"vegan"}}).Validate(); err != ErrInvalidCertification {
+		t.Errorf("Validate() error = %v, want %v", err, ErrInvalidCertification)
+	}
+}
+
+func TestSingleOrigin(t *testing.T) {
+	piura := &Origin{Country: "Peru", Region: "Piura", Variety: "Nacional", Certifications: []Certification{Organic, FairTrade}}
+	cusco := &Origin{Country: "peru", Region: "Cusco", Variety: "Nacional", Certifications: []Certification{Organic}}
+	ghana := &Origin{Country: "Ghana"}
+
+	r := &Recipe{Ingredients: []Ingredient{
+		{Name: "Piura Beans", IsCacao: true, Origin: piura},
+		{Name: "Cusco Butter", IsCacao: true, Origin: cusco},
+		{Name: "Sugar"},
+	}}
+	origin, ok := r.SingleOrigin()
+	if !ok {
+		t.Fatal("expected recipe to be single origin")
+	}
+	if origin.Country != "Peru" || origin.Region != "" || origin.Variety != "Nacional" {
+		t.Errorf("unexpected common origin %+v", origin)
+	}
+	if len(origin.Certifications) != 1 || origin.Certifications[0] != Organic {
+		t.Errorf("expected only the shared organic certification, got %v", origin.Certifications)
+	}
+	if len(piura.Certifications) != 2 {
+		t.Error("SingleOrigin() modified the origin of an ingredient")
+	}
+	if got := len(r.Origins()); got != 2 {
+		t.Errorf("Origins() returned %d origins, want 2", got)
+	}
+
+	r.Ingredients = append(r.Ingredients, Ingredient{Name: "Ghana Beans", IsCacao: true, Origin: ghana})
+	if _, ok := r.SingleOrigin(); ok {
+		t.Error("expected a blend of Peru and Ghana not to be single origin")
+	}
+
+	r.Ingredients = []Ingredient{{Name: "Unknown Beans", IsCacao: true}, {Name: "Piura Beans", IsCacao: true, Origin: piura}}
+	if _, ok := r.SingleOrigin(); ok {
+		t.Error("expected a recipe with cacao of unknown origin not to be single origin")
+	}
+}
@@ -0,0 +1,77 @@
+package recipe
+
+import "strings"
+
+// ErrInvalidCacaoRange is returned when the cacao percentage range of a filter is not within 0 to 100.
+var ErrInvalidCacaoRange = &Error{"invalid_cacao_range", "Cacao percentages must be between 0 and 100, with the minimum below the maximum"}
+
+// Filter holds the criteria to select recipes by. Empty fields match every recipe.
+type Filter struct {
+	Name          string        // Part of the name of the recipe
+	CreatedBy     string        // User that created the recipe
+	MinCacao      float64       // Minimum cacao percentage
+	MaxCacao      float64       // Maximum cacao percentage, 0 for no maximum
+	Status        Status        // Lifecycle status of the recipe
+	OriginCountry string        // Country of origin of one of the cacao ingredients
+	OriginRegion  string        // Region of origin of one of the cacao ingredients
+	Variety       string        // Cacao variety of one of the cacao ingredients
+	Certification Certification // Certification held by one of the cacao ingredients
+	RevisionOf    string        // Recipe the recipe is a revision of
+}
+
+// Validate checks the status, the certification and the cacao percentage range of the filter.
+func (f Filter) Validate() error {
+	if f.Status != "" && !f.Status.Valid() {
+		return ErrInvalidStatus
+	}
+	if f.Certification != "" && !isSupportedCertification(f.Certification) {
+		return ErrInvalidCertification
+	}
+	if f.MinCacao < 0 || f.MaxCacao < 0 || f.MinCacao > 100 || f.MaxCacao > 100 || (f.MaxCacao > 0 && f.MinCacao > f.MaxCacao) {
+		return ErrInvalidCacaoRange
+	}
+	return nil
+}
+
+// Matches reports whether the recipe meets the criteria of the filter.
+// The name is matched as a case-insensitive substring, recipes without a status are drafts, and origin criteria have
+// to match on the same cacao ingredient and are compared case-insensitively.
+func (f Filter) Matches(r *Recipe) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(r.Name), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.CreatedBy != "" && r.CreatedBy != f.CreatedBy {
+		return false
+	}
+	if f.RevisionOf != "" && r.RevisionOf != f.RevisionOf {
+		return false
+	}
+	if r.CacaoPercentage < f.MinCacao || (f.MaxCacao > 0 && r.CacaoPercentage > f.MaxCacao) {
+		return false
+	}
+	if f.Status != "" {
+		status := r.Status
+		if status == "" {
+			status = Draft
+		}
+		if status != f.Status {
+			return false
+		}
+	}
+	if f.OriginCountry == "" && f.OriginRegion == "" && f.Variety == "" && f.Certification == "" {
+		return true
+	}
+	for _, ing := range r.Ingredients {
+		o := ing.Origin
+		if o == nil {
+			continue
+		}
+		if (f.OriginCountry == "" || strings.EqualFold(o.Country, f.OriginCountry)) &&
+			(f.OriginRegion == "" || strings.EqualFold(o.Region, f.OriginRegion)) &&
+			(f.Variety == "" || strings.EqualFold(o.Variety, f.Variety)) &&
+			(f.Certification == "" || o.HasCertification(f.Certification)) {
+			return true
+		}
+	}
+	return false
+}
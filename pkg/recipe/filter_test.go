@@ -0,0 +1,67 @@
+package recipe
+
+import "testing"
+
+func TestFilterMatches(t *testing.T) {
+	rcp := &Recipe{
+		Name:            "Dark Peru 70",
+		CreatedBy:       "alice",
+		CacaoPercentage: 70,
+		Ingredients: []Ingredient{
+			{Name: "Nibs", IsCacao: true, Origin: &Origin{Country: "Peru", Region: "Piura", Certifications: []Certification{Organic}}},
+			{Name: "Butter", IsCacao: true, Origin: &Origin{Country: "Ghana", Variety: "Amelonado"}},
+			{Name: "Sugar"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"legacy recipes are drafts", Filter{Status: Draft}, true},
+		{"status", Filter{Status: Approved}, false},
+		{"country", Filter{OriginCountry: "peru"}, true},
+		{"region and country on the same ingredient", Filter{OriginCountry: "Peru", OriginRegion: "Piura"}, true},
+		{"criteria on different ingredients", Filter{OriginCountry: "Peru", Variety: "Amelonado"}, false},
+		{"certification", Filter{Certification: Organic}, true},
+		{"missing certification", Filter{OriginCountry: "Ghana", Certification: Organic}, false},
+		{"part of the name", Filter{Name: "peru"}, true},
+		{"other name", Filter{Name: "milk"}, false},
+		{"creator", Filter{CreatedBy: "bob"}, false},
+		{"cacao range", Filter{MinCacao: 60, MaxCacao: 80}, true},
+		{"minimum cacao", Filter{MinCacao: 75}, false},
+		{"maximum cacao", Filter{MaxCacao: 65}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(rcp); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	for _, f := range []Filter{{}, {Status: Draft, Certification: Organic}, {MinCacao: 50, MaxCacao: 100}, {MinCacao: 70}} {
+		if err := f.Validate(); err != nil {
+			t.Errorf("expected %+v to be valid, got %v", f, err)
+		}
+	}
+	tests := []struct {
+		filter Filter
+		want   error
+	}{
+		{Filter{Status: "done"}, ErrInvalidStatus},
+		{Filter{Certification: "vegan"}, ErrInvalidCertification},
+		{Filter{MinCacao: -1}, ErrInvalidCacaoRange},
+		{Filter{MaxCacao: 101}, ErrInvalidCacaoRange},
+		{Filter{MinCacao: 80, MaxCacao: 70}, ErrInvalidCacaoRange},
+	}
+	for _, tt := range tests {
+		if err := tt.filter.Validate(); err != tt.want {
+			t.Errorf("expected %v for %+v, got %v", tt.want, tt.filter, err)
+		}
+	}
+}
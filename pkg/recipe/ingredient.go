@@ -2,7 +2,10 @@ package recipe
 
 // Ingredient represents a single ingredient and its quantity.
 type Ingredient struct {
-	Name     string
-	IsCacao  bool // Indicates if the ingredient is cacao, user for determining cacao percentage
-	Quantity Quantity
+	Name      string
+	IsCacao   bool // Indicates if the ingredient is cacao, user for determining cacao percentage
+	Quantity  Quantity
+	CacaoID   string  // ID of the cacao catalog entry, only for cacao ingredients
+	Origin    *Origin // Origin of the cacao, copied from the cacao catalog entry
+	CostPerKg float64 // Purchase price per kilogram, 0 if unknown
 }
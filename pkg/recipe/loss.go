@@ -0,0 +1,32 @@
+package recipe
+
+// ErrInvalidLoss is returned when a process loss percentage is outside of [0, 100).
+var ErrInvalidLoss = &Error{"invalid_loss", "Process loss percentage must be at least 0 and less than 100"}
+
+// ProcessLoss is the percentage of material lost during a processing stage, e.g. shells removed during winnowing,
+// moisture evaporated during conching or residue left in the melanger.
+// A recipe can declare a loss per stage, or a single overall loss.
+type ProcessLoss struct {
+	Stage      string  // Name of the processing stage, e.g. "winnowing" or "overall"
+	Percentage float64 // Percentage of the stage's input that is lost
+}
+
+// ValidateLosses checks that every loss percentage is at least 0 and less than 100.
+func ValidateLosses(losses []ProcessLoss) error {
+	for _, loss := range losses {
+		if loss.Percentage < 0 || loss.Percentage >= 100 {
+			return ErrInvalidLoss
+		}
+	}
+	return nil
+}
+
+// Retention returns the fraction of the input that ends up in the finished product after all losses.
+// Losses are compounded since every stage processes the output of the previous one.
+func Retention(losses []ProcessLoss) float64 {
+	retention := 1.0
+	for _, loss := range losses {
+		retention *= 1 - loss.Percentage/100
+	}
+	return retention
+}
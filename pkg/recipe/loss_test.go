@@ -0,0 +1,64 @@
+package recipe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRetention(t *testing.T) {
+	losses := []ProcessLoss{
+		{Stage: "winnowing", Percentage: 20},
+		{Stage: "conching", Percentage: 10},
+	}
+	if got, want := Retention(losses), 0.72; math.Abs(got-want) > 1e-9 {
+		t.Errorf("Retention() = %f, want %f", got, want)
+	}
+	if got := Retention(nil); got != 1 {
+		t.Errorf("Retention(nil) = %f, want 1", got)
+	}
+}
+
+func TestValidateLosses(t *testing.T) {
+	if err := ValidateLosses([]ProcessLoss{{Stage: "overall", Percentage: 5}}); err != nil {
+		t.Errorf("ValidateLosses() error = %v, want nil", err)
+	}
+	for _, pct := range []float64{-1, 100, 150} {
+		if err := ValidateLosses([]ProcessLoss{{Stage: "overall", Percentage: pct}}); err != ErrInvalidLoss {
+			t.Errorf("ValidateLosses(%g) error = %v, want %v", pct, err, ErrInvalidLoss)
+		}
+	}
+}
+
+func TestToRecipeWithLosses(t *testing.T) {
+	r := &Recipe{
+		Name: "Dark 70",
+		Ingredients: []Ingredient{
+			{Name: "Cacao Beans", IsCacao: true, Quantity: Quantity{Amount: 700, Unit: Gram}},
+			{Name: "Sugar", Quantity: Quantity{Amount: 300, Unit: Gram}},
+		},
+	}
+	if err := r.SetLosses([]ProcessLoss{{Stage: "overall", Percentage: 20}}); err != nil {
+		t.Fatalf("SetLosses() error = %v", err)
+	}
+	if r.Input.Amount != 1000 || r.Yield.Amount != 800 {
+		t.Errorf("expected input 1000 and yield 800, got input %v and yield %v", r.Input, r.Yield)
+	}
+
+	scaled := r.ToTemplate().ToRecipe(400)
+	if scaled == nil {
+		t.Fatal("ToRecipe() returned nil")
+	}
+	if scaled.Yield.Amount != 400 {
+		t.Errorf("expected net yield 400, got %v", scaled.Yield)
+	}
+	if scaled.Input.Amount != 500 {
+		t.Errorf("expected gross input 500, got %v", scaled.Input)
+	}
+	if got := scaled.Ingredients[0].Quantity.Amount; math.Abs(got-350) > 1e-9 {
+		t.Errorf("expected 350 of cacao beans, got %f", got)
+	}
+
+	if r.ToTemplate().ToRecipe(0) != nil {
+		t.Error("expected ToRecipe(0) to return nil")
+	}
+}
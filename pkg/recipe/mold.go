@@ -0,0 +1,88 @@
+package recipe
+
+import "time"
+
+// Error constants for mold validation
+var (
+	ErrMoldNameRequired    = &Error{"mold_name_required", "Mold name is required"}
+	ErrInvalidMoldType     = &Error{"invalid_mold_type", "Mold type must be either solid or shell"}
+	ErrInvalidCavities     = &Error{"invalid_cavities", "A mold must have at least one cavity"}
+	ErrInvalidCavityWeight = &Error{"invalid_cavity_weight", "Cavity weight must be greater than zero"}
+	ErrInvalidShellWeight  = &Error{"invalid_shell_weight", "Shell weight must be greater than zero and not exceed the cavity weight"}
+	ErrMoldNotFound        = &Error{"mold_not_found", "Mold not found"}
+)
+
+// MoldType indicates whether the cavities of a mold are filled completely or only lined with a chocolate shell.
+type MoldType string
+
+const (
+	SolidMold MoldType = "solid" // Cavities are filled completely, e.g. bars
+	ShellMold MoldType = "shell" // Cavities are lined with a shell and filled with something else, e.g. bonbons
+)
+
+// Mold represents a chocolate mold from the mold catalog.
+type Mold struct {
+	ID           string
+	Name         string
+	Type         MoldType
+	Cavities     int     // Number of cavities in the mold
+	CavityWeight float64 // Weight in grams of chocolate a completely filled cavity holds
+	ShellWeight  float64 // Weight in grams of the chocolate shell of a cavity, only used for shell molds
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewMold creates a new Mold instance after validating its properties.
+func NewMold(name string, moldType MoldType, cavities int, cavityWeight, shellWeight float64) (*Mold, error) {
+	m := &Mold{
+		Name:         name,
+		Type:         moldType,
+		Cavities:     cavities,
+		CavityWeight: cavityWeight,
+		ShellWeight:  shellWeight,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Validate checks that the mold is consistent.
+func (m *Mold) Validate() error {
+	if m.Name == "" {
+		return ErrMoldNameRequired
+	}
+	if m.Type != SolidMold && m.Type != ShellMold {
+		return ErrInvalidMoldType
+	}
+	if m.Cavities < 1 {
+		return ErrInvalidCavities
+	}
+	if m.CavityWeight <= 0 {
+		return ErrInvalidCavityWeight
+	}
+	if m.Type == ShellMold && (m.ShellWeight <= 0 || m.ShellWeight > m.CavityWeight) {
+		return ErrInvalidShellWeight
+	}
+	return nil
+}
+
+// PieceWeight returns the weight in grams of chocolate needed for a single piece.
+func (m *Mold) PieceWeight() float64 {
+	if m.Type == ShellMold {
+		return m.ShellWeight
+	}
+	return m.CavityWeight
+}
+
+// YieldForPieces returns the finished yield in grams needed to produce the given number of pieces.
+func (m *Mold) YieldForPieces(pieces int) float64 {
+	return float64(pieces) * m.PieceWeight()
+}
+
+// YieldForMolds returns the finished yield in grams needed to fill the given number of molds.
+func (m *Mold) YieldForMolds(molds int) float64 {
+	return m.YieldForPieces(molds * m.Cavities)
+}
@@ -0,0 +1,46 @@
+package recipe
+
+import "testing"
+
+func TestNewMold(t *testing.T) {
+	tests := []struct {
+		name         string
+		moldName     string
+		moldType     MoldType
+		cavities     int
+		cavityWeight float64
+		shellWeight  float64
+		wantErr      error
+	}{
+		{name: "Valid solid mold", moldName: "Bar 80g", moldType: SolidMold, cavities: 3, cavityWeight: 80},
+		{name: "Valid shell mold", moldName: "Bonbon", moldType: ShellMold, cavities: 24, cavityWeight: 12, shellWeight: 4},
+		{name: "Missing name", moldType: SolidMold, cavities: 3, cavityWeight: 80, wantErr: ErrMoldNameRequired},
+		{name: "Unknown type", moldName: "Bar", moldType: "hollow", cavities: 3, cavityWeight: 80, wantErr: ErrInvalidMoldType},
+		{name: "No cavities", moldName: "Bar", moldType: SolidMold, cavityWeight: 80, wantErr: ErrInvalidCavities},
+		{name: "No cavity weight", moldName: "Bar", moldType: SolidMold, cavities: 3, wantErr: ErrInvalidCavityWeight},
+		{name: "Shell heavier than cavity", moldName: "Bonbon", moldType: ShellMold, cavities: 24, cavityWeight: 12, shellWeight: 13, wantErr: ErrInvalidShellWeight},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMold(tt.moldName, tt.moldType, tt.cavities, tt.cavityWeight, tt.shellWeight)
+			if err != tt.wantErr {
+				t.Errorf("NewMold() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMoldYield(t *testing.T) {
+	bar := &Mold{Name: "Bar 80g", Type: SolidMold, Cavities: 3, CavityWeight: 80}
+	if got := bar.YieldForMolds(10); got != 2400 {
+		t.Errorf("YieldForMolds(10) = %g, want 2400", got)
+	}
+	if got := bar.YieldForPieces(10); got != 800 {
+		t.Errorf("YieldForPieces(10) = %g, want 800", got)
+	}
+
+	bonbon := &Mold{Name: "Bonbon", Type: ShellMold, Cavities: 24, CavityWeight: 12, ShellWeight: 4}
+	if got := bonbon.YieldForMolds(2); got != 192 {
+		t.Errorf("YieldForMolds(2) = %g, want 192", got)
+	}
+}
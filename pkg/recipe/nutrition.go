@@ -0,0 +1,37 @@
+package recipe
+
+// Error constants for nutrition facts
+var (
+	ErrInvalidNutrition = &Error{"invalid_nutrition", "Nutrition facts must not be negative and must not add up to more than 100 g per 100 g"}
+)
+
+// Nutrition holds the nutrition facts of a recipe per 100 g of finished chocolate,
+// so they do not change when the recipe is scaled.
+type Nutrition struct {
+	Energy       float64 // kcal
+	Fat          float64 // g
+	SaturatedFat float64 // g, part of Fat
+	Carbohydrate float64 // g
+	Sugar        float64 // g, part of Carbohydrate
+	Protein      float64 // g
+	Fiber        float64 // g
+	Sodium       float64 // mg
+}
+
+// Validate checks that no value is negative, that the parts do not exceed their totals,
+// and that fat, carbohydrate and protein fit in 100 g. Fiber is left out of the sum as some
+// labelling conventions count it as part of the carbohydrate.
+func (n *Nutrition) Validate() error {
+	for _, v := range []float64{n.Energy, n.Fat, n.SaturatedFat, n.Carbohydrate, n.Sugar, n.Protein, n.Fiber, n.Sodium} {
+		if v < 0 {
+			return ErrInvalidNutrition
+		}
+	}
+	if n.SaturatedFat > n.Fat || n.Sugar > n.Carbohydrate {
+		return ErrInvalidNutrition
+	}
+	if n.Fat+n.Carbohydrate+n.Protein > 100 {
+		return ErrInvalidNutrition
+	}
+	return nil
+}
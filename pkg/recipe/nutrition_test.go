@@ -0,0 +1,29 @@
+package recipe
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNutritionValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		nutrition Nutrition
+		wantErr   error
+	}{
+		{"dark chocolate", Nutrition{Energy: 598, Fat: 43, SaturatedFat: 25, Carbohydrate: 46, Sugar: 24, Protein: 8, Fiber: 11, Sodium: 20}, nil},
+		{"empty", Nutrition{}, nil},
+		{"negative", Nutrition{Fat: -1}, ErrInvalidNutrition},
+		{"saturated exceeds fat", Nutrition{Fat: 10, SaturatedFat: 11}, ErrInvalidNutrition},
+		{"sugar exceeds carbohydrate", Nutrition{Carbohydrate: 10, Sugar: 11}, ErrInvalidNutrition},
+		{"more than 100 g", Nutrition{Fat: 50, Carbohydrate: 50, Protein: 1}, ErrInvalidNutrition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.nutrition.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
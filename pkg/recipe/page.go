@@ -0,0 +1,167 @@
+package recipe
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Error constants for listing recipes
+var (
+	ErrInvalidSort   = &Error{"invalid_sort", "Sort must be name, created, updated or cacao, prefixed with - for descending order"}
+	ErrInvalidCursor = &Error{"invalid_cursor", "Cursor is not valid for this sort order"}
+	ErrInvalidLimit  = &Error{"invalid_limit", "Limit must be between 1 and 100"}
+)
+
+// MaxPageSize is the largest number of recipes a client can request in one page.
+const MaxPageSize = 100
+
+// SortField is a field recipes can be listed in the order of.
+type SortField string
+
+const (
+	SortByName    SortField = "name"
+	SortByCreated SortField = "created"
+	SortByUpdated SortField = "updated"
+	SortByCacao   SortField = "cacao"
+)
+
+// Sort is the order to list recipes in. Recipes with the same value of the field are ordered by ID.
+type Sort struct {
+	Field      SortField
+	Descending bool
+}
+
+// DefaultSort lists recipes in the order they were created.
+var DefaultSort = Sort{Field: SortByCreated}
+
+// ParseSort parses a sort order such as "name" or "-updated", where a leading - means descending.
+// An empty string is the DefaultSort.
+func ParseSort(s string) (Sort, error) {
+	if s == "" {
+		return DefaultSort, nil
+	}
+	sort := Sort{Field: SortField(strings.TrimPrefix(s, "-")), Descending: strings.HasPrefix(s, "-")}
+	switch sort.Field {
+	case SortByName, SortByCreated, SortByUpdated, SortByCacao:
+		return sort, nil
+	}
+	return Sort{}, ErrInvalidSort
+}
+
+// String returns the sort order in the form ParseSort accepts.
+func (s Sort) String() string {
+	if s.Descending {
+		return "-" + string(s.Field)
+	}
+	return string(s.Field)
+}
+
+// Compare returns -1 if a comes before b in the sort order, 1 if it comes after b and 0 if they are the same recipe.
+func (s Sort) Compare(a, b *Recipe) int {
+	var c int
+	switch s.Field {
+	case SortByName:
+		c = strings.Compare(a.Name, b.Name)
+	case SortByUpdated:
+		c = a.UpdatedAt.Compare(b.UpdatedAt)
+	case SortByCacao:
+		c = cmp.Compare(a.CacaoPercentage, b.CacaoPercentage)
+	default:
+		c = a.CreatedAt.Compare(b.CreatedAt)
+	}
+	if c == 0 {
+		c = strings.Compare(a.ID, b.ID)
+	}
+	if s.Descending {
+		return -c
+	}
+	return c
+}
+
+// Cursor marks the position of a recipe in a sort order, so the next page starts after it even when recipes
+// are added or removed in between. It holds the ID of the recipe and its value of the sort field.
+type Cursor struct {
+	Sort      Sort
+	ID        string
+	Name      string     `json:",omitempty"`
+	CreatedAt *time.Time `json:",omitempty"`
+	UpdatedAt *time.Time `json:",omitempty"`
+	Cacao     float64    `json:",omitempty"`
+}
+
+// NewCursor returns the cursor of the recipe in the sort order.
+func NewCursor(r *Recipe, s Sort) Cursor {
+	c := Cursor{Sort: s, ID: r.ID}
+	switch s.Field {
+	case SortByName:
+		c.Name = r.Name
+	case SortByUpdated:
+		c.UpdatedAt = &r.UpdatedAt
+	case SortByCacao:
+		c.Cacao = r.CacaoPercentage
+	default:
+		c.CreatedAt = &r.CreatedAt
+	}
+	return c
+}
+
+// ParseCursor decodes a cursor returned by Encode, checking that it belongs to the sort order.
+func ParseCursor(s string, sort Sort) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" || c.Sort != sort || !c.hasValue() {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// hasValue reports whether the cursor holds a value for its sort field
+func (c Cursor) hasValue() bool {
+	switch c.Sort.Field {
+	case SortByName, SortByCacao:
+		return true // The name and the cacao percentage of a recipe may be their zero value
+	case SortByUpdated:
+		return c.UpdatedAt != nil
+	default:
+		return c.CreatedAt != nil
+	}
+}
+
+// Encode returns the cursor as an opaque string that is safe to use in URLs.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Recipe returns a recipe with the ID and the sort field of the cursor, to Compare recipes with.
+func (c Cursor) Recipe() *Recipe {
+	r := &Recipe{ID: c.ID, Name: c.Name, CacaoPercentage: c.Cacao}
+	if c.CreatedAt != nil {
+		r.CreatedAt = *c.CreatedAt
+	}
+	if c.UpdatedAt != nil {
+		r.UpdatedAt = *c.UpdatedAt
+	}
+	return r
+}
+
+// ListOptions selects a page of recipes.
+type ListOptions struct {
+	Filter Filter
+	Sort   Sort
+	Limit  int64   // Maximum number of recipes in the page, 0 for all
+	After  *Cursor // Position of the last recipe of the previous page, nil for the first page
+}
+
+// Page is a page of recipes in the requested order.
+type Page struct {
+	Recipes    []*Recipe
+	NextCursor string // Cursor to pass to get the next page, empty on the last page
+	Total      int64  // Number of recipes matching the filter across all pages
+}
@@ -0,0 +1,91 @@
+package recipe
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Sort
+	}{
+		{"", Sort{Field: SortByCreated}},
+		{"name", Sort{Field: SortByName}},
+		{"-updated", Sort{Field: SortByUpdated, Descending: true}},
+		{"-cacao", Sort{Field: SortByCacao, Descending: true}},
+	}
+	for _, tt := range tests {
+		got, err := ParseSort(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseSort(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+		if tt.in != "" && got.String() != tt.in {
+			t.Errorf("expected %q, got %q", tt.in, got.String())
+		}
+	}
+	for _, in := range []string{"price", "--name", "Name"} {
+		if _, err := ParseSort(in); err != ErrInvalidSort {
+			t.Errorf("expected ErrInvalidSort for %q, got %v", in, err)
+		}
+	}
+}
+
+func TestSortCompare(t *testing.T) {
+	now := time.Now()
+	recipes := []*Recipe{
+		{ID: "c", Name: "Milk", CacaoPercentage: 40, CreatedAt: now, UpdatedAt: now},
+		{ID: "a", Name: "Dark", CacaoPercentage: 70, CreatedAt: now.Add(time.Hour), UpdatedAt: now},
+		{ID: "b", Name: "Dark", CacaoPercentage: 85, CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(time.Minute)},
+	}
+	tests := []struct {
+		sort string
+		want []string
+	}{
+		{"created", []string{"b", "c", "a"}},
+		{"name", []string{"a", "b", "c"}},
+		{"-name", []string{"c", "b", "a"}},
+		{"updated", []string{"a", "c", "b"}},
+		{"-cacao", []string{"b", "a", "c"}},
+	}
+	for _, tt := range tests {
+		sort, _ := ParseSort(tt.sort)
+		sorted := slices.Clone(recipes)
+		slices.SortFunc(sorted, sort.Compare)
+		var ids []string
+		for _, r := range sorted {
+			ids = append(ids, r.ID)
+		}
+		if !slices.Equal(ids, tt.want) {
+			t.Errorf("sort %s: expected %v, got %v", tt.sort, tt.want, ids)
+		}
+	}
+}
+
+func TestCursor(t *testing.T) {
+	created := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	r := &Recipe{ID: "65f1c0ffee", Name: "Dark 70", CreatedAt: created, CacaoPercentage: 70}
+	sort := Sort{Field: SortByCreated, Descending: true}
+
+	encoded := NewCursor(r, sort).Encode()
+	c, err := ParseCursor(encoded, sort)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sort.Compare(c.Recipe(), r) != 0 {
+		t.Errorf("expected the cursor to be at the recipe, got %+v", c)
+	}
+	if c.Name != "" || c.Cacao != 0 {
+		t.Errorf("expected only the sort field in the cursor, got %+v", c)
+	}
+
+	if _, err := ParseCursor(encoded, Sort{Field: SortByCreated}); err != ErrInvalidCursor {
+		t.Errorf("expected ErrInvalidCursor for another sort order, got %v", err)
+	}
+	for _, s := range []string{"bogus", "", "e30"} {
+		if _, err := ParseCursor(s, sort); err != ErrInvalidCursor {
+			t.Errorf("expected ErrInvalidCursor for %q, got %v", s, err)
+		}
+	}
+}
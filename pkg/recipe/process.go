@@ -0,0 +1,76 @@
+package recipe
+
+// Error constants for process profile validation
+var (
+	ErrInvalidRoastProfile    = &Error{"invalid_roast_profile", "Roast profile points must have increasing times starting at or after 0 and temperatures between 0 and 300 °C"}
+	ErrInvalidWinnowingRatio  = &Error{"invalid_winnowing_ratio", "Winnowing ratio must be greater than 0 and at most 1"}
+	ErrInvalidRefiningProfile = &Error{"invalid_refining_profile", "Refining duration and target particle size must be greater than zero"}
+	ErrInvalidConchingProfile = &Error{"invalid_conching_profile", "Conching duration must be greater than zero and temperature between 0 and 100 °C"}
+)
+
+// ProcessProfile describes the upstream bean-to-bar process of a recipe.
+// All stages are optional, so recipes made from couverture only need to describe what applies to them.
+type ProcessProfile struct {
+	Roast          *RoastProfile
+	WinnowingRatio float64 // Fraction of the roasted bean weight recovered as nibs, 0 if not applicable
+	Refining       *RefiningProfile
+	Conching       *ConchingProfile
+}
+
+// CurvePoint is a single point of a time/temperature curve.
+type CurvePoint struct {
+	Minute      float64 // Minutes since the start of the stage
+	Temperature float64 // Temperature in degrees Celsius
+}
+
+// RoastProfile is the time/temperature curve of the roast.
+type RoastProfile struct {
+	Points []CurvePoint
+}
+
+// RefiningProfile describes grinding and refining the nibs into chocolate liquor.
+type RefiningProfile struct {
+	DurationHours      float64
+	TargetParticleSize float64 // Target particle size in microns
+}
+
+// ConchingProfile describes conching the refined chocolate.
+type ConchingProfile struct {
+	DurationHours float64
+	Temperature   float64 // Temperature in degrees Celsius
+}
+
+// Duration returns the duration of the roast in minutes.
+func (p *RoastProfile) Duration() float64 {
+	if len(p.Points) == 0 {
+		return 0
+	}
+	return p.Points[len(p.Points)-1].Minute
+}
+
+// Validate checks every stage of the process profile.
+func (p *ProcessProfile) Validate() error {
+	if p.Roast != nil {
+		if len(p.Roast.Points) == 0 {
+			return ErrInvalidRoastProfile
+		}
+		for i, point := range p.Roast.Points {
+			if point.Minute < 0 || point.Temperature <= 0 || point.Temperature > 300 {
+				return ErrInvalidRoastProfile
+			}
+			if i > 0 && point.Minute <= p.Roast.Points[i-1].Minute {
+				return ErrInvalidRoastProfile
+			}
+		}
+	}
+	if p.WinnowingRatio < 0 || p.WinnowingRatio > 1 {
+		return ErrInvalidWinnowingRatio
+	}
+	if p.Refining != nil && (p.Refining.DurationHours <= 0 || p.Refining.TargetParticleSize <= 0) {
+		return ErrInvalidRefiningProfile
+	}
+	if p.Conching != nil && (p.Conching.DurationHours <= 0 || p.Conching.Temperature <= 0 || p.Conching.Temperature > 100) {
+		return ErrInvalidConchingProfile
+	}
+	return nil
+}
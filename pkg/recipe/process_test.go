@@ -0,0 +1,50 @@
+package recipe
+
+import "testing"
+
+func TestProcessProfileValidate(t *testing.T) {
+	valid := func() *ProcessProfile {
+		return &ProcessProfile{
+			Roast: &RoastProfile{Points: []CurvePoint{
+				{Minute: 0, Temperature: 150},
+				{Minute: 10, Temperature: 130},
+				{Minute: 25, Temperature: 120},
+			}},
+			WinnowingRatio: 0.8,
+			Refining:       &RefiningProfile{DurationHours: 48, TargetParticleSize: 20},
+			Conching:       &ConchingProfile{DurationHours: 24, Temperature: 60},
+		}
+	}
+
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+	if got := valid().Roast.Duration(); got != 25 {
+		t.Errorf("Duration() = %g, want 25", got)
+	}
+	if err := (&ProcessProfile{}).Validate(); err != nil {
+		t.Errorf("Validate() on empty profile error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(p *ProcessProfile)
+		wantErr error
+	}{
+		{"Roast times not increasing", func(p *ProcessProfile) { p.Roast.Points[2].Minute = 10 }, ErrInvalidRoastProfile},
+		{"Roast too hot", func(p *ProcessProfile) { p.Roast.Points[0].Temperature = 400 }, ErrInvalidRoastProfile},
+		{"Empty roast", func(p *ProcessProfile) { p.Roast.Points = nil }, ErrInvalidRoastProfile},
+		{"Winnowing ratio above 1", func(p *ProcessProfile) { p.WinnowingRatio = 1.2 }, ErrInvalidWinnowingRatio},
+		{"Missing particle size", func(p *ProcessProfile) { p.Refining.TargetParticleSize = 0 }, ErrInvalidRefiningProfile},
+		{"Conching too hot", func(p *ProcessProfile) { p.Conching.Temperature = 120 }, ErrInvalidConchingProfile},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.mutate(p)
+			if err := p.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
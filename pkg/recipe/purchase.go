@@ -0,0 +1,153 @@
+package recipe
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PlanItem is a single entry in a ProductionPlan: a recipe and the yield in grams to produce.
+type PlanItem struct {
+	RecipeID string
+	Yield    float64
+}
+
+// ProductionPlan lists the recipes to produce in a period together with the ingredients already on hand.
+type ProductionPlan struct {
+	Items     []PlanItem
+	Inventory []Ingredient // Ingredients currently in stock
+}
+
+// PurchaseItem is a single line on a PurchaseList.
+type PurchaseItem struct {
+	Name     string
+	IsCacao  bool
+	Required Quantity // Total quantity needed by the plan
+	OnHand   Quantity // Quantity already in stock
+	ToBuy    Quantity // Quantity to purchase, never negative
+}
+
+// PurchaseList is the consolidated list of ingredients to buy for a ProductionPlan.
+type PurchaseList struct {
+	Items []PurchaseItem
+}
+
+// ingredientKey is used to match identical ingredients across recipes.
+func ingredientKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// MergeIngredients combines identical ingredients (matched case-insensitively by name) across the given recipes.
+// Quantities are normalized to the base unit of their dimension before being summed.
+// It returns ErrIncompatibleUnits if the same ingredient is measured in units that cannot be converted into each other.
+func MergeIngredients(recipes ...*Recipe) ([]Ingredient, error) {
+	var all []Ingredient
+	for _, r := range recipes {
+		all = append(all, r.Ingredients...)
+	}
+	return mergeIngredients(all)
+}
+
+func mergeIngredients(ingredients []Ingredient) ([]Ingredient, error) {
+	merged := make([]Ingredient, 0, len(ingredients))
+	index := make(map[string]int)
+	for _, ing := range ingredients {
+		q, err := ing.Quantity.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		key := ingredientKey(ing.Name)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, Ingredient{Name: ing.Name, IsCacao: ing.IsCacao, Quantity: q})
+			continue
+		}
+		if merged[i].Quantity.Unit != q.Unit {
+			return nil, ErrIncompatibleUnits
+		}
+		merged[i].Quantity.Amount += q.Amount
+		merged[i].IsCacao = merged[i].IsCacao || ing.IsCacao
+	}
+	return merged, nil
+}
+
+// NewPurchaseList subtracts the on-hand inventory from the required ingredients and returns the resulting purchase list, sorted by ingredient name.
+// Inventory for ingredients that are not required is ignored.
+func NewPurchaseList(required, onHand []Ingredient) (*PurchaseList, error) {
+	need, err := mergeIngredients(required)
+	if err != nil {
+		return nil, err
+	}
+	// Only inventory of required ingredients is merged, so stock that can not be merged but is not needed is no error
+	needed := make(map[string]bool, len(need))
+	for _, ing := range need {
+		needed[ingredientKey(ing.Name)] = true
+	}
+	relevant := make([]Ingredient, 0, len(onHand))
+	for _, ing := range onHand {
+		if needed[ingredientKey(ing.Name)] {
+			relevant = append(relevant, ing)
+		}
+	}
+	stock, err := mergeIngredients(relevant)
+	if err != nil {
+		return nil, err
+	}
+	inStock := make(map[string]Quantity, len(stock))
+	for _, ing := range stock {
+		inStock[ingredientKey(ing.Name)] = ing.Quantity
+	}
+
+	items := make([]PurchaseItem, len(need))
+	for i, ing := range need {
+		available := Quantity{Amount: 0, Unit: ing.Quantity.Unit}
+		if q, ok := inStock[ingredientKey(ing.Name)]; ok {
+			if q.Unit != ing.Quantity.Unit {
+				return nil, ErrIncompatibleUnits
+			}
+			available = q
+		}
+		toBuy := ing.Quantity.Amount - available.Amount
+		if toBuy < 0 {
+			toBuy = 0
+		}
+		items[i] = PurchaseItem{
+			Name:     ing.Name,
+			IsCacao:  ing.IsCacao,
+			Required: ing.Quantity,
+			OnHand:   available,
+			ToBuy:    Quantity{Amount: toBuy, Unit: ing.Quantity.Unit},
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return ingredientKey(items[i].Name) < ingredientKey(items[j].Name)
+	})
+
+	return &PurchaseList{Items: items}, nil
+}
+
+// WriteCSV writes the purchase list as CSV with a header row.
+func (p *PurchaseList) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"name", "is_cacao", "required", "on_hand", "to_buy", "unit"}); err != nil {
+		return err
+	}
+	for _, item := range p.Items {
+		record := []string{
+			item.Name,
+			strconv.FormatBool(item.IsCacao),
+			strconv.FormatFloat(item.Required.Amount, 'f', -1, 64),
+			strconv.FormatFloat(item.OnHand.Amount, 'f', -1, 64),
+			strconv.FormatFloat(item.ToBuy.Amount, 'f', -1, 64),
+			string(item.Required.Unit),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
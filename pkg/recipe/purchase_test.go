@@ -0,0 +1,80 @@
+package recipe
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestMergeIngredients(t *testing.T) {
+	dark := &Recipe{Ingredients: []Ingredient{
+		{Name: "Cacao Mass", IsCacao: true, Quantity: Quantity{Amount: 700, Unit: "grams"}},
+		{Name: "Sugar", Quantity: Quantity{Amount: 300, Unit: Gram}},
+	}}
+	milk := &Recipe{Ingredients: []Ingredient{
+		{Name: "cacao mass", IsCacao: true, Quantity: Quantity{Amount: 0.4, Unit: Kilogram}},
+		{Name: "Sugar ", Quantity: Quantity{Amount: 350, Unit: Gram}},
+		{Name: "Milk Powder", Quantity: Quantity{Amount: 250, Unit: Gram}},
+	}}
+
+	merged, err := MergeIngredients(dark, milk)
+	if err != nil {
+		t.Fatalf("MergeIngredients() error = %v", err)
+	}
+	want := map[string]float64{"Cacao Mass": 1100, "Sugar": 650, "Milk Powder": 250}
+	if len(merged) != len(want) {
+		t.Fatalf("MergeIngredients() returned %d ingredients, want %d", len(merged), len(want))
+	}
+	for _, ing := range merged {
+		if ing.Quantity.Amount != want[ing.Name] || ing.Quantity.Unit != Gram {
+			t.Errorf("%s: got %v, want %g g", ing.Name, ing.Quantity, want[ing.Name])
+		}
+	}
+
+	liquid := &Recipe{Ingredients: []Ingredient{{Name: "Sugar", Quantity: Quantity{Amount: 1, Unit: Liter}}}}
+	if _, err := MergeIngredients(dark, liquid); err != ErrIncompatibleUnits {
+		t.Errorf("MergeIngredients() error = %v, want %v", err, ErrIncompatibleUnits)
+	}
+}
+
+func TestNewPurchaseList(t *testing.T) {
+	required := []Ingredient{
+		{Name: "Sugar", Quantity: Quantity{Amount: 2, Unit: Kilogram}},
+		{Name: "Cacao Mass", IsCacao: true, Quantity: Quantity{Amount: 500, Unit: Gram}},
+	}
+	onHand := []Ingredient{
+		{Name: "sugar", Quantity: Quantity{Amount: 500, Unit: Gram}},
+		{Name: "Cacao Mass", Quantity: Quantity{Amount: 1, Unit: Kilogram}},
+		{Name: "Vanilla", Quantity: Quantity{Amount: 10, Unit: Gram}},
+		// Not needed, so measuring it in incompatible units is no error
+		{Name: "Vanilla", Quantity: Quantity{Amount: 1, Unit: Liter}},
+	}
+
+	list, err := NewPurchaseList(required, onHand)
+	if err != nil {
+		t.Fatalf("NewPurchaseList() error = %v", err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("NewPurchaseList() returned %d items, want 2", len(list.Items))
+	}
+	// Items are sorted by name
+	cacao, sugar := list.Items[0], list.Items[1]
+	if cacao.Name != "Cacao Mass" || cacao.ToBuy.Amount != 0 || cacao.OnHand.Amount != 1000 {
+		t.Errorf("unexpected cacao item %+v", cacao)
+	}
+	if sugar.Name != "Sugar" || sugar.Required.Amount != 2000 || sugar.ToBuy.Amount != 1500 {
+		t.Errorf("unexpected sugar item %+v", sugar)
+	}
+
+	var buf bytes.Buffer
+	if err := list.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("WriteCSV() wrote %d lines, want 3", len(lines))
+	}
+	if lines[2] != "Sugar,false,2000,500,1500,g" {
+		t.Errorf("WriteCSV() line = %q", lines[2])
+	}
+}
@@ -16,6 +16,48 @@ func (q Quantity) String() string {
 	return fmt.Sprintf("%g %s", q.Amount, q.Unit)
 }
 
+// ConvertTo returns the quantity expressed in the given unit.
+// It returns ErrUnknownUnit if either unit is not known and ErrIncompatibleUnits if the units measure different things (e.g. mass and volume).
+func (q Quantity) ConvertTo(u Unit) (Quantity, error) {
+	from, ok := units[q.Unit.Normalize()]
+	if !ok {
+		return Quantity{}, ErrUnknownUnit
+	}
+	to, ok := units[u.Normalize()]
+	if !ok {
+		return Quantity{}, ErrUnknownUnit
+	}
+	if from.dimension != to.dimension {
+		return Quantity{}, ErrIncompatibleUnits
+	}
+	return Quantity{
+		Amount: q.Amount * from.factor / to.factor,
+		Unit:   u.Normalize(),
+	}, nil
+}
+
+// Normalize returns the quantity expressed in the base unit of its dimension.
+func (q Quantity) Normalize() (Quantity, error) {
+	base, err := q.Unit.Base()
+	if err != nil {
+		return Quantity{}, err
+	}
+	return q.ConvertTo(base)
+}
+
+// grams returns the amount of the quantity in grams.
+// It returns ErrUnknownUnit if the unit is not known and ErrMassUnitRequired if it is not a unit of mass.
+func (q Quantity) grams() (float64, error) {
+	g, err := q.ConvertTo(Gram)
+	if err == ErrIncompatibleUnits {
+		return 0, ErrMassUnitRequired
+	}
+	if err != nil {
+		return 0, err
+	}
+	return g.Amount, nil
+}
+
 // ParseQuantity creates a Quantity from a string like "1.5 kg".
 func ParseQuantity(s string) (Quantity, error) {
 	parts := strings.Fields(s)
@@ -35,5 +77,9 @@ func ParseQuantity(s string) (Quantity, error) {
 func SupportedUnits() []Unit {
 	return []Unit{
 		Gram,
+		Kilogram,
+		Milligram,
+		Milliliter,
+		Liter,
 	}
 }
@@ -59,6 +59,10 @@ func TestSupportedUnits(t *testing.T) {
 	units := SupportedUnits()
 	expected := []Unit{
 		Gram,
+		Kilogram,
+		Milligram,
+		Milliliter,
+		Liter,
 	}
 	if len(units) != len(expected) {
 		t.Errorf("SupportedUnits() returned %d units, want %d", len(units), len(expected))
@@ -69,3 +73,55 @@ func TestSupportedUnits(t *testing.T) {
 		}
 	}
 }
+
+func TestQuantityConvertTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   Quantity
+		unit    Unit
+		want    Quantity
+		wantErr error
+	}{
+		{
+			name:  "Kilograms to grams",
+			input: Quantity{Amount: 1.5, Unit: Kilogram},
+			unit:  Gram,
+			want:  Quantity{Amount: 1500, Unit: Gram},
+		},
+		{
+			name:  "Grams alias to kilograms",
+			input: Quantity{Amount: 250, Unit: "grams"},
+			unit:  Kilogram,
+			want:  Quantity{Amount: 0.25, Unit: Kilogram},
+		},
+		{
+			name:  "Liters to milliliters",
+			input: Quantity{Amount: 2, Unit: Liter},
+			unit:  Milliliter,
+			want:  Quantity{Amount: 2000, Unit: Milliliter},
+		},
+		{
+			name:    "Mass to volume",
+			input:   Quantity{Amount: 1, Unit: Gram},
+			unit:    Liter,
+			wantErr: ErrIncompatibleUnits,
+		},
+		{
+			name:    "Unknown unit",
+			input:   Quantity{Amount: 1, Unit: "cup"},
+			unit:    Gram,
+			wantErr: ErrUnknownUnit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.input.ConvertTo(tt.unit)
+			if err != tt.wantErr {
+				t.Fatalf("ConvertTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (got.Amount != tt.want.Amount || got.Unit != tt.want.Unit) {
+				t.Errorf("ConvertTo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,9 @@ var (
 	ErrNameRequired         = &Error{"name_required", "Recipe name is required"}
 	ErrIngredientsRequired  = &Error{"ingredients_required", "At least one ingredient is required"}
 	ErrInstructionsRequired = &Error{"instructions_required", "Recipe instructions are required"}
+	ErrRecipeNotFound       = &Error{"recipe_not_found", "Recipe not found"}
+	ErrInvalidYield         = &Error{"invalid_yield", "Yield must be greater than zero"}
+	ErrMassUnitRequired     = &Error{"mass_unit_required", "Ingredient quantities must be in a unit of mass"}
 )
 
 // Error represents a recipe-specific error
@@ -31,7 +34,20 @@ type Recipe struct {
 	CreatedBy       string
 	UpdatedBy       string
 	CacaoPercentage float64  // Cacao percentage of the recipe, calculated from ingredients
-	Yield           Quantity // Batch size or yield of the recipe
+	Yield           Quantity // Batch size or yield of the recipe, net of process losses
+	Input           Quantity // Total gross input of the recipe before process losses
+	Losses          []ProcessLoss
+	Classification  Classification  // Type of chocolate, inferred from the ingredients when empty
+	Tempering       *TemperingCurve // Recipe specific overrides of the default tempering curve
+	Process         *ProcessProfile // How the chocolate is made, for bean-to-bar recipes
+	Nutrition       *Nutrition      // Nutrition facts per 100 g
+	Status          Status          // Lifecycle status of the recipe
+	Revision        int             // Revision number, starting at 1
+	RevisionOf      string          // ID of the recipe this revision was derived from
+	ApprovedBy      string
+	ApprovedAt      *time.Time
+	DeletedAt       *time.Time // Set when the recipe is moved to the trash
+	DeletedBy       string
 }
 
 // NewRecipe creates a new Recipe instance with the provided name, description, and ingredients. Cacao percentage is calculated automatically.
@@ -50,19 +66,49 @@ func NewRecipe(name, description string, ingredients []Ingredient, instructions
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 		Instructions: instructions,
+		Status:       Draft,
+		Revision:     1,
+	}
+	if err := rcp.ValidateQuantities(); err != nil {
+		return nil, err
 	}
 
 	rcp.CacaoPercentage = rcp.CalculateCacaoPercentage()
 	rcp.Yield = rcp.CalculateYield()
+	rcp.Input = rcp.CalculateInput()
 
 	return rcp, nil
 }
 
-// CalculateYield calculates the yield in grams of the recipe based on its ingredients.
-func (r *Recipe) CalculateYield() Quantity {
+// SetLosses validates and sets the process losses of the recipe and recalculates its yield.
+func (r *Recipe) SetLosses(losses []ProcessLoss) error {
+	if err := ValidateLosses(losses); err != nil {
+		return err
+	}
+	r.Losses = losses
+	r.Yield = r.CalculateYield()
+	r.Input = r.CalculateInput()
+	return nil
+}
+
+// ValidateQuantities checks that the quantity of every ingredient can be converted to grams,
+// since the input, yield and percentages of the recipe are calculated by mass.
+func (r *Recipe) ValidateQuantities() error {
+	for _, ingredient := range r.Ingredients {
+		if _, err := ingredient.Quantity.grams(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CalculateInput calculates the gross input in grams of the recipe based on its ingredients.
+// Ingredients whose quantity can not be converted to grams are not included.
+func (r *Recipe) CalculateInput() Quantity {
 	var totalQuantity float64
 	for _, ingredient := range r.Ingredients {
-		totalQuantity += ingredient.Quantity.Amount
+		grams, _ := ingredient.Quantity.grams()
+		totalQuantity += grams
 	}
 	return Quantity{
 		Unit:   "grams",
@@ -70,13 +116,21 @@ func (r *Recipe) CalculateYield() Quantity {
 	}
 }
 
-// calculateCacaoPercentage calculates the cacao percentage of the recipe based on its ingredients.
+// CalculateYield calculates the yield in grams of the recipe based on its ingredients, net of process losses.
+func (r *Recipe) CalculateYield() Quantity {
+	input := r.CalculateInput()
+	input.Amount *= Retention(r.Losses)
+	return input
+}
+
+// calculateCacaoPercentage calculates the cacao percentage by mass of the recipe based on its ingredients.
 func (r *Recipe) CalculateCacaoPercentage() float64 {
 	var totalQuantity, cacaoQuantity float64
 	for _, ingredient := range r.Ingredients {
-		totalQuantity += ingredient.Quantity.Amount
+		grams, _ := ingredient.Quantity.grams()
+		totalQuantity += grams
 		if ingredient.IsCacao {
-			cacaoQuantity += ingredient.Quantity.Amount
+			cacaoQuantity += grams
 		}
 	}
 	if totalQuantity == 0 {
@@ -85,23 +139,54 @@ func (r *Recipe) CalculateCacaoPercentage() float64 {
 	return (cacaoQuantity / totalQuantity) * 100
 }
 
+// Cost calculates the cost of the ingredients of the recipe from their price per kilogram.
+// Ingredients without a price, or measured by volume, are not included.
+func (r *Recipe) Cost() float64 {
+	var cost float64
+	for _, ingredient := range r.Ingredients {
+		if ingredient.CostPerKg == 0 {
+			continue
+		}
+		q, err := ingredient.Quantity.ConvertTo(Kilogram)
+		if err != nil {
+			continue
+		}
+		cost += q.Amount * ingredient.CostPerKg
+	}
+	return cost
+}
+
+// CostPerKg calculates the cost of the ingredients per kilogram of finished yield.
+func (r *Recipe) CostPerKg() float64 {
+	yield, err := r.CalculateYield().ConvertTo(Kilogram)
+	if err != nil || yield.Amount == 0 {
+		return 0
+	}
+	return r.Cost() / yield.Amount
+}
+
 // ToTemplate converts the Recipe to a TemplateRecipe, which is used for creating new recipes based on templates.
-// It calculates the percentages of each ingredient and returns a TemplateRecipe instance.
+// It calculates the percentages of each ingredient by mass, whatever units their quantities are in, and returns a TemplateRecipe instance.
 func (r *Recipe) ToTemplate() *TemplateRecipe {
+	grams := make([]float64, len(r.Ingredients))
 	totalQuantity := 0.0
-	for _, ingredient := range r.Ingredients {
-		totalQuantity += ingredient.Quantity.Amount
+	for i, ingredient := range r.Ingredients {
+		grams[i], _ = ingredient.Quantity.grams()
+		totalQuantity += grams[i]
 	}
 	ingredients := make([]TemplateIngredient, len(r.Ingredients))
 	for i, ingredient := range r.Ingredients {
 		percentage := 0.0
 		if totalQuantity > 0 {
-			percentage = (ingredient.Quantity.Amount / totalQuantity) * 100
+			percentage = (grams[i] / totalQuantity) * 100
 		}
 		ingredients[i] = TemplateIngredient{
 			Name:       ingredient.Name,
 			IsCacao:    ingredient.IsCacao,
 			Percentage: percentage,
+			CacaoID:    ingredient.CacaoID,
+			Origin:     ingredient.Origin,
+			CostPerKg:  ingredient.CostPerKg,
 		}
 	}
 	return &TemplateRecipe{
@@ -111,6 +196,11 @@ func (r *Recipe) ToTemplate() *TemplateRecipe {
 		Ingredients:     ingredients,
 		CacaoPercentage: r.CacaoPercentage,
 		Instructions:    r.Instructions,
+		Losses:          r.Losses,
+		Classification:  r.Classification,
+		Tempering:       r.Tempering,
+		Process:         r.Process,
+		Nutrition:       r.Nutrition,
 	}
 
 }
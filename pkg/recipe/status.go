@@ -0,0 +1,80 @@
+package recipe
+
+// Error constants for the recipe lifecycle
+var (
+	ErrInvalidStatus     = &Error{"invalid_status", "Status must be draft, in_review, approved or archived"}
+	ErrInvalidTransition = &Error{"invalid_transition", "Recipe cannot move from its current status to the requested status"}
+	ErrReviewerRequired  = &Error{"reviewer_required", "Only reviewers can approve or reject recipes"}
+	ErrRecipeArchived    = &Error{"recipe_archived", "Archived recipes cannot be edited"}
+	ErrRecipeNotApproved = &Error{"recipe_not_approved", "Only approved recipes can be used for production batches"}
+)
+
+// Status is the lifecycle status of a recipe.
+type Status string
+
+const (
+	Draft    Status = "draft"     // Being worked on, can be edited freely
+	InReview Status = "in_review" // Submitted for approval by a reviewer
+	Approved Status = "approved"  // Approved for production, locked against edits
+	Archived Status = "archived"  // No longer in use
+)
+
+// transitions lists the statuses a recipe can move to from each status.
+var transitions = map[Status][]Status{
+	Draft:    {InReview, Archived},
+	InReview: {Approved, Draft, Archived},
+	Approved: {Archived},
+	Archived: {Draft},
+}
+
+// Valid reports whether the status is a known status.
+func (s Status) Valid() bool {
+	_, ok := transitions[s]
+	return ok
+}
+
+// CanTransition reports whether a recipe can move from one status to another.
+func CanTransition(from, to Status) bool {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresReviewer reports whether moving from one status to another has to be done by a reviewer.
+// Approving a recipe, and rejecting it by sending it back to draft, are reserved for reviewers.
+func RequiresReviewer(from, to Status) bool {
+	return from == InReview && (to == Approved || to == Draft)
+}
+
+// Transition moves the recipe to the status, returning ErrInvalidTransition if that is not allowed from its current status.
+func (r *Recipe) Transition(to Status) error {
+	if !to.Valid() {
+		return ErrInvalidStatus
+	}
+	if !CanTransition(r.Status, to) {
+		return ErrInvalidTransition
+	}
+	r.Status = to
+	return nil
+}
+
+// Locked reports whether the recipe can no longer be edited in place.
+// Changes to an approved recipe are made in a new draft revision instead.
+func (r *Recipe) Locked() bool {
+	return r.Status == Approved || r.Status == Archived
+}
+
+// NewRevision returns a draft copy of the recipe to make changes to, linked to the recipe it was derived from.
+func (r *Recipe) NewRevision() *Recipe {
+	revision := *r
+	revision.ID = ""
+	revision.Status = Draft
+	revision.RevisionOf = r.ID
+	revision.Revision = r.Revision + 1
+	revision.ApprovedBy = ""
+	revision.ApprovedAt = nil
+	return &revision
+}
@@ -0,0 +1,74 @@
+package recipe
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		from, to Status
+		wantErr  error
+	}{
+		{Draft, InReview, nil},
+		{InReview, Approved, nil},
+		{InReview, Draft, nil},
+		{Approved, Archived, nil},
+		{Archived, Draft, nil},
+		{Draft, Approved, ErrInvalidTransition},
+		{Approved, Draft, ErrInvalidTransition},
+		{Approved, InReview, ErrInvalidTransition},
+		{Draft, "published", ErrInvalidStatus},
+	}
+
+	for _, tt := range tests {
+		r := &Recipe{Status: tt.from}
+		err := r.Transition(tt.to)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s -> %s: expected error %v, got %v", tt.from, tt.to, tt.wantErr, err)
+			continue
+		}
+		if err == nil && r.Status != tt.to {
+			t.Errorf("%s -> %s: expected status %s, got %s", tt.from, tt.to, tt.to, r.Status)
+		}
+		if err != nil && r.Status != tt.from {
+			t.Errorf("%s -> %s: expected status to remain %s, got %s", tt.from, tt.to, tt.from, r.Status)
+		}
+	}
+}
+
+func TestRequiresReviewer(t *testing.T) {
+	if !RequiresReviewer(InReview, Approved) || !RequiresReviewer(InReview, Draft) {
+		t.Error("Expected approving and rejecting a recipe in review to require a reviewer")
+	}
+	if RequiresReviewer(Draft, InReview) || RequiresReviewer(Approved, Archived) {
+		t.Error("Expected submitting and archiving not to require a reviewer")
+	}
+}
+
+func TestNewRevision(t *testing.T) {
+	r, err := NewRecipe("Dark", "", []Ingredient{{Name: "Cacao", IsCacao: true, Quantity: Quantity{Amount: 100, Unit: Gram}}}, "Mix")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Status != Draft || r.Revision != 1 {
+		t.Fatalf("Expected new recipe to be revision 1 draft, got %s revision %d", r.Status, r.Revision)
+	}
+	r.ID = "abc"
+	r.Status = Approved
+	r.ApprovedBy = "alice"
+	if !r.Locked() {
+		t.Error("Expected approved recipe to be locked")
+	}
+
+	rev := r.NewRevision()
+	if rev.ID != "" || rev.RevisionOf != "abc" || rev.Revision != 2 {
+		t.Errorf("Expected unsaved revision 2 of abc, got ID %q revision %d of %q", rev.ID, rev.Revision, rev.RevisionOf)
+	}
+	if rev.Status != Draft || rev.ApprovedBy != "" || rev.Locked() {
+		t.Errorf("Expected revision to be an unapproved draft, got %s approved by %q", rev.Status, rev.ApprovedBy)
+	}
+	if r.Status != Approved || r.ID != "abc" {
+		t.Error("Expected the original recipe to be unchanged")
+	}
+}
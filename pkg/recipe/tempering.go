@@ -0,0 +1,181 @@
+package recipe
+
+import "strings"
+
+// Error constants for tempering
+var (
+	ErrInvalidClassification  = &Error{"invalid_classification", "Classification must be dark, milk or white"}
+	ErrInvalidTemperingMethod = &Error{"invalid_tempering_method", "Tempering method must be seed or tabling"}
+	ErrInvalidTemperingCurve  = &Error{"invalid_tempering_curve", "Tempering temperatures must satisfy cool < work < melt"}
+	ErrInvalidTemperingBatch  = &Error{"invalid_tempering_batch", "Batch size must be greater than zero"}
+)
+
+// Classification is the type of chocolate a recipe produces, which determines its tempering curve.
+type Classification string
+
+const (
+	Dark  Classification = "dark"
+	Milk  Classification = "milk"
+	White Classification = "white"
+)
+
+// TemperingMethod is the technique used to temper chocolate.
+type TemperingMethod string
+
+const (
+	// SeedMethod melts part of the batch and cools it down by stirring in already tempered chocolate (the seed).
+	SeedMethod TemperingMethod = "seed"
+	// TablingMethod melts the whole batch and cools part of it by working it on a marble slab.
+	TablingMethod TemperingMethod = "tabling"
+)
+
+// SeedRatio is the fraction of the batch that is added as tempered seed chocolate with the seed method.
+const SeedRatio = 0.25
+
+// TablingRatio is the fraction of the melted batch that is worked on the table with the tabling method.
+const TablingRatio = 2.0 / 3.0
+
+// TemperingCurve holds the temperatures in degrees Celsius of the tempering process.
+type TemperingCurve struct {
+	MeltTemperature float64 // Temperature to fully melt all cocoa butter crystals
+	CoolTemperature float64 // Temperature to cool down to, forming stable and unstable crystals
+	WorkTemperature float64 // Temperature to reheat to and work at, melting the unstable crystals
+}
+
+// defaultTemperingCurves are the commonly used tempering curves per classification.
+var defaultTemperingCurves = map[Classification]TemperingCurve{
+	Dark:  {MeltTemperature: 50, CoolTemperature: 27, WorkTemperature: 31},
+	Milk:  {MeltTemperature: 45, CoolTemperature: 26, WorkTemperature: 29},
+	White: {MeltTemperature: 40, CoolTemperature: 25, WorkTemperature: 28},
+}
+
+// DefaultTemperingCurve returns the standard tempering curve for the classification.
+func DefaultTemperingCurve(c Classification) (TemperingCurve, error) {
+	curve, ok := defaultTemperingCurves[c]
+	if !ok {
+		return TemperingCurve{}, ErrInvalidClassification
+	}
+	return curve, nil
+}
+
+// Validate checks that the temperatures of the curve are in the right order.
+func (c TemperingCurve) Validate() error {
+	if !(c.CoolTemperature < c.WorkTemperature && c.WorkTemperature < c.MeltTemperature) {
+		return ErrInvalidTemperingCurve
+	}
+	return nil
+}
+
+// TemperingPlan describes how to temper a batch of chocolate.
+type TemperingPlan struct {
+	Classification Classification
+	Method         TemperingMethod
+	Curve          TemperingCurve
+	BatchSize      Quantity // Total amount of chocolate being tempered
+	Melt           Quantity // Amount of chocolate to melt
+	Seed           Quantity // Amount of tempered seed chocolate to add, only for the seed method
+	Tabled         Quantity // Amount of melted chocolate to work on the table, only for the tabling method
+}
+
+// ResolveClassification returns the classification of the recipe.
+// If none is set it is inferred from the ingredients: recipes without cocoa solids are white, recipes with milk are milk chocolate and everything else is dark.
+func (r *Recipe) ResolveClassification() Classification {
+	if r.Classification != "" {
+		return r.Classification
+	}
+	var hasCocoaSolids, hasMilk bool
+	for _, ing := range r.Ingredients {
+		name := strings.ToLower(ing.Name)
+		if ing.IsCacao && !strings.Contains(name, "butter") {
+			hasCocoaSolids = true
+		}
+		if strings.Contains(name, "milk") {
+			hasMilk = true
+		}
+	}
+	switch {
+	case !hasCocoaSolids:
+		return White
+	case hasMilk:
+		return Milk
+	default:
+		return Dark
+	}
+}
+
+// ValidateTempering checks the classification and the tempering overrides of the recipe.
+func (r *Recipe) ValidateTempering() error {
+	if r.Classification != "" {
+		if _, err := DefaultTemperingCurve(r.Classification); err != nil {
+			return err
+		}
+	}
+	if r.Tempering != nil {
+		curve, _ := DefaultTemperingCurve(r.ResolveClassification())
+		if err := mergeTemperingCurve(curve, r.Tempering).Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TemperingCurve returns the tempering curve of the recipe: the default curve for its classification
+// with any temperatures overridden on the recipe applied.
+func (r *Recipe) TemperingCurve() (TemperingCurve, error) {
+	curve, err := DefaultTemperingCurve(r.ResolveClassification())
+	if err != nil {
+		return TemperingCurve{}, err
+	}
+	curve = mergeTemperingCurve(curve, r.Tempering)
+	if err := curve.Validate(); err != nil {
+		return TemperingCurve{}, err
+	}
+	return curve, nil
+}
+
+// TemperingPlan calculates how to temper a batch of the given size in grams with the given method.
+func (r *Recipe) TemperingPlan(method TemperingMethod, batchSize float64) (*TemperingPlan, error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidTemperingBatch
+	}
+	curve, err := r.TemperingCurve()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &TemperingPlan{
+		Classification: r.ResolveClassification(),
+		Method:         method,
+		Curve:          curve,
+		BatchSize:      Quantity{Unit: "grams", Amount: batchSize},
+	}
+	switch method {
+	case SeedMethod:
+		seed := batchSize * SeedRatio
+		plan.Seed = Quantity{Unit: "grams", Amount: seed}
+		plan.Melt = Quantity{Unit: "grams", Amount: batchSize - seed}
+	case TablingMethod:
+		plan.Melt = Quantity{Unit: "grams", Amount: batchSize}
+		plan.Tabled = Quantity{Unit: "grams", Amount: batchSize * TablingRatio}
+	default:
+		return nil, ErrInvalidTemperingMethod
+	}
+	return plan, nil
+}
+
+// mergeTemperingCurve applies the non-zero temperatures of the override to the curve.
+func mergeTemperingCurve(curve TemperingCurve, override *TemperingCurve) TemperingCurve {
+	if override == nil {
+		return curve
+	}
+	if override.MeltTemperature != 0 {
+		curve.MeltTemperature = override.MeltTemperature
+	}
+	if override.CoolTemperature != 0 {
+		curve.CoolTemperature = override.CoolTemperature
+	}
+	if override.WorkTemperature != 0 {
+		curve.WorkTemperature = override.WorkTemperature
+	}
+	return curve
+}
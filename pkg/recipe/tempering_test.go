@@ -0,0 +1,76 @@
+package recipe
+
+import "testing"
+
+func TestResolveClassification(t *testing.T) {
+	tests := []struct {
+		name        string
+		ingredients []Ingredient
+		want        Classification
+	}{
+		{
+			name:        "Dark",
+			ingredients: []Ingredient{{Name: "Cacao Mass", IsCacao: true}, {Name: "Sugar"}},
+			want:        Dark,
+		},
+		{
+			name:        "Milk",
+			ingredients: []Ingredient{{Name: "Cacao Mass", IsCacao: true}, {Name: "Whole Milk Powder"}, {Name: "Sugar"}},
+			want:        Milk,
+		},
+		{
+			name:        "White",
+			ingredients: []Ingredient{{Name: "Cocoa Butter", IsCacao: true}, {Name: "Milk Powder"}, {Name: "Sugar"}},
+			want:        White,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Recipe{Ingredients: tt.ingredients}
+			if got := r.ResolveClassification(); got != tt.want {
+				t.Errorf("ResolveClassification() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	r := &Recipe{Classification: Milk, Ingredients: tests[0].ingredients}
+	if got := r.ResolveClassification(); got != Milk {
+		t.Errorf("ResolveClassification() = %q, want explicit classification %q", got, Milk)
+	}
+}
+
+func TestTemperingPlan(t *testing.T) {
+	r := &Recipe{
+		Classification: Dark,
+		Tempering:      &TemperingCurve{WorkTemperature: 32},
+	}
+
+	plan, err := r.TemperingPlan(SeedMethod, 1000)
+	if err != nil {
+		t.Fatalf("TemperingPlan() error = %v", err)
+	}
+	want := TemperingCurve{MeltTemperature: 50, CoolTemperature: 27, WorkTemperature: 32}
+	if plan.Curve != want {
+		t.Errorf("Curve = %+v, want %+v", plan.Curve, want)
+	}
+	if plan.Seed.Amount != 250 || plan.Melt.Amount != 750 {
+		t.Errorf("expected 250 seed and 750 melt, got %v and %v", plan.Seed, plan.Melt)
+	}
+
+	plan, err = r.TemperingPlan(TablingMethod, 900)
+	if err != nil {
+		t.Fatalf("TemperingPlan() error = %v", err)
+	}
+	if plan.Melt.Amount != 900 || plan.Tabled.Amount != 600 || plan.Seed.Amount != 0 {
+		t.Errorf("unexpected tabling amounts %+v", plan)
+	}
+
+	if _, err := r.TemperingPlan("microwave", 900); err != ErrInvalidTemperingMethod {
+		t.Errorf("TemperingPlan() error = %v, want %v", err, ErrInvalidTemperingMethod)
+	}
+
+	r.Tempering = &TemperingCurve{WorkTemperature: 55}
+	if err := r.ValidateTempering(); err != ErrInvalidTemperingCurve {
+		t.Errorf("ValidateTempering() error = %v, want %v", err, ErrInvalidTemperingCurve)
+	}
+}
@@ -5,4 +5,7 @@ type TemplateIngredient struct {
 	Name       string  // Name of the ingredient
 	IsCacao    bool    // Indicates if the ingredient is cacao
 	Percentage float64 // Percentage of the ingredient in the recipe
+	CacaoID    string  // ID of the cacao catalog entry, only for cacao ingredients
+	Origin     *Origin // Origin of the cacao
+	CostPerKg  float64 // Purchase price per kilogram
 }
@@ -11,20 +11,35 @@ type TemplateRecipe struct {
 	Ingredients     []TemplateIngredient
 	Instructions    string  // Instructions for the recipe
 	CacaoPercentage float64 // Cacao percentage of the recipe
+	Losses          []ProcessLoss
+	Classification  Classification
+	Tempering       *TemperingCurve
+	Process         *ProcessProfile
+	Nutrition       *Nutrition
 }
 
 // ToRecipe converts a TemplateRecipe to a Recipe with recalculated ingredient quantities based on the desired yield.
 // The yield is specified in grams, and the ingredient quantities are adjusted accordingly.
+// The yield is the finished (net) output; when the template declares process losses the ingredients are scaled up so that
+// the gross input, reported as Input, yields the requested amount after all losses.
 // The recipe ID is preserved, and the name and description are modified to reflect the new yield.
-// If the yield is zero or negative, it returns nil.
+// If the yield is zero or negative, or the losses consume all input, it returns nil.
 func (tr *TemplateRecipe) ToRecipe(yield float64) *Recipe {
+	retention := Retention(tr.Losses)
+	if yield <= 0 || retention <= 0 {
+		return nil
+	}
+	input := yield / retention
 	ingredients := make([]Ingredient, len(tr.Ingredients))
 	for i, ing := range tr.Ingredients {
-		quantity := ing.Percentage * yield / 100
+		quantity := ing.Percentage * input / 100
 		ingredients[i] = Ingredient{
-			Name:     ing.Name,
-			IsCacao:  ing.IsCacao,
-			Quantity: Quantity{Unit: "grams", Amount: quantity},
+			Name:      ing.Name,
+			IsCacao:   ing.IsCacao,
+			Quantity:  Quantity{Unit: "grams", Amount: quantity},
+			CacaoID:   ing.CacaoID,
+			Origin:    ing.Origin,
+			CostPerKg: ing.CostPerKg,
 		}
 	}
 	return &Recipe{
@@ -35,5 +50,11 @@ func (tr *TemplateRecipe) ToRecipe(yield float64) *Recipe {
 		Instructions:    tr.Instructions,
 		CacaoPercentage: tr.CacaoPercentage,
 		Yield:           Quantity{Unit: "grams", Amount: yield},
+		Input:           Quantity{Unit: "grams", Amount: input},
+		Losses:          tr.Losses,
+		Classification:  tr.Classification,
+		Tempering:       tr.Tempering,
+		Process:         tr.Process,
+		Nutrition:       tr.Nutrition,
 	}
 }
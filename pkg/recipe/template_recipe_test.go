@@ -0,0 +1,53 @@
+package recipe
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToTemplateMixedUnits(t *testing.T) {
+	r, err := NewRecipe("Dark", "", []Ingredient{
+		{Name: "Cacao Mass", IsCacao: true, Quantity: Quantity{Amount: 1, Unit: Kilogram}},
+		{Name: "Sugar", Quantity: Quantity{Amount: 300, Unit: Gram}},
+	}, "Mix")
+	if err != nil {
+		t.Fatalf("NewRecipe() error = %v", err)
+	}
+	if r.Input.Amount != 1300 {
+		t.Errorf("expected input 1300 grams, got %v", r.Input)
+	}
+	if want := 100000.0 / 1300; math.Abs(r.CacaoPercentage-want) > 1e-9 {
+		t.Errorf("expected cacao percentage %f, got %f", want, r.CacaoPercentage)
+	}
+
+	scaled := r.ToTemplate().ToRecipe(650)
+	if scaled == nil {
+		t.Fatal("ToRecipe() returned nil")
+	}
+	for i, want := range []float64{500, 150} {
+		if got := scaled.Ingredients[i].Quantity.Amount; math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: expected %g grams, got %f", scaled.Ingredients[i].Name, want, got)
+		}
+	}
+}
+
+func TestValidateQuantities(t *testing.T) {
+	tests := []struct {
+		unit    Unit
+		wantErr error
+	}{
+		{Milligram, nil},
+		{"grams", nil},
+		{Milliliter, ErrMassUnitRequired},
+		{"cup", ErrUnknownUnit},
+	}
+	for _, tt := range tests {
+		_, err := NewRecipe("Dark", "", []Ingredient{
+			{Name: "Cacao Mass", IsCacao: true, Quantity: Quantity{Amount: 700, Unit: Gram}},
+			{Name: "Vanilla", Quantity: Quantity{Amount: 5, Unit: tt.unit}},
+		}, "Mix")
+		if err != tt.wantErr {
+			t.Errorf("NewRecipe() with %q error = %v, want %v", tt.unit, err, tt.wantErr)
+		}
+	}
+}
@@ -1,8 +1,83 @@
 package recipe
 
+import "strings"
+
+// Error constants for unit handling
+var (
+	ErrUnknownUnit       = &Error{"unknown_unit", "Unknown unit"}
+	ErrIncompatibleUnits = &Error{"incompatible_units", "Units cannot be converted into each other"}
+)
+
 // Unit represents a measurement unit used in recipes.
 type Unit string
 
 const (
-	Gram Unit = "g"
+	Gram       Unit = "g"
+	Kilogram   Unit = "kg"
+	Milligram  Unit = "mg"
+	Milliliter Unit = "ml"
+	Liter      Unit = "l"
+)
+
+// dimension groups units that can be converted into each other.
+type dimension int
+
+const (
+	mass dimension = iota
+	volume
 )
+
+// unitInfo describes how a unit relates to the base unit of its dimension.
+type unitInfo struct {
+	dimension dimension
+	base      Unit
+	factor    float64 // multiply by factor to get the amount in the base unit
+}
+
+var units = map[Unit]unitInfo{
+	Gram:       {mass, Gram, 1},
+	Kilogram:   {mass, Gram, 1000},
+	Milligram:  {mass, Gram, 0.001},
+	Milliliter: {volume, Milliliter, 1},
+	Liter:      {volume, Milliliter, 1000},
+}
+
+// unitAliases maps commonly used spellings to their canonical unit.
+var unitAliases = map[string]Unit{
+	"gram":        Gram,
+	"grams":       Gram,
+	"kilogram":    Kilogram,
+	"kilograms":   Kilogram,
+	"milligram":   Milligram,
+	"milligrams":  Milligram,
+	"milliliter":  Milliliter,
+	"milliliters": Milliliter,
+	"millilitre":  Milliliter,
+	"millilitres": Milliliter,
+	"liter":       Liter,
+	"liters":      Liter,
+	"litre":       Liter,
+	"litres":      Liter,
+}
+
+// Normalize returns the canonical form of the unit, resolving aliases like "grams" to Gram.
+// Unknown units are returned unchanged.
+func (u Unit) Normalize() Unit {
+	s := strings.ToLower(strings.TrimSpace(string(u)))
+	if alias, ok := unitAliases[s]; ok {
+		return alias
+	}
+	if _, ok := units[Unit(s)]; ok {
+		return Unit(s)
+	}
+	return u
+}
+
+// Base returns the base unit of the unit's dimension (grams for mass, milliliters for volume).
+func (u Unit) Base() (Unit, error) {
+	info, ok := units[u.Normalize()]
+	if !ok {
+		return "", ErrUnknownUnit
+	}
+	return info.base, nil
+}
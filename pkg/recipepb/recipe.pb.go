@@ -0,0 +1,2818 @@
+// Recipe API over gRPC. The messages mirror the types of the recipe package: field names follow the Go fields,
+// units, statuses and classifications are the same strings, and times are UTC timestamps.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: recipe.proto
+
+package recipepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Quantity is an amount in a unit such as g, kg or ml
+type Quantity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quantity) Reset() {
+	*x = Quantity{}
+	mi := &file_recipe_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quantity) ProtoMessage() {}
+
+func (x *Quantity) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quantity.ProtoReflect.Descriptor instead.
+func (*Quantity) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quantity) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Quantity) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+// Origin describes where the cacao of an ingredient comes from
+type Origin struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Country      string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Region       string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Producer     string                 `protobuf:"bytes,3,opt,name=producer,proto3" json:"producer,omitempty"`
+	Variety      string                 `protobuf:"bytes,4,opt,name=variety,proto3" json:"variety,omitempty"`
+	HarvestYear  int32                  `protobuf:"varint,5,opt,name=harvest_year,json=harvestYear,proto3" json:"harvest_year,omitempty"`
+	Fermentation string                 `protobuf:"bytes,6,opt,name=fermentation,proto3" json:"fermentation,omitempty"`
+	Drying       string                 `protobuf:"bytes,7,opt,name=drying,proto3" json:"drying,omitempty"`
+	// organic, fair_trade, rainforest_alliance or direct_trade
+	Certifications []string `protobuf:"bytes,8,rep,name=certifications,proto3" json:"certifications,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Origin) Reset() {
+	*x = Origin{}
+	mi := &file_recipe_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Origin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Origin) ProtoMessage() {}
+
+func (x *Origin) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Origin.ProtoReflect.Descriptor instead.
+func (*Origin) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Origin) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Origin) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Origin) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *Origin) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *Origin) GetHarvestYear() int32 {
+	if x != nil {
+		return x.HarvestYear
+	}
+	return 0
+}
+
+func (x *Origin) GetFermentation() string {
+	if x != nil {
+		return x.Fermentation
+	}
+	return ""
+}
+
+func (x *Origin) GetDrying() string {
+	if x != nil {
+		return x.Drying
+	}
+	return ""
+}
+
+func (x *Origin) GetCertifications() []string {
+	if x != nil {
+		return x.Certifications
+	}
+	return nil
+}
+
+type Ingredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsCacao       bool                   `protobuf:"varint,2,opt,name=is_cacao,json=isCacao,proto3" json:"is_cacao,omitempty"`
+	Quantity      *Quantity              `protobuf:"bytes,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CacaoId       string                 `protobuf:"bytes,4,opt,name=cacao_id,json=cacaoId,proto3" json:"cacao_id,omitempty"`
+	Origin        *Origin                `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	CostPerKg     float64                `protobuf:"fixed64,6,opt,name=cost_per_kg,json=costPerKg,proto3" json:"cost_per_kg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	mi := &file_recipe_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Ingredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ingredient) GetIsCacao() bool {
+	if x != nil {
+		return x.IsCacao
+	}
+	return false
+}
+
+func (x *Ingredient) GetQuantity() *Quantity {
+	if x != nil {
+		return x.Quantity
+	}
+	return nil
+}
+
+func (x *Ingredient) GetCacaoId() string {
+	if x != nil {
+		return x.CacaoId
+	}
+	return ""
+}
+
+func (x *Ingredient) GetOrigin() *Origin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *Ingredient) GetCostPerKg() float64 {
+	if x != nil {
+		return x.CostPerKg
+	}
+	return 0
+}
+
+type ProcessLoss struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessLoss) Reset() {
+	*x = ProcessLoss{}
+	mi := &file_recipe_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessLoss) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessLoss) ProtoMessage() {}
+
+func (x *ProcessLoss) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessLoss.ProtoReflect.Descriptor instead.
+func (*ProcessLoss) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProcessLoss) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *ProcessLoss) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+// TemperingCurve holds the tempering temperatures in degrees Celsius
+type TemperingCurve struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MeltTemperature float64                `protobuf:"fixed64,1,opt,name=melt_temperature,json=meltTemperature,proto3" json:"melt_temperature,omitempty"`
+	CoolTemperature float64                `protobuf:"fixed64,2,opt,name=cool_temperature,json=coolTemperature,proto3" json:"cool_temperature,omitempty"`
+	WorkTemperature float64                `protobuf:"fixed64,3,opt,name=work_temperature,json=workTemperature,proto3" json:"work_temperature,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TemperingCurve) Reset() {
+	*x = TemperingCurve{}
+	mi := &file_recipe_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperingCurve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperingCurve) ProtoMessage() {}
+
+func (x *TemperingCurve) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperingCurve.ProtoReflect.Descriptor instead.
+func (*TemperingCurve) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TemperingCurve) GetMeltTemperature() float64 {
+	if x != nil {
+		return x.MeltTemperature
+	}
+	return 0
+}
+
+func (x *TemperingCurve) GetCoolTemperature() float64 {
+	if x != nil {
+		return x.CoolTemperature
+	}
+	return 0
+}
+
+func (x *TemperingCurve) GetWorkTemperature() float64 {
+	if x != nil {
+		return x.WorkTemperature
+	}
+	return 0
+}
+
+type CurvePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Minute        float64                `protobuf:"fixed64,1,opt,name=minute,proto3" json:"minute,omitempty"`
+	Temperature   float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurvePoint) Reset() {
+	*x = CurvePoint{}
+	mi := &file_recipe_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurvePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurvePoint) ProtoMessage() {}
+
+func (x *CurvePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurvePoint.ProtoReflect.Descriptor instead.
+func (*CurvePoint) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CurvePoint) GetMinute() float64 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *CurvePoint) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+type RoastProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*CurvePoint          `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoastProfile) Reset() {
+	*x = RoastProfile{}
+	mi := &file_recipe_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoastProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoastProfile) ProtoMessage() {}
+
+func (x *RoastProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoastProfile.ProtoReflect.Descriptor instead.
+func (*RoastProfile) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoastProfile) GetPoints() []*CurvePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type RefiningProfile struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DurationHours      float64                `protobuf:"fixed64,1,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	TargetParticleSize float64                `protobuf:"fixed64,2,opt,name=target_particle_size,json=targetParticleSize,proto3" json:"target_particle_size,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RefiningProfile) Reset() {
+	*x = RefiningProfile{}
+	mi := &file_recipe_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefiningProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefiningProfile) ProtoMessage() {}
+
+func (x *RefiningProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefiningProfile.ProtoReflect.Descriptor instead.
+func (*RefiningProfile) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RefiningProfile) GetDurationHours() float64 {
+	if x != nil {
+		return x.DurationHours
+	}
+	return 0
+}
+
+func (x *RefiningProfile) GetTargetParticleSize() float64 {
+	if x != nil {
+		return x.TargetParticleSize
+	}
+	return 0
+}
+
+type ConchingProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DurationHours float64                `protobuf:"fixed64,1,opt,name=duration_hours,json=durationHours,proto3" json:"duration_hours,omitempty"`
+	Temperature   float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConchingProfile) Reset() {
+	*x = ConchingProfile{}
+	mi := &file_recipe_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConchingProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConchingProfile) ProtoMessage() {}
+
+func (x *ConchingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConchingProfile.ProtoReflect.Descriptor instead.
+func (*ConchingProfile) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConchingProfile) GetDurationHours() float64 {
+	if x != nil {
+		return x.DurationHours
+	}
+	return 0
+}
+
+func (x *ConchingProfile) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+type ProcessProfile struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Roast          *RoastProfile          `protobuf:"bytes,1,opt,name=roast,proto3" json:"roast,omitempty"`
+	WinnowingRatio float64                `protobuf:"fixed64,2,opt,name=winnowing_ratio,json=winnowingRatio,proto3" json:"winnowing_ratio,omitempty"`
+	Refining       *RefiningProfile       `protobuf:"bytes,3,opt,name=refining,proto3" json:"refining,omitempty"`
+	Conching       *ConchingProfile       `protobuf:"bytes,4,opt,name=conching,proto3" json:"conching,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProcessProfile) Reset() {
+	*x = ProcessProfile{}
+	mi := &file_recipe_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessProfile) ProtoMessage() {}
+
+func (x *ProcessProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessProfile.ProtoReflect.Descriptor instead.
+func (*ProcessProfile) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProcessProfile) GetRoast() *RoastProfile {
+	if x != nil {
+		return x.Roast
+	}
+	return nil
+}
+
+func (x *ProcessProfile) GetWinnowingRatio() float64 {
+	if x != nil {
+		return x.WinnowingRatio
+	}
+	return 0
+}
+
+func (x *ProcessProfile) GetRefining() *RefiningProfile {
+	if x != nil {
+		return x.Refining
+	}
+	return nil
+}
+
+func (x *ProcessProfile) GetConching() *ConchingProfile {
+	if x != nil {
+		return x.Conching
+	}
+	return nil
+}
+
+// Nutrition holds the nutrition facts per 100 g, in kcal for energy, mg for sodium and g for the rest
+type Nutrition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Energy        float64                `protobuf:"fixed64,1,opt,name=energy,proto3" json:"energy,omitempty"`
+	Fat           float64                `protobuf:"fixed64,2,opt,name=fat,proto3" json:"fat,omitempty"`
+	SaturatedFat  float64                `protobuf:"fixed64,3,opt,name=saturated_fat,json=saturatedFat,proto3" json:"saturated_fat,omitempty"`
+	Carbohydrate  float64                `protobuf:"fixed64,4,opt,name=carbohydrate,proto3" json:"carbohydrate,omitempty"`
+	Sugar         float64                `protobuf:"fixed64,5,opt,name=sugar,proto3" json:"sugar,omitempty"`
+	Protein       float64                `protobuf:"fixed64,6,opt,name=protein,proto3" json:"protein,omitempty"`
+	Fiber         float64                `protobuf:"fixed64,7,opt,name=fiber,proto3" json:"fiber,omitempty"`
+	Sodium        float64                `protobuf:"fixed64,8,opt,name=sodium,proto3" json:"sodium,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nutrition) Reset() {
+	*x = Nutrition{}
+	mi := &file_recipe_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nutrition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nutrition) ProtoMessage() {}
+
+func (x *Nutrition) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nutrition.ProtoReflect.Descriptor instead.
+func (*Nutrition) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Nutrition) GetEnergy() float64 {
+	if x != nil {
+		return x.Energy
+	}
+	return 0
+}
+
+func (x *Nutrition) GetFat() float64 {
+	if x != nil {
+		return x.Fat
+	}
+	return 0
+}
+
+func (x *Nutrition) GetSaturatedFat() float64 {
+	if x != nil {
+		return x.SaturatedFat
+	}
+	return 0
+}
+
+func (x *Nutrition) GetCarbohydrate() float64 {
+	if x != nil {
+		return x.Carbohydrate
+	}
+	return 0
+}
+
+func (x *Nutrition) GetSugar() float64 {
+	if x != nil {
+		return x.Sugar
+	}
+	return 0
+}
+
+func (x *Nutrition) GetProtein() float64 {
+	if x != nil {
+		return x.Protein
+	}
+	return 0
+}
+
+func (x *Nutrition) GetFiber() float64 {
+	if x != nil {
+		return x.Fiber
+	}
+	return 0
+}
+
+func (x *Nutrition) GetSodium() float64 {
+	if x != nil {
+		return x.Sodium
+	}
+	return 0
+}
+
+type Recipe struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Ingredients     []*Ingredient          `protobuf:"bytes,4,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Instructions    string                 `protobuf:"bytes,5,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy       string                 `protobuf:"bytes,9,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CacaoPercentage float64                `protobuf:"fixed64,10,opt,name=cacao_percentage,json=cacaoPercentage,proto3" json:"cacao_percentage,omitempty"`
+	Yield           *Quantity              `protobuf:"bytes,11,opt,name=yield,proto3" json:"yield,omitempty"`
+	Input           *Quantity              `protobuf:"bytes,12,opt,name=input,proto3" json:"input,omitempty"`
+	Losses          []*ProcessLoss         `protobuf:"bytes,13,rep,name=losses,proto3" json:"losses,omitempty"`
+	// dark, milk or white
+	Classification string          `protobuf:"bytes,14,opt,name=classification,proto3" json:"classification,omitempty"`
+	Tempering      *TemperingCurve `protobuf:"bytes,15,opt,name=tempering,proto3" json:"tempering,omitempty"`
+	Process        *ProcessProfile `protobuf:"bytes,16,opt,name=process,proto3" json:"process,omitempty"`
+	Nutrition      *Nutrition      `protobuf:"bytes,17,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	// draft, in_review, approved or archived
+	Status        string                 `protobuf:"bytes,18,opt,name=status,proto3" json:"status,omitempty"`
+	Revision      int32                  `protobuf:"varint,19,opt,name=revision,proto3" json:"revision,omitempty"`
+	RevisionOf    string                 `protobuf:"bytes,20,opt,name=revision_of,json=revisionOf,proto3" json:"revision_of,omitempty"`
+	ApprovedBy    string                 `protobuf:"bytes,21,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt    *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,24,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	mi := &file_recipe_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Recipe) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Recipe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recipe) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Recipe) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *Recipe) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *Recipe) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Recipe) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Recipe) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Recipe) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Recipe) GetCacaoPercentage() float64 {
+	if x != nil {
+		return x.CacaoPercentage
+	}
+	return 0
+}
+
+func (x *Recipe) GetYield() *Quantity {
+	if x != nil {
+		return x.Yield
+	}
+	return nil
+}
+
+func (x *Recipe) GetInput() *Quantity {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *Recipe) GetLosses() []*ProcessLoss {
+	if x != nil {
+		return x.Losses
+	}
+	return nil
+}
+
+func (x *Recipe) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *Recipe) GetTempering() *TemperingCurve {
+	if x != nil {
+		return x.Tempering
+	}
+	return nil
+}
+
+func (x *Recipe) GetProcess() *ProcessProfile {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *Recipe) GetNutrition() *Nutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+func (x *Recipe) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Recipe) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Recipe) GetRevisionOf() string {
+	if x != nil {
+		return x.RevisionOf
+	}
+	return ""
+}
+
+func (x *Recipe) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *Recipe) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *Recipe) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Recipe) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type TemplateIngredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsCacao       bool                   `protobuf:"varint,2,opt,name=is_cacao,json=isCacao,proto3" json:"is_cacao,omitempty"`
+	Percentage    float64                `protobuf:"fixed64,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	CacaoId       string                 `protobuf:"bytes,4,opt,name=cacao_id,json=cacaoId,proto3" json:"cacao_id,omitempty"`
+	Origin        *Origin                `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	CostPerKg     float64                `protobuf:"fixed64,6,opt,name=cost_per_kg,json=costPerKg,proto3" json:"cost_per_kg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateIngredient) Reset() {
+	*x = TemplateIngredient{}
+	mi := &file_recipe_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateIngredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateIngredient) ProtoMessage() {}
+
+func (x *TemplateIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateIngredient.ProtoReflect.Descriptor instead.
+func (*TemplateIngredient) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TemplateIngredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateIngredient) GetIsCacao() bool {
+	if x != nil {
+		return x.IsCacao
+	}
+	return false
+}
+
+func (x *TemplateIngredient) GetPercentage() float64 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *TemplateIngredient) GetCacaoId() string {
+	if x != nil {
+		return x.CacaoId
+	}
+	return ""
+}
+
+func (x *TemplateIngredient) GetOrigin() *Origin {
+	if x != nil {
+		return x.Origin
+	}
+	return nil
+}
+
+func (x *TemplateIngredient) GetCostPerKg() float64 {
+	if x != nil {
+		return x.CostPerKg
+	}
+	return 0
+}
+
+type TemplateRecipe struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId        string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Ingredients     []*TemplateIngredient  `protobuf:"bytes,4,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Instructions    string                 `protobuf:"bytes,5,opt,name=instructions,proto3" json:"instructions,omitempty"`
+	CacaoPercentage float64                `protobuf:"fixed64,6,opt,name=cacao_percentage,json=cacaoPercentage,proto3" json:"cacao_percentage,omitempty"`
+	Losses          []*ProcessLoss         `protobuf:"bytes,7,rep,name=losses,proto3" json:"losses,omitempty"`
+	Classification  string                 `protobuf:"bytes,8,opt,name=classification,proto3" json:"classification,omitempty"`
+	Tempering       *TemperingCurve        `protobuf:"bytes,9,opt,name=tempering,proto3" json:"tempering,omitempty"`
+	Process         *ProcessProfile        `protobuf:"bytes,10,opt,name=process,proto3" json:"process,omitempty"`
+	Nutrition       *Nutrition             `protobuf:"bytes,11,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TemplateRecipe) Reset() {
+	*x = TemplateRecipe{}
+	mi := &file_recipe_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateRecipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateRecipe) ProtoMessage() {}
+
+func (x *TemplateRecipe) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateRecipe.ProtoReflect.Descriptor instead.
+func (*TemplateRecipe) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TemplateRecipe) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *TemplateRecipe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateRecipe) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateRecipe) GetIngredients() []*TemplateIngredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *TemplateRecipe) GetInstructions() string {
+	if x != nil {
+		return x.Instructions
+	}
+	return ""
+}
+
+func (x *TemplateRecipe) GetCacaoPercentage() float64 {
+	if x != nil {
+		return x.CacaoPercentage
+	}
+	return 0
+}
+
+func (x *TemplateRecipe) GetLosses() []*ProcessLoss {
+	if x != nil {
+		return x.Losses
+	}
+	return nil
+}
+
+func (x *TemplateRecipe) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *TemplateRecipe) GetTempering() *TemperingCurve {
+	if x != nil {
+		return x.Tempering
+	}
+	return nil
+}
+
+func (x *TemplateRecipe) GetProcess() *ProcessProfile {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *TemplateRecipe) GetNutrition() *Nutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+type CreateRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only the fields that can be set by the caller are used: name, description, ingredients, instructions,
+	// losses, classification, tempering, process and nutrition
+	Recipe        *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecipeRequest) Reset() {
+	*x = CreateRecipeRequest{}
+	mi := &file_recipe_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecipeRequest) ProtoMessage() {}
+
+func (x *CreateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateRecipeRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+type GetRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Yield in grams to scale the recipe to, the recipe is returned as saved when 0
+	Yield         float64 `protobuf:"fixed64,2,opt,name=yield,proto3" json:"yield,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeRequest) Reset() {
+	*x = GetRecipeRequest{}
+	mi := &file_recipe_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeRequest) ProtoMessage() {}
+
+func (x *GetRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRecipeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRecipeRequest) GetYield() float64 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_recipe_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateRecipeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recipe to update is identified by its id, the other fields are used like for CreateRecipe
+	Recipe        *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeRequest) Reset() {
+	*x = UpdateRecipeRequest{}
+	mi := &file_recipe_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeRequest) ProtoMessage() {}
+
+func (x *UpdateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateRecipeRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+type UpdateRecipeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Recipe *Recipe                `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// Set when the recipe was approved, so the changes were saved as a new draft revision
+	NewRevision   bool `protobuf:"varint,2,opt,name=new_revision,json=newRevision,proto3" json:"new_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeResponse) Reset() {
+	*x = UpdateRecipeResponse{}
+	mi := &file_recipe_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeResponse) ProtoMessage() {}
+
+func (x *UpdateRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateRecipeResponse) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *UpdateRecipeResponse) GetNewRevision() bool {
+	if x != nil {
+		return x.NewRevision
+	}
+	return false
+}
+
+type TransitionRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransitionRecipeRequest) Reset() {
+	*x = TransitionRecipeRequest{}
+	mi := &file_recipe_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransitionRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionRecipeRequest) ProtoMessage() {}
+
+func (x *TransitionRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionRecipeRequest.ProtoReflect.Descriptor instead.
+func (*TransitionRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TransitionRecipeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransitionRecipeRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRecipeRequest) Reset() {
+	*x = DeleteRecipeRequest{}
+	mi := &file_recipe_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRecipeRequest) ProtoMessage() {}
+
+func (x *DeleteRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRecipeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteRecipeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRecipeRequest) Reset() {
+	*x = RestoreRecipeRequest{}
+	mi := &file_recipe_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRecipeRequest) ProtoMessage() {}
+
+func (x *RestoreRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRecipeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreRecipeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RecipeFilter selects recipes by status and by the origin of their cacao
+type RecipeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	OriginCountry string                 `protobuf:"bytes,2,opt,name=origin_country,json=originCountry,proto3" json:"origin_country,omitempty"`
+	OriginRegion  string                 `protobuf:"bytes,3,opt,name=origin_region,json=originRegion,proto3" json:"origin_region,omitempty"`
+	Variety       string                 `protobuf:"bytes,4,opt,name=variety,proto3" json:"variety,omitempty"`
+	Certification string                 `protobuf:"bytes,5,opt,name=certification,proto3" json:"certification,omitempty"`
+	// Part of the name of the recipe
+	Name      string  `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedBy string  `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MinCacao  float64 `protobuf:"fixed64,8,opt,name=min_cacao,json=minCacao,proto3" json:"min_cacao,omitempty"`
+	// Maximum cacao percentage, no maximum when 0
+	MaxCacao      float64 `protobuf:"fixed64,9,opt,name=max_cacao,json=maxCacao,proto3" json:"max_cacao,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeFilter) Reset() {
+	*x = RecipeFilter{}
+	mi := &file_recipe_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeFilter) ProtoMessage() {}
+
+func (x *RecipeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeFilter.ProtoReflect.Descriptor instead.
+func (*RecipeFilter) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RecipeFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetOriginCountry() string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetOriginRegion() string {
+	if x != nil {
+		return x.OriginRegion
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetVariety() string {
+	if x != nil {
+		return x.Variety
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetCertification() string {
+	if x != nil {
+		return x.Certification
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RecipeFilter) GetMinCacao() float64 {
+	if x != nil {
+		return x.MinCacao
+	}
+	return 0
+}
+
+func (x *RecipeFilter) GetMaxCacao() float64 {
+	if x != nil {
+		return x.MaxCacao
+	}
+	return 0
+}
+
+type ListRecipesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *RecipeFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of recipes, 10 when 0 and at most 100
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// name, created, updated or cacao, prefixed with - for descending order; created when empty
+	Sort string `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// next_page_token of the previous page, with the same sort, empty for the first page
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipesRequest) Reset() {
+	*x = ListRecipesRequest{}
+	mi := &file_recipe_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipesRequest) ProtoMessage() {}
+
+func (x *ListRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListRecipesRequest) GetFilter() *RecipeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListRecipesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRecipesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRecipesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Recipes []*Recipe              `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	// Token of the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of recipes matching the filter across all pages
+	TotalSize     int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipesResponse) Reset() {
+	*x = ListRecipesResponse{}
+	mi := &file_recipe_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipesResponse) ProtoMessage() {}
+
+func (x *ListRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListRecipesResponse) GetRecipes() []*Recipe {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *ListRecipesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRecipesResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of recipes, 10 when 0
+	Limit         int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_recipe_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTrashRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListTrashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipes       []*Recipe              `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_recipe_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTrashResponse) GetRecipes() []*Recipe {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+type CountRecipesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountRecipesRequest) Reset() {
+	*x = CountRecipesRequest{}
+	mi := &file_recipe_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRecipesRequest) ProtoMessage() {}
+
+func (x *CountRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRecipesRequest.ProtoReflect.Descriptor instead.
+func (*CountRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{27}
+}
+
+type CountRecipesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountRecipesResponse) Reset() {
+	*x = CountRecipesResponse{}
+	mi := &file_recipe_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountRecipesResponse) ProtoMessage() {}
+
+func (x *CountRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountRecipesResponse.ProtoReflect.Descriptor instead.
+func (*CountRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CountRecipesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PlanItem struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	// Yield to produce in grams
+	Yield         float64 `protobuf:"fixed64,2,opt,name=yield,proto3" json:"yield,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanItem) Reset() {
+	*x = PlanItem{}
+	mi := &file_recipe_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanItem) ProtoMessage() {}
+
+func (x *PlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanItem.ProtoReflect.Descriptor instead.
+func (*PlanItem) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PlanItem) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *PlanItem) GetYield() float64 {
+	if x != nil {
+		return x.Yield
+	}
+	return 0
+}
+
+type CreatePurchaseListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*PlanItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Ingredients currently in stock
+	Inventory     []*Ingredient `protobuf:"bytes,2,rep,name=inventory,proto3" json:"inventory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseListRequest) Reset() {
+	*x = CreatePurchaseListRequest{}
+	mi := &file_recipe_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseListRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseListRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseListRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePurchaseListRequest) GetItems() []*PlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreatePurchaseListRequest) GetInventory() []*Ingredient {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+type PurchaseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsCacao       bool                   `protobuf:"varint,2,opt,name=is_cacao,json=isCacao,proto3" json:"is_cacao,omitempty"`
+	Required      *Quantity              `protobuf:"bytes,3,opt,name=required,proto3" json:"required,omitempty"`
+	OnHand        *Quantity              `protobuf:"bytes,4,opt,name=on_hand,json=onHand,proto3" json:"on_hand,omitempty"`
+	ToBuy         *Quantity              `protobuf:"bytes,5,opt,name=to_buy,json=toBuy,proto3" json:"to_buy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseItem) Reset() {
+	*x = PurchaseItem{}
+	mi := &file_recipe_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseItem) ProtoMessage() {}
+
+func (x *PurchaseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseItem.ProtoReflect.Descriptor instead.
+func (*PurchaseItem) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PurchaseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PurchaseItem) GetIsCacao() bool {
+	if x != nil {
+		return x.IsCacao
+	}
+	return false
+}
+
+func (x *PurchaseItem) GetRequired() *Quantity {
+	if x != nil {
+		return x.Required
+	}
+	return nil
+}
+
+func (x *PurchaseItem) GetOnHand() *Quantity {
+	if x != nil {
+		return x.OnHand
+	}
+	return nil
+}
+
+func (x *PurchaseItem) GetToBuy() *Quantity {
+	if x != nil {
+		return x.ToBuy
+	}
+	return nil
+}
+
+type PurchaseList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PurchaseItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseList) Reset() {
+	*x = PurchaseList{}
+	mi := &file_recipe_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseList) ProtoMessage() {}
+
+func (x *PurchaseList) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseList.ProtoReflect.Descriptor instead.
+func (*PurchaseList) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PurchaseList) GetItems() []*PurchaseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ImportRecipesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Used like for CreateRecipe
+	Recipe *Recipe `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	// Only validate the recipes, read from the first message
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRecipesRequest) Reset() {
+	*x = ImportRecipesRequest{}
+	mi := &file_recipe_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRecipesRequest) ProtoMessage() {}
+
+func (x *ImportRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ImportRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportRecipesRequest) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *ImportRecipesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the recipe in the stream, starting at 1
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportResult) Reset() {
+	*x = ImportResult{}
+	mi := &file_recipe_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportResult) ProtoMessage() {}
+
+func (x *ImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportResult.ProtoReflect.Descriptor instead.
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*ImportResult        `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	mi := &file_recipe_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportReport) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportReport) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *ImportReport) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportReport) GetResults() []*ImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportRecipesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *RecipeFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRecipesRequest) Reset() {
+	*x = ExportRecipesRequest{}
+	mi := &file_recipe_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecipesRequest) ProtoMessage() {}
+
+func (x *ExportRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ExportRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExportRecipesRequest) GetFilter() *RecipeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+var File_recipe_proto protoreflect.FileDescriptor
+
+const file_recipe_proto_rawDesc = "" +
+	"\n" +
+	"\frecipe.proto\x12\x13chocolate.recipe.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
+	"\bQuantity\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\"\xf7\x01\n" +
+	"\x06Origin\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1a\n" +
+	"\bproducer\x18\x03 \x01(\tR\bproducer\x12\x18\n" +
+	"\avariety\x18\x04 \x01(\tR\avariety\x12!\n" +
+	"\fharvest_year\x18\x05 \x01(\x05R\vharvestYear\x12\"\n" +
+	"\ffermentation\x18\x06 \x01(\tR\ffermentation\x12\x16\n" +
+	"\x06drying\x18\a \x01(\tR\x06drying\x12&\n" +
+	"\x0ecertifications\x18\b \x03(\tR\x0ecertifications\"\xe6\x01\n" +
+	"\n" +
+	"Ingredient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bis_cacao\x18\x02 \x01(\bR\aisCacao\x129\n" +
+	"\bquantity\x18\x03 \x01(\v2\x1d.chocolate.recipe.v1.QuantityR\bquantity\x12\x19\n" +
+	"\bcacao_id\x18\x04 \x01(\tR\acacaoId\x123\n" +
+	"\x06origin\x18\x05 \x01(\v2\x1b.chocolate.recipe.v1.OriginR\x06origin\x12\x1e\n" +
+	"\vcost_per_kg\x18\x06 \x01(\x01R\tcostPerKg\"C\n" +
+	"\vProcessLoss\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x02 \x01(\x01R\n" +
+	"percentage\"\x91\x01\n" +
+	"\x0eTemperingCurve\x12)\n" +
+	"\x10melt_temperature\x18\x01 \x01(\x01R\x0fmeltTemperature\x12)\n" +
+	"\x10cool_temperature\x18\x02 \x01(\x01R\x0fcoolTemperature\x12)\n" +
+	"\x10work_temperature\x18\x03 \x01(\x01R\x0fworkTemperature\"F\n" +
+	"\n" +
+	"CurvePoint\x12\x16\n" +
+	"\x06minute\x18\x01 \x01(\x01R\x06minute\x12 \n" +
+	"\vtemperature\x18\x02 \x01(\x01R\vtemperature\"G\n" +
+	"\fRoastProfile\x127\n" +
+	"\x06points\x18\x01 \x03(\v2\x1f.chocolate.recipe.v1.CurvePointR\x06points\"j\n" +
+	"\x0fRefiningProfile\x12%\n" +
+	"\x0eduration_hours\x18\x01 \x01(\x01R\rdurationHours\x120\n" +
+	"\x14target_particle_size\x18\x02 \x01(\x01R\x12targetParticleSize\"Z\n" +
+	"\x0fConchingProfile\x12%\n" +
+	"\x0eduration_hours\x18\x01 \x01(\x01R\rdurationHours\x12 \n" +
+	"\vtemperature\x18\x02 \x01(\x01R\vtemperature\"\xf6\x01\n" +
+	"\x0eProcessProfile\x127\n" +
+	"\x05roast\x18\x01 \x01(\v2!.chocolate.recipe.v1.RoastProfileR\x05roast\x12'\n" +
+	"\x0fwinnowing_ratio\x18\x02 \x01(\x01R\x0ewinnowingRatio\x12@\n" +
+	"\brefining\x18\x03 \x01(\v2$.chocolate.recipe.v1.RefiningProfileR\brefining\x12@\n" +
+	"\bconching\x18\x04 \x01(\v2$.chocolate.recipe.v1.ConchingProfileR\bconching\"\xdc\x01\n" +
+	"\tNutrition\x12\x16\n" +
+	"\x06energy\x18\x01 \x01(\x01R\x06energy\x12\x10\n" +
+	"\x03fat\x18\x02 \x01(\x01R\x03fat\x12#\n" +
+	"\rsaturated_fat\x18\x03 \x01(\x01R\fsaturatedFat\x12\"\n" +
+	"\fcarbohydrate\x18\x04 \x01(\x01R\fcarbohydrate\x12\x14\n" +
+	"\x05sugar\x18\x05 \x01(\x01R\x05sugar\x12\x18\n" +
+	"\aprotein\x18\x06 \x01(\x01R\aprotein\x12\x14\n" +
+	"\x05fiber\x18\a \x01(\x01R\x05fiber\x12\x16\n" +
+	"\x06sodium\x18\b \x01(\x01R\x06sodium\"\xad\b\n" +
+	"\x06Recipe\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12A\n" +
+	"\vingredients\x18\x04 \x03(\v2\x1f.chocolate.recipe.v1.IngredientR\vingredients\x12\"\n" +
+	"\finstructions\x18\x05 \x01(\tR\finstructions\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\t \x01(\tR\tupdatedBy\x12)\n" +
+	"\x10cacao_percentage\x18\n" +
+	" \x01(\x01R\x0fcacaoPercentage\x123\n" +
+	"\x05yield\x18\v \x01(\v2\x1d.chocolate.recipe.v1.QuantityR\x05yield\x123\n" +
+	"\x05input\x18\f \x01(\v2\x1d.chocolate.recipe.v1.QuantityR\x05input\x128\n" +
+	"\x06losses\x18\r \x03(\v2 .chocolate.recipe.v1.ProcessLossR\x06losses\x12&\n" +
+	"\x0eclassification\x18\x0e \x01(\tR\x0eclassification\x12A\n" +
+	"\ttempering\x18\x0f \x01(\v2#.chocolate.recipe.v1.TemperingCurveR\ttempering\x12=\n" +
+	"\aprocess\x18\x10 \x01(\v2#.chocolate.recipe.v1.ProcessProfileR\aprocess\x12<\n" +
+	"\tnutrition\x18\x11 \x01(\v2\x1e.chocolate.recipe.v1.NutritionR\tnutrition\x12\x16\n" +
+	"\x06status\x18\x12 \x01(\tR\x06status\x12\x1a\n" +
+	"\brevision\x18\x13 \x01(\x05R\brevision\x12\x1f\n" +
+	"\vrevision_of\x18\x14 \x01(\tR\n" +
+	"revisionOf\x12\x1f\n" +
+	"\vapproved_by\x18\x15 \x01(\tR\n" +
+	"approvedBy\x12;\n" +
+	"\vapproved_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x18 \x01(\tR\tdeletedBy\"\xd3\x01\n" +
+	"\x12TemplateIngredient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bis_cacao\x18\x02 \x01(\bR\aisCacao\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x01R\n" +
+	"percentage\x12\x19\n" +
+	"\bcacao_id\x18\x04 \x01(\tR\acacaoId\x123\n" +
+	"\x06origin\x18\x05 \x01(\v2\x1b.chocolate.recipe.v1.OriginR\x06origin\x12\x1e\n" +
+	"\vcost_per_kg\x18\x06 \x01(\x01R\tcostPerKg\"\x9f\x04\n" +
+	"\x0eTemplateRecipe\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12I\n" +
+	"\vingredients\x18\x04 \x03(\v2'.chocolate.recipe.v1.TemplateIngredientR\vingredients\x12\"\n" +
+	"\finstructions\x18\x05 \x01(\tR\finstructions\x12)\n" +
+	"\x10cacao_percentage\x18\x06 \x01(\x01R\x0fcacaoPercentage\x128\n" +
+	"\x06losses\x18\a \x03(\v2 .chocolate.recipe.v1.ProcessLossR\x06losses\x12&\n" +
+	"\x0eclassification\x18\b \x01(\tR\x0eclassification\x12A\n" +
+	"\ttempering\x18\t \x01(\v2#.chocolate.recipe.v1.TemperingCurveR\ttempering\x12=\n" +
+	"\aprocess\x18\n" +
+	" \x01(\v2#.chocolate.recipe.v1.ProcessProfileR\aprocess\x12<\n" +
+	"\tnutrition\x18\v \x01(\v2\x1e.chocolate.recipe.v1.NutritionR\tnutrition\"J\n" +
+	"\x13CreateRecipeRequest\x123\n" +
+	"\x06recipe\x18\x01 \x01(\v2\x1b.chocolate.recipe.v1.RecipeR\x06recipe\"8\n" +
+	"\x10GetRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05yield\x18\x02 \x01(\x01R\x05yield\"$\n" +
+	"\x12GetTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"J\n" +
+	"\x13UpdateRecipeRequest\x123\n" +
+	"\x06recipe\x18\x01 \x01(\v2\x1b.chocolate.recipe.v1.RecipeR\x06recipe\"n\n" +
+	"\x14UpdateRecipeResponse\x123\n" +
+	"\x06recipe\x18\x01 \x01(\v2\x1b.chocolate.recipe.v1.RecipeR\x06recipe\x12!\n" +
+	"\fnew_revision\x18\x02 \x01(\bR\vnewRevision\"A\n" +
+	"\x17TransitionRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"%\n" +
+	"\x13DeleteRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x14RestoreRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x9f\x02\n" +
+	"\fRecipeFilter\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12%\n" +
+	"\x0eorigin_country\x18\x02 \x01(\tR\roriginCountry\x12#\n" +
+	"\rorigin_region\x18\x03 \x01(\tR\foriginRegion\x12\x18\n" +
+	"\avariety\x18\x04 \x01(\tR\avariety\x12$\n" +
+	"\rcertification\x18\x05 \x01(\tR\rcertification\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1b\n" +
+	"\tmin_cacao\x18\b \x01(\x01R\bminCacao\x12\x1b\n" +
+	"\tmax_cacao\x18\t \x01(\x01R\bmaxCacao\"\xa6\x01\n" +
+	"\x12ListRecipesRequest\x129\n" +
+	"\x06filter\x18\x01 \x01(\v2!.chocolate.recipe.v1.RecipeFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageTokenJ\x04\b\x03\x10\x04R\x06offset\"\x93\x01\n" +
+	"\x13ListRecipesResponse\x125\n" +
+	"\arecipes\x18\x01 \x03(\v2\x1b.chocolate.recipe.v1.RecipeR\arecipes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"@\n" +
+	"\x10ListTrashRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"J\n" +
+	"\x11ListTrashResponse\x125\n" +
+	"\arecipes\x18\x01 \x03(\v2\x1b.chocolate.recipe.v1.RecipeR\arecipes\"\x15\n" +
+	"\x13CountRecipesRequest\",\n" +
+	"\x14CountRecipesResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"=\n" +
+	"\bPlanItem\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x14\n" +
+	"\x05yield\x18\x02 \x01(\x01R\x05yield\"\x8f\x01\n" +
+	"\x19CreatePurchaseListRequest\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.chocolate.recipe.v1.PlanItemR\x05items\x12=\n" +
+	"\tinventory\x18\x02 \x03(\v2\x1f.chocolate.recipe.v1.IngredientR\tinventory\"\xe6\x01\n" +
+	"\fPurchaseItem\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bis_cacao\x18\x02 \x01(\bR\aisCacao\x129\n" +
+	"\brequired\x18\x03 \x01(\v2\x1d.chocolate.recipe.v1.QuantityR\brequired\x126\n" +
+	"\aon_hand\x18\x04 \x01(\v2\x1d.chocolate.recipe.v1.QuantityR\x06onHand\x124\n" +
+	"\x06to_buy\x18\x05 \x01(\v2\x1d.chocolate.recipe.v1.QuantityR\x05toBuy\"G\n" +
+	"\fPurchaseList\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.chocolate.recipe.v1.PurchaseItemR\x05items\"d\n" +
+	"\x14ImportRecipesRequest\x123\n" +
+	"\x06recipe\x18\x01 \x01(\v2\x1b.chocolate.recipe.v1.RecipeR\x06recipe\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\\\n" +
+	"\fImportResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xb0\x01\n" +
+	"\fImportReport\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x03 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\x12;\n" +
+	"\aresults\x18\x05 \x03(\v2!.chocolate.recipe.v1.ImportResultR\aresults\"Q\n" +
+	"\x14ExportRecipesRequest\x129\n" +
+	"\x06filter\x18\x01 \x01(\v2!.chocolate.recipe.v1.RecipeFilterR\x06filter2\xcb\t\n" +
+	"\rRecipeService\x12U\n" +
+	"\fCreateRecipe\x12(.chocolate.recipe.v1.CreateRecipeRequest\x1a\x1b.chocolate.recipe.v1.Recipe\x12O\n" +
+	"\tGetRecipe\x12%.chocolate.recipe.v1.GetRecipeRequest\x1a\x1b.chocolate.recipe.v1.Recipe\x12[\n" +
+	"\vGetTemplate\x12'.chocolate.recipe.v1.GetTemplateRequest\x1a#.chocolate.recipe.v1.TemplateRecipe\x12c\n" +
+	"\fUpdateRecipe\x12(.chocolate.recipe.v1.UpdateRecipeRequest\x1a).chocolate.recipe.v1.UpdateRecipeResponse\x12]\n" +
+	"\x10TransitionRecipe\x12,.chocolate.recipe.v1.TransitionRecipeRequest\x1a\x1b.chocolate.recipe.v1.Recipe\x12P\n" +
+	"\fDeleteRecipe\x12(.chocolate.recipe.v1.DeleteRecipeRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\rRestoreRecipe\x12).chocolate.recipe.v1.RestoreRecipeRequest\x1a\x1b.chocolate.recipe.v1.Recipe\x12`\n" +
+	"\vListRecipes\x12'.chocolate.recipe.v1.ListRecipesRequest\x1a(.chocolate.recipe.v1.ListRecipesResponse\x12Z\n" +
+	"\tListTrash\x12%.chocolate.recipe.v1.ListTrashRequest\x1a&.chocolate.recipe.v1.ListTrashResponse\x12c\n" +
+	"\fCountRecipes\x12(.chocolate.recipe.v1.CountRecipesRequest\x1a).chocolate.recipe.v1.CountRecipesResponse\x12g\n" +
+	"\x12CreatePurchaseList\x12..chocolate.recipe.v1.CreatePurchaseListRequest\x1a!.chocolate.recipe.v1.PurchaseList\x12_\n" +
+	"\rImportRecipes\x12).chocolate.recipe.v1.ImportRecipesRequest\x1a!.chocolate.recipe.v1.ImportReport(\x01\x12Y\n" +
+	"\rExportRecipes\x12).chocolate.recipe.v1.ExportRecipesRequest\x1a\x1b.chocolate.recipe.v1.Recipe0\x01BDZBgithub.com/onasunnymorning/go-make-chocolate/pkg/recipepb;recipepbb\x06proto3"
+
+var (
+	file_recipe_proto_rawDescOnce sync.Once
+	file_recipe_proto_rawDescData []byte
+)
+
+func file_recipe_proto_rawDescGZIP() []byte {
+	file_recipe_proto_rawDescOnce.Do(func() {
+		file_recipe_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_recipe_proto_rawDesc), len(file_recipe_proto_rawDesc)))
+	})
+	return file_recipe_proto_rawDescData
+}
+
+var file_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_recipe_proto_goTypes = []any{
+	(*Quantity)(nil),                  // 0: chocolate.recipe.v1.Quantity
+	(*Origin)(nil),                    // 1: chocolate.recipe.v1.Origin
+	(*Ingredient)(nil),                // 2: chocolate.recipe.v1.Ingredient
+	(*ProcessLoss)(nil),               // 3: chocolate.recipe.v1.ProcessLoss
+	(*TemperingCurve)(nil),            // 4: chocolate.recipe.v1.TemperingCurve
+	(*CurvePoint)(nil),                // 5: chocolate.recipe.v1.CurvePoint
+	(*RoastProfile)(nil),              // 6: chocolate.recipe.v1.RoastProfile
+	(*RefiningProfile)(nil),           // 7: chocolate.recipe.v1.RefiningProfile
+	(*ConchingProfile)(nil),           // 8: chocolate.recipe.v1.ConchingProfile
+	(*ProcessProfile)(nil),            // 9: chocolate.recipe.v1.ProcessProfile
+	(*Nutrition)(nil),                 // 10: chocolate.recipe.v1.Nutrition
+	(*Recipe)(nil),                    // 11: chocolate.recipe.v1.Recipe
+	(*TemplateIngredient)(nil),        // 12: chocolate.recipe.v1.TemplateIngredient
+	(*TemplateRecipe)(nil),            // 13: chocolate.recipe.v1.TemplateRecipe
+	(*CreateRecipeRequest)(nil),       // 14: chocolate.recipe.v1.CreateRecipeRequest
+	(*GetRecipeRequest)(nil),          // 15: chocolate.recipe.v1.GetRecipeRequest
+	(*GetTemplateRequest)(nil),        // 16: chocolate.recipe.v1.GetTemplateRequest
+	(*UpdateRecipeRequest)(nil),       // 17: chocolate.recipe.v1.UpdateRecipeRequest
+	(*UpdateRecipeResponse)(nil),      // 18: chocolate.recipe.v1.UpdateRecipeResponse
+	(*TransitionRecipeRequest)(nil),   // 19: chocolate.recipe.v1.TransitionRecipeRequest
+	(*DeleteRecipeRequest)(nil),       // 20: chocolate.recipe.v1.DeleteRecipeRequest
+	(*RestoreRecipeRequest)(nil),      // 21: chocolate.recipe.v1.RestoreRecipeRequest
+	(*RecipeFilter)(nil),              // 22: chocolate.recipe.v1.RecipeFilter
+	(*ListRecipesRequest)(nil),        // 23: chocolate.recipe.v1.ListRecipesRequest
+	(*ListRecipesResponse)(nil),       // 24: chocolate.recipe.v1.ListRecipesResponse
+	(*ListTrashRequest)(nil),          // 25: chocolate.recipe.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),         // 26: chocolate.recipe.v1.ListTrashResponse
+	(*CountRecipesRequest)(nil),       // 27: chocolate.recipe.v1.CountRecipesRequest
+	(*CountRecipesResponse)(nil),      // 28: chocolate.recipe.v1.CountRecipesResponse
+	(*PlanItem)(nil),                  // 29: chocolate.recipe.v1.PlanItem
+	(*CreatePurchaseListRequest)(nil), // 30: chocolate.recipe.v1.CreatePurchaseListRequest
+	(*PurchaseItem)(nil),              // 31: chocolate.recipe.v1.PurchaseItem
+	(*PurchaseList)(nil),              // 32: chocolate.recipe.v1.PurchaseList
+	(*ImportRecipesRequest)(nil),      // 33: chocolate.recipe.v1.ImportRecipesRequest
+	(*ImportResult)(nil),              // 34: chocolate.recipe.v1.ImportResult
+	(*ImportReport)(nil),              // 35: chocolate.recipe.v1.ImportReport
+	(*ExportRecipesRequest)(nil),      // 36: chocolate.recipe.v1.ExportRecipesRequest
+	(*timestamppb.Timestamp)(nil),     // 37: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 38: google.protobuf.Empty
+}
+var file_recipe_proto_depIdxs = []int32{
+	0,  // 0: chocolate.recipe.v1.Ingredient.quantity:type_name -> chocolate.recipe.v1.Quantity
+	1,  // 1: chocolate.recipe.v1.Ingredient.origin:type_name -> chocolate.recipe.v1.Origin
+	5,  // 2: chocolate.recipe.v1.RoastProfile.points:type_name -> chocolate.recipe.v1.CurvePoint
+	6,  // 3: chocolate.recipe.v1.ProcessProfile.roast:type_name -> chocolate.recipe.v1.RoastProfile
+	7,  // 4: chocolate.recipe.v1.ProcessProfile.refining:type_name -> chocolate.recipe.v1.RefiningProfile
+	8,  // 5: chocolate.recipe.v1.ProcessProfile.conching:type_name -> chocolate.recipe.v1.ConchingProfile
+	2,  // 6: chocolate.recipe.v1.Recipe.ingredients:type_name -> chocolate.recipe.v1.Ingredient
+	37, // 7: chocolate.recipe.v1.Recipe.created_at:type_name -> google.protobuf.Timestamp
+	37, // 8: chocolate.recipe.v1.Recipe.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: chocolate.recipe.v1.Recipe.yield:type_name -> chocolate.recipe.v1.Quantity
+	0,  // 10: chocolate.recipe.v1.Recipe.input:type_name -> chocolate.recipe.v1.Quantity
+	3,  // 11: chocolate.recipe.v1.Recipe.losses:type_name -> chocolate.recipe.v1.ProcessLoss
+	4,  // 12: chocolate.recipe.v1.Recipe.tempering:type_name -> chocolate.recipe.v1.TemperingCurve
+	9,  // 13: chocolate.recipe.v1.Recipe.process:type_name -> chocolate.recipe.v1.ProcessProfile
+	10, // 14: chocolate.recipe.v1.Recipe.nutrition:type_name -> chocolate.recipe.v1.Nutrition
+	37, // 15: chocolate.recipe.v1.Recipe.approved_at:type_name -> google.protobuf.Timestamp
+	37, // 16: chocolate.recipe.v1.Recipe.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 17: chocolate.recipe.v1.TemplateIngredient.origin:type_name -> chocolate.recipe.v1.Origin
+	12, // 18: chocolate.recipe.v1.TemplateRecipe.ingredients:type_name -> chocolate.recipe.v1.TemplateIngredient
+	3,  // 19: chocolate.recipe.v1.TemplateRecipe.losses:type_name -> chocolate.recipe.v1.ProcessLoss
+	4,  // 20: chocolate.recipe.v1.TemplateRecipe.tempering:type_name -> chocolate.recipe.v1.TemperingCurve
+	9,  // 21: chocolate.recipe.v1.TemplateRecipe.process:type_name -> chocolate.recipe.v1.ProcessProfile
+	10, // 22: chocolate.recipe.v1.TemplateRecipe.nutrition:type_name -> chocolate.recipe.v1.Nutrition
+	11, // 23: chocolate.recipe.v1.CreateRecipeRequest.recipe:type_name -> chocolate.recipe.v1.Recipe
+	11, // 24: chocolate.recipe.v1.UpdateRecipeRequest.recipe:type_name -> chocolate.recipe.v1.Recipe
+	11, // 25: chocolate.recipe.v1.UpdateRecipeResponse.recipe:type_name -> chocolate.recipe.v1.Recipe
+	22, // 26: chocolate.recipe.v1.ListRecipesRequest.filter:type_name -> chocolate.recipe.v1.RecipeFilter
+	11, // 27: chocolate.recipe.v1.ListRecipesResponse.recipes:type_name -> chocolate.recipe.v1.Recipe
+	11, // 28: chocolate.recipe.v1.ListTrashResponse.recipes:type_name -> chocolate.recipe.v1.Recipe
+	29, // 29: chocolate.recipe.v1.CreatePurchaseListRequest.items:type_name -> chocolate.recipe.v1.PlanItem
+	2,  // 30: chocolate.recipe.v1.CreatePurchaseListRequest.inventory:type_name -> chocolate.recipe.v1.Ingredient
+	0,  // 31: chocolate.recipe.v1.PurchaseItem.required:type_name -> chocolate.recipe.v1.Quantity
+	0,  // 32: chocolate.recipe.v1.PurchaseItem.on_hand:type_name -> chocolate.recipe.v1.Quantity
+	0,  // 33: chocolate.recipe.v1.PurchaseItem.to_buy:type_name -> chocolate.recipe.v1.Quantity
+	31, // 34: chocolate.recipe.v1.PurchaseList.items:type_name -> chocolate.recipe.v1.PurchaseItem
+	11, // 35: chocolate.recipe.v1.ImportRecipesRequest.recipe:type_name -> chocolate.recipe.v1.Recipe
+	34, // 36: chocolate.recipe.v1.ImportReport.results:type_name -> chocolate.recipe.v1.ImportResult
+	22, // 37: chocolate.recipe.v1.ExportRecipesRequest.filter:type_name -> chocolate.recipe.v1.RecipeFilter
+	14, // 38: chocolate.recipe.v1.RecipeService.CreateRecipe:input_type -> chocolate.recipe.v1.CreateRecipeRequest
+	15, // 39: chocolate.recipe.v1.RecipeService.GetRecipe:input_type -> chocolate.recipe.v1.GetRecipeRequest
+	16, // 40: chocolate.recipe.v1.RecipeService.GetTemplate:input_type -> chocolate.recipe.v1.GetTemplateRequest
+	17, // 41: chocolate.recipe.v1.RecipeService.UpdateRecipe:input_type -> chocolate.recipe.v1.UpdateRecipeRequest
+	19, // 42: chocolate.recipe.v1.RecipeService.TransitionRecipe:input_type -> chocolate.recipe.v1.TransitionRecipeRequest
+	20, // 43: chocolate.recipe.v1.RecipeService.DeleteRecipe:input_type -> chocolate.recipe.v1.DeleteRecipeRequest
+	21, // 44: chocolate.recipe.v1.RecipeService.RestoreRecipe:input_type -> chocolate.recipe.v1.RestoreRecipeRequest
+	23, // 45: chocolate.recipe.v1.RecipeService.ListRecipes:input_type -> chocolate.recipe.v1.ListRecipesRequest
+	25, // 46: chocolate.recipe.v1.RecipeService.ListTrash:input_type -> chocolate.recipe.v1.ListTrashRequest
+	27, // 47: chocolate.recipe.v1.RecipeService.CountRecipes:input_type -> chocolate.recipe.v1.CountRecipesRequest
+	30, // 48: chocolate.recipe.v1.RecipeService.CreatePurchaseList:input_type -> chocolate.recipe.v1.CreatePurchaseListRequest
+	33, // 49: chocolate.recipe.v1.RecipeService.ImportRecipes:input_type -> chocolate.recipe.v1.ImportRecipesRequest
+	36, // 50: chocolate.recipe.v1.RecipeService.ExportRecipes:input_type -> chocolate.recipe.v1.ExportRecipesRequest
+	11, // 51: chocolate.recipe.v1.RecipeService.CreateRecipe:output_type -> chocolate.recipe.v1.Recipe
+	11, // 52: chocolate.recipe.v1.RecipeService.GetRecipe:output_type -> chocolate.recipe.v1.Recipe
+	13, // 53: chocolate.recipe.v1.RecipeService.GetTemplate:output_type -> chocolate.recipe.v1.TemplateRecipe
+	18, // 54: chocolate.recipe.v1.RecipeService.UpdateRecipe:output_type -> chocolate.recipe.v1.UpdateRecipeResponse
+	11, // 55: chocolate.recipe.v1.RecipeService.TransitionRecipe:output_type -> chocolate.recipe.v1.Recipe
+	38, // 56: chocolate.recipe.v1.RecipeService.DeleteRecipe:output_type -> google.protobuf.Empty
+	11, // 57: chocolate.recipe.v1.RecipeService.RestoreRecipe:output_type -> chocolate.recipe.v1.Recipe
+	24, // 58: chocolate.recipe.v1.RecipeService.ListRecipes:output_type -> chocolate.recipe.v1.ListRecipesResponse
+	26, // 59: chocolate.recipe.v1.RecipeService.ListTrash:output_type -> chocolate.recipe.v1.ListTrashResponse
+	28, // 60: chocolate.recipe.v1.RecipeService.CountRecipes:output_type -> chocolate.recipe.v1.CountRecipesResponse
+	32, // 61: chocolate.recipe.v1.RecipeService.CreatePurchaseList:output_type -> chocolate.recipe.v1.PurchaseList
+	35, // 62: chocolate.recipe.v1.RecipeService.ImportRecipes:output_type -> chocolate.recipe.v1.ImportReport
+	11, // 63: chocolate.recipe.v1.RecipeService.ExportRecipes:output_type -> chocolate.recipe.v1.Recipe
+	51, // [51:64] is the sub-list for method output_type
+	38, // [38:51] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_recipe_proto_init() }
+func file_recipe_proto_init() {
+	if File_recipe_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_recipe_proto_rawDesc), len(file_recipe_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   37,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_recipe_proto_goTypes,
+		DependencyIndexes: file_recipe_proto_depIdxs,
+		MessageInfos:      file_recipe_proto_msgTypes,
+	}.Build()
+	File_recipe_proto = out.File
+	file_recipe_proto_goTypes = nil
+	file_recipe_proto_depIdxs = nil
+}
@@ -0,0 +1,612 @@
+// Recipe API over gRPC. The messages mirror the types of the recipe package: field names follow the Go fields,
+// units, statuses and classifications are the same strings, and times are UTC timestamps.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: recipe.proto
+
+package recipepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RecipeService_CreateRecipe_FullMethodName       = "/chocolate.recipe.v1.RecipeService/CreateRecipe"
+	RecipeService_GetRecipe_FullMethodName          = "/chocolate.recipe.v1.RecipeService/GetRecipe"
+	RecipeService_GetTemplate_FullMethodName        = "/chocolate.recipe.v1.RecipeService/GetTemplate"
+	RecipeService_UpdateRecipe_FullMethodName       = "/chocolate.recipe.v1.RecipeService/UpdateRecipe"
+	RecipeService_TransitionRecipe_FullMethodName   = "/chocolate.recipe.v1.RecipeService/TransitionRecipe"
+	RecipeService_DeleteRecipe_FullMethodName       = "/chocolate.recipe.v1.RecipeService/DeleteRecipe"
+	RecipeService_RestoreRecipe_FullMethodName      = "/chocolate.recipe.v1.RecipeService/RestoreRecipe"
+	RecipeService_ListRecipes_FullMethodName        = "/chocolate.recipe.v1.RecipeService/ListRecipes"
+	RecipeService_ListTrash_FullMethodName          = "/chocolate.recipe.v1.RecipeService/ListTrash"
+	RecipeService_CountRecipes_FullMethodName       = "/chocolate.recipe.v1.RecipeService/CountRecipes"
+	RecipeService_CreatePurchaseList_FullMethodName = "/chocolate.recipe.v1.RecipeService/CreatePurchaseList"
+	RecipeService_ImportRecipes_FullMethodName      = "/chocolate.recipe.v1.RecipeService/ImportRecipes"
+	RecipeService_ExportRecipes_FullMethodName      = "/chocolate.recipe.v1.RecipeService/ExportRecipes"
+)
+
+// RecipeServiceClient is the client API for RecipeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RecipeService manages recipes like the /recipe endpoints of the REST API.
+// The caller is identified by the x-user and x-roles metadata, and x-request-id correlates the call with the audit log.
+type RecipeServiceClient interface {
+	// CreateRecipe creates a draft recipe
+	CreateRecipe(ctx context.Context, in *CreateRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	// GetRecipe retrieves a recipe, scaled to a yield when one is given
+	GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	// GetTemplate retrieves a recipe with the ingredients as percentages
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*TemplateRecipe, error)
+	// UpdateRecipe updates a draft in place, or saves the changes to an approved recipe as a new draft revision
+	UpdateRecipe(ctx context.Context, in *UpdateRecipeRequest, opts ...grpc.CallOption) (*UpdateRecipeResponse, error)
+	// TransitionRecipe moves a recipe through its lifecycle
+	TransitionRecipe(ctx context.Context, in *TransitionRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	// DeleteRecipe moves a recipe to the trash
+	DeleteRecipe(ctx context.Context, in *DeleteRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RestoreRecipe takes a recipe out of the trash
+	RestoreRecipe(ctx context.Context, in *RestoreRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	// ListRecipes lists a page of the recipes matching a filter in a sort order
+	ListRecipes(ctx context.Context, in *ListRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error)
+	// ListTrash lists the recipes in the trash with pagination, most recently deleted first
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// CountRecipes returns the total number of recipes
+	CountRecipes(ctx context.Context, in *CountRecipesRequest, opts ...grpc.CallOption) (*CountRecipesResponse, error)
+	// CreatePurchaseList returns the consolidated list of ingredients to buy for a production plan
+	CreatePurchaseList(ctx context.Context, in *CreatePurchaseListRequest, opts ...grpc.CallOption) (*PurchaseList, error)
+	// ImportRecipes creates the streamed recipes, or only validates them when the first message sets dry_run,
+	// and reports the outcome of every recipe
+	ImportRecipes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRecipesRequest, ImportReport], error)
+	// ExportRecipes streams every recipe matching a filter
+	ExportRecipes(ctx context.Context, in *ExportRecipesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Recipe], error)
+}
+
+type recipeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecipeServiceClient(cc grpc.ClientConnInterface) RecipeServiceClient {
+	return &recipeServiceClient{cc}
+}
+
+func (c *recipeServiceClient) CreateRecipe(ctx context.Context, in *CreateRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_CreateRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_GetRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*TemplateRecipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TemplateRecipe)
+	err := c.cc.Invoke(ctx, RecipeService_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) UpdateRecipe(ctx context.Context, in *UpdateRecipeRequest, opts ...grpc.CallOption) (*UpdateRecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRecipeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_UpdateRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) TransitionRecipe(ctx context.Context, in *TransitionRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_TransitionRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DeleteRecipe(ctx context.Context, in *DeleteRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_DeleteRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RestoreRecipe(ctx context.Context, in *RestoreRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_RestoreRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListRecipes(ctx context.Context, in *ListRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) CountRecipes(ctx context.Context, in *CountRecipesRequest, opts ...grpc.CallOption) (*CountRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountRecipesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_CountRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) CreatePurchaseList(ctx context.Context, in *CreatePurchaseListRequest, opts ...grpc.CallOption) (*PurchaseList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseList)
+	err := c.cc.Invoke(ctx, RecipeService_CreatePurchaseList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ImportRecipes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRecipesRequest, ImportReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecipeService_ServiceDesc.Streams[0], RecipeService_ImportRecipes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRecipesRequest, ImportReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ImportRecipesClient = grpc.ClientStreamingClient[ImportRecipesRequest, ImportReport]
+
+func (c *recipeServiceClient) ExportRecipes(ctx context.Context, in *ExportRecipesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Recipe], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecipeService_ServiceDesc.Streams[1], RecipeService_ExportRecipes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRecipesRequest, Recipe]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ExportRecipesClient = grpc.ServerStreamingClient[Recipe]
+
+// RecipeServiceServer is the server API for RecipeService service.
+// All implementations must embed UnimplementedRecipeServiceServer
+// for forward compatibility.
+//
+// RecipeService manages recipes like the /recipe endpoints of the REST API.
+// The caller is identified by the x-user and x-roles metadata, and x-request-id correlates the call with the audit log.
+type RecipeServiceServer interface {
+	// CreateRecipe creates a draft recipe
+	CreateRecipe(context.Context, *CreateRecipeRequest) (*Recipe, error)
+	// GetRecipe retrieves a recipe, scaled to a yield when one is given
+	GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error)
+	// GetTemplate retrieves a recipe with the ingredients as percentages
+	GetTemplate(context.Context, *GetTemplateRequest) (*TemplateRecipe, error)
+	// UpdateRecipe updates a draft in place, or saves the changes to an approved recipe as a new draft revision
+	UpdateRecipe(context.Context, *UpdateRecipeRequest) (*UpdateRecipeResponse, error)
+	// TransitionRecipe moves a recipe through its lifecycle
+	TransitionRecipe(context.Context, *TransitionRecipeRequest) (*Recipe, error)
+	// DeleteRecipe moves a recipe to the trash
+	DeleteRecipe(context.Context, *DeleteRecipeRequest) (*emptypb.Empty, error)
+	// RestoreRecipe takes a recipe out of the trash
+	RestoreRecipe(context.Context, *RestoreRecipeRequest) (*Recipe, error)
+	// ListRecipes lists a page of the recipes matching a filter in a sort order
+	ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error)
+	// ListTrash lists the recipes in the trash with pagination, most recently deleted first
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// CountRecipes returns the total number of recipes
+	CountRecipes(context.Context, *CountRecipesRequest) (*CountRecipesResponse, error)
+	// CreatePurchaseList returns the consolidated list of ingredients to buy for a production plan
+	CreatePurchaseList(context.Context, *CreatePurchaseListRequest) (*PurchaseList, error)
+	// ImportRecipes creates the streamed recipes, or only validates them when the first message sets dry_run,
+	// and reports the outcome of every recipe
+	ImportRecipes(grpc.ClientStreamingServer[ImportRecipesRequest, ImportReport]) error
+	// ExportRecipes streams every recipe matching a filter
+	ExportRecipes(*ExportRecipesRequest, grpc.ServerStreamingServer[Recipe]) error
+	mustEmbedUnimplementedRecipeServiceServer()
+}
+
+// UnimplementedRecipeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeServiceServer struct{}
+
+func (UnimplementedRecipeServiceServer) CreateRecipe(context.Context, *CreateRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetTemplate(context.Context, *GetTemplateRequest) (*TemplateRecipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedRecipeServiceServer) UpdateRecipe(context.Context, *UpdateRecipeRequest) (*UpdateRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) TransitionRecipe(context.Context, *TransitionRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteRecipe(context.Context, *DeleteRecipeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) RestoreRecipe(context.Context, *RestoreRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedRecipeServiceServer) CountRecipes(context.Context, *CountRecipesRequest) (*CountRecipesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) CreatePurchaseList(context.Context, *CreatePurchaseListRequest) (*PurchaseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseList not implemented")
+}
+func (UnimplementedRecipeServiceServer) ImportRecipes(grpc.ClientStreamingServer[ImportRecipesRequest, ImportReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ExportRecipes(*ExportRecipesRequest, grpc.ServerStreamingServer[Recipe]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) mustEmbedUnimplementedRecipeServiceServer() {}
+func (UnimplementedRecipeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRecipeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeServiceServer will
+// result in compilation errors.
+type UnsafeRecipeServiceServer interface {
+	mustEmbedUnimplementedRecipeServiceServer()
+}
+
+func RegisterRecipeServiceServer(s grpc.ServiceRegistrar, srv RecipeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRecipeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeService_ServiceDesc, srv)
+}
+
+func _RecipeService_CreateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CreateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CreateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CreateRecipe(ctx, req.(*CreateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetRecipe(ctx, req.(*GetRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_UpdateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).UpdateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_UpdateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).UpdateRecipe(ctx, req.(*UpdateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_TransitionRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).TransitionRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_TransitionRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).TransitionRecipe(ctx, req.(*TransitionRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DeleteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DeleteRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DeleteRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DeleteRecipe(ctx, req.(*DeleteRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RestoreRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RestoreRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RestoreRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RestoreRecipe(ctx, req.(*RestoreRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListRecipes(ctx, req.(*ListRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_CountRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CountRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CountRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CountRecipes(ctx, req.(*CountRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_CreatePurchaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CreatePurchaseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CreatePurchaseList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CreatePurchaseList(ctx, req.(*CreatePurchaseListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ImportRecipes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RecipeServiceServer).ImportRecipes(&grpc.GenericServerStream[ImportRecipesRequest, ImportReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ImportRecipesServer = grpc.ClientStreamingServer[ImportRecipesRequest, ImportReport]
+
+func _RecipeService_ExportRecipes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRecipesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecipeServiceServer).ExportRecipes(m, &grpc.GenericServerStream[ExportRecipesRequest, Recipe]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ExportRecipesServer = grpc.ServerStreamingServer[Recipe]
+
+// RecipeService_ServiceDesc is the grpc.ServiceDesc for RecipeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecipeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chocolate.recipe.v1.RecipeService",
+	HandlerType: (*RecipeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecipe",
+			Handler:    _RecipeService_CreateRecipe_Handler,
+		},
+		{
+			MethodName: "GetRecipe",
+			Handler:    _RecipeService_GetRecipe_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _RecipeService_GetTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateRecipe",
+			Handler:    _RecipeService_UpdateRecipe_Handler,
+		},
+		{
+			MethodName: "TransitionRecipe",
+			Handler:    _RecipeService_TransitionRecipe_Handler,
+		},
+		{
+			MethodName: "DeleteRecipe",
+			Handler:    _RecipeService_DeleteRecipe_Handler,
+		},
+		{
+			MethodName: "RestoreRecipe",
+			Handler:    _RecipeService_RestoreRecipe_Handler,
+		},
+		{
+			MethodName: "ListRecipes",
+			Handler:    _RecipeService_ListRecipes_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _RecipeService_ListTrash_Handler,
+		},
+		{
+			MethodName: "CountRecipes",
+			Handler:    _RecipeService_CountRecipes_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseList",
+			Handler:    _RecipeService_CreatePurchaseList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportRecipes",
+			Handler:       _RecipeService_ImportRecipes_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportRecipes",
+			Handler:       _RecipeService_ExportRecipes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "recipe.proto",
+}
@@ -0,0 +1,5 @@
+// Package recipepb contains the protobuf messages and gRPC stubs of the Recipe API, generated from recipe.proto.
+// Regenerate them with go generate after changing recipe.proto.
+package recipepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative recipe.proto
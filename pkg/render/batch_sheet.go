@@ -0,0 +1,261 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// MIMEPDF is the MIME type of PDF documents
+const MIMEPDF = "application/pdf"
+
+// ErrInvalidBatchSize is returned when a batch sheet is requested for a batch size that cannot be produced
+var ErrInvalidBatchSize = errors.New("batch size must be greater than zero")
+
+// Layout of the batch sheet in millimeters
+const (
+	sheetMargin     = 15.0
+	sheetLineHeight = 7.0
+	sheetCheckbox   = 4.0
+)
+
+// BatchSheet writes a printable PDF production sheet for a batch of the given size in grams.
+// The template is scaled with TemplateRecipe.ToRecipe, and the sheet lists the quantities to weigh with a checkbox,
+// a field for the actual weight and lot number of every ingredient, the tempering temperatures for the method,
+// the steps and lines for the operators to sign off.
+func BatchSheet(w io.Writer, tr *recipe.TemplateRecipe, yield float64, method recipe.TemperingMethod) error {
+	pdf, err := newBatchSheet(tr, yield, method, time.Now())
+	if err != nil {
+		return err
+	}
+	return pdf.Output(w)
+}
+
+// newBatchSheet lays out the batch sheet, printed at the given time
+func newBatchSheet(tr *recipe.TemplateRecipe, yield float64, method recipe.TemperingMethod, printedAt time.Time) (*fpdf.Fpdf, error) {
+	rcp := tr.ToRecipe(yield)
+	if rcp == nil {
+		return nil, ErrInvalidBatchSize
+	}
+	plan, err := rcp.TemperingPlan(method, yield)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(sheetMargin, sheetMargin, sheetMargin)
+	pdf.SetAutoPageBreak(true, sheetMargin)
+	pdf.SetCreationDate(printedAt)
+	pdf.SetTitle("Batch sheet "+tr.Name, true)
+	pdf.AliasNbPages("")
+	s := &sheet{pdf: pdf, tr: pdf.UnicodeTranslatorFromDescriptor("")}
+	s.width, _ = pdf.GetPageSize()
+	s.width -= 2 * sheetMargin
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-sheetMargin)
+		pdf.SetFont("Helvetica", "I", 8)
+		s.cell(s.width/2, 5, s.tr(tr.Name)+" - printed "+printedAt.Format("2006-01-02 15:04"), "", 0, "L")
+		s.cell(s.width/2, 5, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R")
+	})
+	pdf.AddPage()
+
+	s.header(tr, rcp)
+	s.ingredients(rcp)
+	if len(rcp.Losses) > 0 {
+		s.losses(rcp)
+	}
+	if rcp.Process != nil {
+		s.process(rcp.Process)
+	}
+	s.tempering(plan)
+	if steps := steps(rcp.Instructions); len(steps) > 0 {
+		s.steps(steps)
+	}
+	s.signOff()
+
+	return pdf, pdf.Error()
+}
+
+// sheet writes the sections of a batch sheet
+type sheet struct {
+	pdf   *fpdf.Fpdf
+	tr    func(string) string // Translates UTF-8 to the encoding of the core fonts
+	width float64             // Width between the margins
+}
+
+func (s *sheet) cell(w, h float64, text, border string, ln int, align string) {
+	s.pdf.CellFormat(w, h, text, border, ln, align, false, 0, "")
+}
+
+// title writes the title of a section
+func (s *sheet) title(text string) {
+	s.pdf.Ln(4)
+	s.pdf.SetFont("Helvetica", "B", 12)
+	s.cell(s.width, sheetLineHeight, text, "B", 1, "L")
+	s.pdf.Ln(1)
+	s.pdf.SetFont("Helvetica", "", 10)
+}
+
+// field writes a label followed by a line to fill in
+func (s *sheet) field(label string, w float64, ln int) {
+	labelWidth := s.pdf.GetStringWidth(label) + 2
+	s.cell(labelWidth, sheetLineHeight, label, "", 0, "L")
+	s.cell(w-labelWidth-4, sheetLineHeight, "", "B", 0, "L")
+	s.cell(4, sheetLineHeight, "", "", ln, "L")
+}
+
+// checkbox draws an empty checkbox in a cell of the given width
+func (s *sheet) checkbox(w float64, border string) {
+	x, y := s.pdf.GetX(), s.pdf.GetY()
+	s.pdf.Rect(x+(w-sheetCheckbox)/2, y+(sheetLineHeight-sheetCheckbox)/2, sheetCheckbox, sheetCheckbox, "D")
+	s.cell(w, sheetLineHeight, "", border, 0, "C")
+}
+
+func (s *sheet) header(tr *recipe.TemplateRecipe, rcp *recipe.Recipe) {
+	s.pdf.SetFont("Helvetica", "B", 18)
+	s.cell(s.width, 10, "Batch sheet", "", 1, "L")
+	s.pdf.SetFont("Helvetica", "B", 14)
+	s.pdf.MultiCell(s.width, 7, s.tr(tr.Name), "", "L", false)
+	s.pdf.SetFont("Helvetica", "", 9)
+	if tr.RecipeID != "" {
+		s.cell(s.width, 5, "Recipe "+tr.RecipeID, "", 1, "L")
+	}
+	if tr.Description != "" {
+		s.pdf.MultiCell(s.width, 5, s.tr(tr.Description), "", "L", false)
+	}
+	s.pdf.Ln(3)
+
+	s.pdf.SetFont("Helvetica", "", 10)
+	third := s.width / 3
+	s.field("Batch no.", third, 0)
+	s.field("Date", third, 0)
+	s.field("Operator", third, 1)
+	s.pdf.Ln(2)
+
+	s.pdf.SetFont("Helvetica", "B", 10)
+	quarter := s.width / 4
+	s.cell(quarter, sheetLineHeight, "Batch yield", "", 0, "L")
+	s.cell(quarter, sheetLineHeight, "Gross input", "", 0, "L")
+	s.cell(quarter, sheetLineHeight, "Cacao", "", 0, "L")
+	s.cell(quarter, sheetLineHeight, "Classification", "", 1, "L")
+	s.pdf.SetFont("Helvetica", "", 10)
+	s.cell(quarter, sheetLineHeight, formatQuantity(rcp.Yield), "", 0, "L")
+	s.cell(quarter, sheetLineHeight, formatQuantity(rcp.Input), "", 0, "L")
+	s.cell(quarter, sheetLineHeight, formatPercentage(rcp.CacaoPercentage), "", 0, "L")
+	s.cell(quarter, sheetLineHeight, string(rcp.ResolveClassification()), "", 1, "L")
+}
+
+func (s *sheet) ingredients(rcp *recipe.Recipe) {
+	s.title("Weighing")
+	widths := []float64{10, 60, 28, 28, 38, 16}
+	widths[1] = s.width - widths[0] - widths[2] - widths[3] - widths[4] - widths[5]
+
+	s.pdf.SetFont("Helvetica", "B", 9)
+	for i, heading := range []string{"Done", "Ingredient", "Target", "Weighed", "Lot number", "Initials"} {
+		s.cell(widths[i], sheetLineHeight, heading, "1", 0, "C")
+	}
+	s.pdf.Ln(-1)
+
+	s.pdf.SetFont("Helvetica", "", 10)
+	for _, ing := range rcp.Ingredients {
+		name := ing.Name
+		if ing.Origin != nil && ing.Origin.Country != "" {
+			name += " (" + ing.Origin.Country + ")"
+		}
+		s.checkbox(widths[0], "1")
+		s.cell(widths[1], sheetLineHeight, s.tr(name), "1", 0, "L")
+		s.cell(widths[2], sheetLineHeight, formatQuantity(ing.Quantity), "1", 0, "R")
+		for _, w := range widths[3:] {
+			s.cell(w, sheetLineHeight, "", "1", 0, "L")
+		}
+		s.pdf.Ln(-1)
+	}
+}
+
+func (s *sheet) losses(rcp *recipe.Recipe) {
+	s.title("Expected process losses")
+	for _, loss := range rcp.Losses {
+		s.cell(s.width, 6, s.tr(loss.Stage)+": "+formatPercentage(loss.Percentage), "", 1, "L")
+	}
+}
+
+func (s *sheet) process(p *recipe.ProcessProfile) {
+	s.title("Process")
+	if p.Roast != nil && len(p.Roast.Points) > 0 {
+		peak := 0.0
+		for _, point := range p.Roast.Points {
+			peak = max(peak, point.Temperature)
+		}
+		s.cell(s.width, 6, s.tr(fmt.Sprintf("Roast: %s minutes, peaking at %s °C", formatAmount(p.Roast.Duration()), formatAmount(peak))), "", 1, "L")
+	}
+	if p.WinnowingRatio > 0 {
+		s.cell(s.width, 6, "Winnowing: "+formatPercentage(p.WinnowingRatio*100)+" recovered as nibs", "", 1, "L")
+	}
+	if p.Refining != nil {
+		s.cell(s.width, 6, s.tr(fmt.Sprintf("Refining: %s hours to %s µm", formatAmount(p.Refining.DurationHours), formatAmount(p.Refining.TargetParticleSize))), "", 1, "L")
+	}
+	if p.Conching != nil {
+		s.cell(s.width, 6, s.tr(fmt.Sprintf("Conching: %s hours at %s °C", formatAmount(p.Conching.DurationHours), formatAmount(p.Conching.Temperature))), "", 1, "L")
+	}
+}
+
+func (s *sheet) tempering(plan *recipe.TemperingPlan) {
+	s.title("Tempering (" + string(plan.Method) + " method)")
+	widths := []float64{40, 40, s.width - 80}
+
+	s.pdf.SetFont("Helvetica", "B", 9)
+	for i, heading := range []string{"Stage", "Target", "Measured"} {
+		s.cell(widths[i], sheetLineHeight, heading, "1", 0, "C")
+	}
+	s.pdf.Ln(-1)
+	s.pdf.SetFont("Helvetica", "", 10)
+	for _, stage := range []struct {
+		name        string
+		temperature float64
+	}{
+		{"Melt", plan.Curve.MeltTemperature},
+		{"Cool", plan.Curve.CoolTemperature},
+		{"Work", plan.Curve.WorkTemperature},
+	} {
+		s.cell(widths[0], sheetLineHeight, stage.name, "1", 0, "L")
+		s.cell(widths[1], sheetLineHeight, s.tr(formatAmount(stage.temperature)+" °C"), "1", 0, "R")
+		s.cell(widths[2], sheetLineHeight, "", "1", 1, "L")
+	}
+
+	s.pdf.Ln(2)
+	switch plan.Method {
+	case recipe.SeedMethod:
+		s.cell(s.width, 6, "Melt "+formatQuantity(plan.Melt)+", then add "+formatQuantity(plan.Seed)+" of tempered seed chocolate", "", 1, "L")
+	case recipe.TablingMethod:
+		s.cell(s.width, 6, "Melt "+formatQuantity(plan.Melt)+", then work "+formatQuantity(plan.Tabled)+" on the table", "", 1, "L")
+	}
+	s.checkbox(10, "")
+	s.cell(s.width-10, sheetLineHeight, " Temper test passed", "", 1, "L")
+}
+
+func (s *sheet) steps(steps []string) {
+	s.title("Steps")
+	for i, step := range steps {
+		s.checkbox(10, "")
+		s.cell(8, sheetLineHeight, strconv.Itoa(i+1)+".", "", 0, "R")
+		s.pdf.MultiCell(s.width-18, sheetLineHeight, s.tr(step), "", "L", false)
+	}
+}
+
+func (s *sheet) signOff() {
+	s.title("Sign-off")
+	third := s.width / 3
+	for _, role := range []string{"Weighed by", "Produced by", "Checked by (QA)"} {
+		s.field(role, third, 0)
+		s.field("Signature", third, 0)
+		s.field("Date", third, 1)
+		s.pdf.Ln(2)
+	}
+}
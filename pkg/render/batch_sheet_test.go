@@ -0,0 +1,66 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+func TestBatchSheet(t *testing.T) {
+	rcp := testRecipe(t)
+	rcp.Losses = []recipe.ProcessLoss{{Stage: "winnowing", Percentage: 20}}
+	rcp.Process = &recipe.ProcessProfile{Conching: &recipe.ConchingProfile{DurationHours: 24, Temperature: 70}}
+
+	pdf, err := newBatchSheet(rcp.ToTemplate(), 5000, recipe.SeedMethod, time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pdf.SetCompression(false)
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, "%PDF-") || !strings.HasSuffix(strings.TrimSpace(out), "%%EOF") {
+		t.Fatal("expected a PDF document")
+	}
+	for _, want := range []string{
+		"(Batch sheet)",
+		"(Dark 70)",
+		"(Cacao nibs)",
+		"(4375 grams)", // 700 g of 1000 g scaled to 5000 g, before 20% winnowing loss
+		"(6250 grams)", // gross input
+		"(Lot number)",
+		"(31 \xb0C)", // work temperature of dark chocolate, in the encoding of the core fonts
+		"(Melt 3750 grams, then add 1250 grams of tempered seed chocolate)",
+		"(Conching: 24 hours at 70 \xb0C)",
+		"(Conche for 24 hours.)",
+		"(Checked by \\(QA\\))",
+		"(Page 1 of 1)",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in the batch sheet", want)
+		}
+	}
+}
+
+func TestBatchSheetErrors(t *testing.T) {
+	template := testRecipe(t).ToTemplate()
+	if err := BatchSheet(&bytes.Buffer{}, template, 0, recipe.SeedMethod); err != ErrInvalidBatchSize {
+		t.Errorf("expected ErrInvalidBatchSize, got %v", err)
+	}
+	if err := BatchSheet(&bytes.Buffer{}, template, 1000, recipe.TemperingMethod("microwave")); err != recipe.ErrInvalidTemperingMethod {
+		t.Errorf("expected ErrInvalidTemperingMethod, got %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := BatchSheet(&buf, template, 1000, recipe.TablingMethod); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+		t.Error("expected a PDF document")
+	}
+}
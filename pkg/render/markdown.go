@@ -0,0 +1,61 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// markdownEscaper escapes the characters that would break a Markdown table cell
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+// RecipeMarkdown writes the recipe as a Markdown recipe card with its yield, cacao percentage, ingredient table and steps
+func RecipeMarkdown(w io.Writer, r *recipe.Recipe) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "# %s\n\n", r.Name)
+	if r.Description != "" {
+		fmt.Fprintf(bw, "%s\n\n", r.Description)
+	}
+
+	fmt.Fprintf(bw, "- **Yield:** %s\n", formatQuantity(r.Yield))
+	if len(r.Losses) > 0 {
+		fmt.Fprintf(bw, "- **Input:** %s\n", formatQuantity(r.Input))
+	}
+	fmt.Fprintf(bw, "- **Cacao:** %s\n", formatPercentage(r.CacaoPercentage))
+	if c := r.ResolveClassification(); c != "" {
+		fmt.Fprintf(bw, "- **Classification:** %s\n", c)
+	}
+
+	bw.WriteString("\n## Ingredients\n\n")
+	bw.WriteString("| Ingredient | Quantity | Share | Cacao |\n")
+	bw.WriteString("| --- | ---: | ---: | :---: |\n")
+	shares := shares(r)
+	for i, ing := range r.Ingredients {
+		cacao := ""
+		if ing.IsCacao {
+			cacao = "✓"
+		}
+		fmt.Fprintf(bw, "| %s | %s | %s | %s |\n",
+			markdownEscaper.Replace(ing.Name), formatQuantity(ing.Quantity), formatPercentage(shares[i]), cacao)
+	}
+
+	if len(r.Losses) > 0 {
+		bw.WriteString("\n## Process losses\n\n")
+		for _, loss := range r.Losses {
+			fmt.Fprintf(bw, "- %s: %s\n", loss.Stage, formatPercentage(loss.Percentage))
+		}
+	}
+
+	if steps := steps(r.Instructions); len(steps) > 0 {
+		bw.WriteString("\n## Steps\n\n")
+		for i, step := range steps {
+			fmt.Fprintf(bw, "%d. %s\n", i+1, step)
+		}
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,99 @@
+// Package render writes recipes as YAML, Markdown and plain text recipe cards.
+// It is used by the REST API for content negotiation and can be used on its own, for example to print recipe cards.
+package render
+
+import (
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// Format is a format a recipe can be rendered in
+type Format string
+
+const (
+	YAML     Format = "yaml"
+	Markdown Format = "markdown"
+	Text     Format = "text"
+)
+
+// MIME types of the formats
+const (
+	MIMEYAML     = "application/yaml"
+	MIMEMarkdown = "text/markdown"
+	MIMEText     = "text/plain"
+)
+
+// ErrUnknownFormat is returned for formats that are not supported
+var ErrUnknownFormat = errors.New("unknown format, expected yaml, markdown or text")
+
+// Formats returns the supported formats
+func Formats() []Format {
+	return []Format{YAML, Markdown, Text}
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	switch f {
+	case YAML:
+		return MIMEYAML
+	case Markdown:
+		return MIMEMarkdown
+	default:
+		return MIMEText
+	}
+}
+
+// Recipe writes the recipe in the given format
+func Recipe(w io.Writer, f Format, r *recipe.Recipe) error {
+	switch f {
+	case YAML:
+		return RecipeYAML(w, r)
+	case Markdown:
+		return RecipeMarkdown(w, r)
+	case Text:
+		return RecipeText(w, r)
+	default:
+		return ErrUnknownFormat
+	}
+}
+
+// formatAmount rounds an amount to a precision that is useful on a scale, dropping trailing zeros
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(math.Round(amount*10)/10, 'f', -1, 64)
+}
+
+// formatQuantity formats a quantity for a recipe card, like "233.3 grams"
+func formatQuantity(q recipe.Quantity) string {
+	return formatAmount(q.Amount) + " " + string(q.Unit)
+}
+
+// formatPercentage formats a percentage with one decimal, like "70.5%"
+func formatPercentage(p float64) string {
+	return strconv.FormatFloat(p, 'f', 1, 64) + "%"
+}
+
+// steps splits instructions into one step per non-blank line
+func steps(instructions string) []string {
+	var steps []string
+	for _, line := range strings.Split(instructions, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			steps = append(steps, line)
+		}
+	}
+	return steps
+}
+
+// shares returns the share of every ingredient in the recipe as a percentage
+func shares(r *recipe.Recipe) []float64 {
+	template := r.ToTemplate()
+	shares := make([]float64, len(template.Ingredients))
+	for i, ing := range template.Ingredients {
+		shares[i] = ing.Percentage
+	}
+	return shares
+}
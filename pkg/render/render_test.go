@@ -0,0 +1,106 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+func testRecipe(t *testing.T) *recipe.Recipe {
+	t.Helper()
+	rcp, err := recipe.NewRecipe("Dark 70", "A dark bar", []recipe.Ingredient{
+		{Name: "Cacao nibs", IsCacao: true, Quantity: recipe.Quantity{Amount: 700, Unit: recipe.Gram}},
+		{Name: "Cane sugar | fine", Quantity: recipe.Quantity{Amount: 300, Unit: recipe.Gram}},
+	}, "Roast the beans.\n\nConche for 24 hours.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rcp
+}
+
+func TestRecipeYAML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RecipeYAML(&buf, testRecipe(t)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"Name: Dark 70\n", "  - Name: Cacao nibs\n", "    IsCacao: true\n", "CacaoPercentage: 70\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "{") || strings.Contains(out, `"Name"`) {
+		t.Errorf("expected block style YAML, got\n%s", out)
+	}
+
+	var doc struct {
+		Name        string `yaml:"Name"`
+		Ingredients []struct {
+			Name     string `yaml:"Name"`
+			Quantity struct {
+				Amount float64 `yaml:"Amount"`
+			} `yaml:"Quantity"`
+		} `yaml:"Ingredients"`
+	}
+	if err := yaml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Name != "Dark 70" || len(doc.Ingredients) != 2 || doc.Ingredients[1].Quantity.Amount != 300 {
+		t.Errorf("unexpected document %+v", doc)
+	}
+}
+
+func TestRecipeMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := RecipeMarkdown(&buf, testRecipe(t)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"# Dark 70\n",
+		"- **Yield:** 1000 grams\n",
+		"- **Cacao:** 70.0%\n",
+		"| Cacao nibs | 700 g | 70.0% | ✓ |\n",
+		`| Cane sugar \| fine | 300 g | 30.0% |  |` + "\n",
+		"1. Roast the beans.\n2. Conche for 24 hours.\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+}
+
+func TestRecipeTextScaled(t *testing.T) {
+	scaled := testRecipe(t).ToTemplate().ToRecipe(333)
+
+	var buf bytes.Buffer
+	if err := RecipeText(&buf, scaled); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"Yield: 333 grams\n",
+		"  233.1 grams  Cacao nibs          70.0%  cacao\n",
+		"   99.9 grams  Cane sugar | fine   30.0%\n",
+		"  2. Conche for 24 hours.\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in\n%s", want, out)
+		}
+	}
+}
+
+func TestRecipeUnknownFormat(t *testing.T) {
+	if err := Recipe(&bytes.Buffer{}, Format("pdf"), testRecipe(t)); err != ErrUnknownFormat {
+		t.Errorf("expected ErrUnknownFormat, got %v", err)
+	}
+	for _, f := range Formats() {
+		if err := Recipe(&bytes.Buffer{}, f, testRecipe(t)); err != nil {
+			t.Errorf("%s: %v", f, err)
+		}
+	}
+}
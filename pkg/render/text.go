@@ -0,0 +1,75 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// RecipeText writes the recipe as a plain text recipe card, with the ingredients in aligned columns
+func RecipeText(w io.Writer, r *recipe.Recipe) error {
+	bw := bufio.NewWriter(w)
+
+	title := strings.ToUpper(r.Name)
+	fmt.Fprintf(bw, "%s\n%s\n", title, strings.Repeat("=", utf8.RuneCountInString(title)))
+	if r.Description != "" {
+		fmt.Fprintf(bw, "%s\n", r.Description)
+	}
+	bw.WriteString("\n")
+
+	fmt.Fprintf(bw, "Yield: %s\n", formatQuantity(r.Yield))
+	if len(r.Losses) > 0 {
+		fmt.Fprintf(bw, "Input: %s\n", formatQuantity(r.Input))
+	}
+	fmt.Fprintf(bw, "Cacao: %s\n", formatPercentage(r.CacaoPercentage))
+	if c := r.ResolveClassification(); c != "" {
+		fmt.Fprintf(bw, "Classification: %s\n", c)
+	}
+
+	// Right-align the quantities and left-align the names, like on a scale ticket
+	quantities := make([]string, len(r.Ingredients))
+	var quantityWidth, nameWidth int
+	for i, ing := range r.Ingredients {
+		quantities[i] = formatQuantity(ing.Quantity)
+		quantityWidth = max(quantityWidth, utf8.RuneCountInString(quantities[i]))
+		nameWidth = max(nameWidth, utf8.RuneCountInString(ing.Name))
+	}
+	bw.WriteString("\nIngredients\n")
+	shares := shares(r)
+	for i, ing := range r.Ingredients {
+		line := fmt.Sprintf("  %s  %s  %6s", pad(quantities[i], quantityWidth, true), pad(ing.Name, nameWidth, false), formatPercentage(shares[i]))
+		if ing.IsCacao {
+			line += "  cacao"
+		}
+		fmt.Fprintln(bw, line)
+	}
+
+	if len(r.Losses) > 0 {
+		bw.WriteString("\nProcess losses\n")
+		for _, loss := range r.Losses {
+			fmt.Fprintf(bw, "  %s: %s\n", loss.Stage, formatPercentage(loss.Percentage))
+		}
+	}
+
+	if steps := steps(r.Instructions); len(steps) > 0 {
+		bw.WriteString("\nSteps\n")
+		for i, step := range steps {
+			fmt.Fprintf(bw, "  %d. %s\n", i+1, step)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// pad pads s with spaces to the given width in runes, on the left when right-aligning
+func pad(s string, width int, right bool) string {
+	padding := strings.Repeat(" ", max(0, width-utf8.RuneCountInString(s)))
+	if right {
+		return padding + s
+	}
+	return s + padding
+}
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// RecipeYAML writes the recipe as YAML, with the same fields as its JSON representation
+func RecipeYAML(w io.Writer, r *recipe.Recipe) error {
+	return ValueYAML(w, r)
+}
+
+// ValueYAML writes any value as YAML, with the fields named and ordered like in its JSON representation
+func ValueYAML(w io.Writer, v any) error {
+	// Go through JSON so the JSON field names and omitempty options apply
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle drops the JSON quoting and flow style so the document is written as idiomatic YAML
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
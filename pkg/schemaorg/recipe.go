@@ -0,0 +1,299 @@
+// Package schemaorg converts recipes to and from schema.org Recipe JSON-LD, the format search engines read recipes in.
+package schemaorg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+// MIMEJSONLD is the content type of JSON-LD documents
+const MIMEJSONLD = "application/ld+json"
+
+// Context is the JSON-LD context of schema.org documents
+const Context = "https://schema.org"
+
+// Recipe is a schema.org Recipe, see https://schema.org/Recipe.
+type Recipe struct {
+	Context            string                `json:"@context"`
+	Type               string                `json:"@type"`
+	Identifier         string                `json:"identifier,omitempty"`
+	Name               string                `json:"name"`
+	Description        string                `json:"description,omitempty"`
+	Author             *Person               `json:"author,omitempty"`
+	DateCreated        string                `json:"dateCreated,omitempty"`
+	DateModified       string                `json:"dateModified,omitempty"`
+	RecipeCategory     string                `json:"recipeCategory,omitempty"`
+	Keywords           string                `json:"keywords,omitempty"`
+	RecipeYield        string                `json:"recipeYield,omitempty"`
+	RecipeIngredient   []string              `json:"recipeIngredient"`
+	RecipeInstructions Instructions          `json:"recipeInstructions,omitempty"`
+	Nutrition          *NutritionInformation `json:"nutrition,omitempty"`
+}
+
+// Person is a schema.org Person, see https://schema.org/Person.
+type Person struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+// HowToStep is a schema.org HowToStep, see https://schema.org/HowToStep.
+type HowToStep struct {
+	Type string `json:"@type"`
+	Text string `json:"text"`
+}
+
+// NutritionInformation is a schema.org NutritionInformation, see https://schema.org/NutritionInformation.
+// Values are text with a unit, like "9 g".
+type NutritionInformation struct {
+	Type                string `json:"@type"`
+	ServingSize         string `json:"servingSize,omitempty"`
+	Calories            string `json:"calories,omitempty"`
+	FatContent          string `json:"fatContent,omitempty"`
+	SaturatedFatContent string `json:"saturatedFatContent,omitempty"`
+	CarbohydrateContent string `json:"carbohydrateContent,omitempty"`
+	SugarContent        string `json:"sugarContent,omitempty"`
+	ProteinContent      string `json:"proteinContent,omitempty"`
+	FiberContent        string `json:"fiberContent,omitempty"`
+	SodiumContent       string `json:"sodiumContent,omitempty"`
+}
+
+// Instructions are the steps of a recipe. They are written as HowToSteps, and read from any of the forms
+// schema.org allows: a single text, a list of texts, or a list of HowToSteps and HowToSections.
+type Instructions []string
+
+// MarshalJSON writes the instructions as a list of HowToSteps.
+func (in Instructions) MarshalJSON() ([]byte, error) {
+	steps := make([]HowToStep, len(in))
+	for i, text := range in {
+		steps[i] = HowToStep{Type: "HowToStep", Text: text}
+	}
+	return json.Marshal(steps)
+}
+
+// UnmarshalJSON reads instructions from a text, a list of texts, or a list of HowToSteps and HowToSections.
+func (in *Instructions) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*in = splitSteps(text)
+		return nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return errors.New("recipeInstructions must be a text or a list of steps")
+	}
+	var steps Instructions
+	for _, item := range items {
+		if err := json.Unmarshal(item, &text); err == nil {
+			steps = append(steps, splitSteps(text)...)
+			continue
+		}
+		var step struct {
+			Type            string       `json:"@type"`
+			Text            string       `json:"text"`
+			Name            string       `json:"name"`
+			ItemListElement Instructions `json:"itemListElement"`
+		}
+		if err := json.Unmarshal(item, &step); err != nil {
+			return errors.New("recipeInstructions must be a text or a list of steps")
+		}
+		switch {
+		case step.Type == "HowToSection":
+			steps = append(steps, step.ItemListElement...)
+		case step.Text != "":
+			steps = append(steps, step.Text)
+		case step.Name != "":
+			steps = append(steps, step.Name)
+		}
+	}
+	*in = steps
+	return nil
+}
+
+// splitSteps splits instructions into one step per non-blank line.
+func splitSteps(instructions string) []string {
+	var steps []string
+	for _, line := range strings.Split(instructions, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			steps = append(steps, line)
+		}
+	}
+	return steps
+}
+
+// FromRecipe converts a recipe to a schema.org Recipe.
+// Ingredients are written as their quantity followed by their name, like "700 g Cacao nibs",
+// and every line of the instructions becomes a step.
+func FromRecipe(r *recipe.Recipe) *Recipe {
+	out := &Recipe{
+		Context:            Context,
+		Type:               "Recipe",
+		Identifier:         r.ID,
+		Name:               r.Name,
+		Description:        r.Description,
+		RecipeCategory:     "Chocolate",
+		RecipeIngredient:   make([]string, len(r.Ingredients)),
+		RecipeInstructions: splitSteps(r.Instructions),
+	}
+	for i, ing := range r.Ingredients {
+		out.RecipeIngredient[i] = ing.Quantity.String() + " " + ing.Name
+	}
+	if r.Yield.Amount > 0 {
+		out.RecipeYield = r.Yield.String()
+	}
+	if r.CreatedBy != "" {
+		out.Author = &Person{Type: "Person", Name: r.CreatedBy}
+	}
+	if !r.CreatedAt.IsZero() {
+		out.DateCreated = r.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if !r.UpdatedAt.IsZero() {
+		out.DateModified = r.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	var keywords []string
+	if c := r.ResolveClassification(); c != "" {
+		keywords = append(keywords, string(c)+" chocolate")
+	}
+	if r.CacaoPercentage > 0 {
+		keywords = append(keywords, strconv.FormatFloat(r.CacaoPercentage, 'f', 0, 64)+"% cacao")
+	}
+	out.Keywords = strings.Join(keywords, ", ")
+	if r.Nutrition != nil {
+		out.Nutrition = fromNutrition(r.Nutrition)
+	}
+	return out
+}
+
+func fromNutrition(n *recipe.Nutrition) *NutritionInformation {
+	grams := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) + " g" }
+	return &NutritionInformation{
+		Type:                "NutritionInformation",
+		ServingSize:         "100 g",
+		Calories:            strconv.FormatFloat(n.Energy, 'f', -1, 64) + " calories",
+		FatContent:          grams(n.Fat),
+		SaturatedFatContent: grams(n.SaturatedFat),
+		CarbohydrateContent: grams(n.Carbohydrate),
+		SugarContent:        grams(n.Sugar),
+		ProteinContent:      grams(n.Protein),
+		FiberContent:        grams(n.Fiber),
+		SodiumContent:       strconv.FormatFloat(n.Sodium, 'f', -1, 64) + " mg",
+	}
+}
+
+// ToRecipe converts a schema.org Recipe to a recipe.
+// Every ingredient has to start with a quantity, like "700 g Cacao nibs"; ingredients with cacao or cocoa in their name
+// are taken to be cacao. The steps of the instructions are joined with newlines.
+// Nutrition facts are only read when they are given per 100 g, or without a serving size.
+func ToRecipe(in *Recipe) (*recipe.Recipe, error) {
+	if in.Type != "" && in.Type != "Recipe" {
+		return nil, fmt.Errorf("expected a Recipe, got %s", in.Type)
+	}
+	r := &recipe.Recipe{
+		ID:           in.Identifier,
+		Name:         in.Name,
+		Description:  in.Description,
+		Instructions: strings.Join(in.RecipeInstructions, "\n"),
+	}
+	for _, text := range in.RecipeIngredient {
+		ing, err := parseIngredient(text)
+		if err != nil {
+			return nil, err
+		}
+		r.Ingredients = append(r.Ingredients, ing)
+	}
+	if in.Nutrition != nil {
+		n, err := toNutrition(in.Nutrition)
+		if err != nil {
+			return nil, err
+		}
+		r.Nutrition = n
+	}
+	return r, nil
+}
+
+// parseIngredient parses an ingredient like "700 g Cacao nibs".
+func parseIngredient(text string) (recipe.Ingredient, error) {
+	fields := strings.Fields(text)
+	if len(fields) < 3 {
+		return recipe.Ingredient{}, fmt.Errorf("ingredient %q must be a quantity followed by a name, like \"700 g Cacao nibs\"", text)
+	}
+	q, err := recipe.ParseQuantity(fields[0] + " " + fields[1])
+	if err != nil {
+		return recipe.Ingredient{}, fmt.Errorf("ingredient %q: %w", text, err)
+	}
+	name := strings.Join(fields[2:], " ")
+	lower := strings.ToLower(name)
+	return recipe.Ingredient{
+		Name:     name,
+		IsCacao:  strings.Contains(lower, "cacao") || strings.Contains(lower, "cocoa"),
+		Quantity: q,
+	}, nil
+}
+
+// Units of the nutrition values, in grams for masses and in kilocalories for energy.
+// Schema.org gives energy in "calories", meaning kilocalories as on food labels.
+var (
+	massUnits   = map[string]float64{"g": 1, "gram": 1, "grams": 1, "mg": 1e-3, "milligram": 1e-3, "milligrams": 1e-3, "µg": 1e-6, "mcg": 1e-6, "kg": 1e3}
+	energyUnits = map[string]float64{"calories": 1, "calorie": 1, "cal": 1, "kcal": 1, "kj": 1 / 4.184}
+)
+
+// toNutrition parses nutrition information per 100 g, converting the values to the units of recipe.Nutrition.
+func toNutrition(in *NutritionInformation) (*recipe.Nutrition, error) {
+	if in.ServingSize != "" && strings.ReplaceAll(in.ServingSize, " ", "") != "100g" {
+		return nil, fmt.Errorf("nutrition must be given per 100 g, got a serving size of %s", in.ServingSize)
+	}
+	var n recipe.Nutrition
+	for _, f := range []struct {
+		text  string
+		value *float64
+		units map[string]float64
+		unit  string // Unit of the value, assumed if the text has none
+	}{
+		{in.Calories, &n.Energy, energyUnits, "kcal"},
+		{in.FatContent, &n.Fat, massUnits, "g"},
+		{in.SaturatedFatContent, &n.SaturatedFat, massUnits, "g"},
+		{in.CarbohydrateContent, &n.Carbohydrate, massUnits, "g"},
+		{in.SugarContent, &n.Sugar, massUnits, "g"},
+		{in.ProteinContent, &n.Protein, massUnits, "g"},
+		{in.FiberContent, &n.Fiber, massUnits, "g"},
+		{in.SodiumContent, &n.Sodium, massUnits, "mg"},
+	} {
+		if f.text == "" {
+			continue
+		}
+		v, err := parseNutritionValue(f.text, f.units, f.unit)
+		if err != nil {
+			return nil, err
+		}
+		*f.value = v
+	}
+	return &n, nil
+}
+
+// parseNutritionValue parses a value like "0.5 g" or "120kcal" and converts it to the unit.
+func parseNutritionValue(text string, units map[string]float64, unit string) (float64, error) {
+	text = strings.TrimSpace(text)
+	end := strings.IndexFunc(text, func(r rune) bool { return !strings.ContainsRune("0123456789.+-", r) })
+	if end < 0 {
+		end = len(text)
+	}
+	v, err := strconv.ParseFloat(text[:end], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid nutrition value %q", text)
+	}
+	given := strings.ToLower(strings.TrimSpace(text[end:]))
+	if given == "" {
+		return v, nil
+	}
+	factor, ok := units[given]
+	if !ok {
+		return 0, fmt.Errorf("invalid nutrition value %q, unknown unit %q", text, given)
+	}
+	return v * factor / units[unit], nil
+}
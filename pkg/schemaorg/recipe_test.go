@@ -0,0 +1,173 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/onasunnymorning/go-make-chocolate/pkg/recipe"
+)
+
+func TestFromRecipe(t *testing.T) {
+	created := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	rcp := &recipe.Recipe{
+		ID:          "65f1c0ffee",
+		Name:        "Hot chocolate",
+		Description: "Thick drinking chocolate",
+		Ingredients: []recipe.Ingredient{
+			{Name: "Dark chocolate 70%", IsCacao: true, Quantity: recipe.Quantity{Amount: 60, Unit: recipe.Gram}},
+			{Name: "Whole milk", Quantity: recipe.Quantity{Amount: 250, Unit: recipe.Milliliter}},
+		},
+		Instructions: "Heat the milk.\n\n  Whisk in the chocolate.  \n",
+		CreatedBy:    "alice",
+		CreatedAt:    created,
+		UpdatedAt:    created,
+		Yield:        recipe.Quantity{Amount: 310, Unit: recipe.Gram},
+		Nutrition:    &recipe.Nutrition{Energy: 120, Fat: 6.5, Sodium: 40},
+	}
+
+	out := FromRecipe(rcp)
+	if out.Context != Context || out.Type != "Recipe" {
+		t.Errorf("expected a schema.org Recipe, got %s %s", out.Context, out.Type)
+	}
+	wantIngredients := []string{"60 g Dark chocolate 70%", "250 ml Whole milk"}
+	if !reflect.DeepEqual(out.RecipeIngredient, wantIngredients) {
+		t.Errorf("expected ingredients %v, got %v", wantIngredients, out.RecipeIngredient)
+	}
+	wantSteps := Instructions{"Heat the milk.", "Whisk in the chocolate."}
+	if !reflect.DeepEqual(out.RecipeInstructions, wantSteps) {
+		t.Errorf("expected steps %v, got %v", wantSteps, out.RecipeInstructions)
+	}
+	if out.RecipeYield != "310 g" {
+		t.Errorf("expected yield 310 g, got %q", out.RecipeYield)
+	}
+	if out.Author == nil || out.Author.Name != "alice" {
+		t.Errorf("expected author alice, got %+v", out.Author)
+	}
+	if out.DateCreated != "2025-03-01T09:30:00Z" {
+		t.Errorf("unexpected dateCreated %q", out.DateCreated)
+	}
+	if out.Nutrition.Calories != "120 calories" || out.Nutrition.FatContent != "6.5 g" || out.Nutrition.SodiumContent != "40 mg" {
+		t.Errorf("unexpected nutrition %+v", out.Nutrition)
+	}
+
+	data, err := json.Marshal(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	steps := doc["recipeInstructions"].([]any)
+	if step := steps[0].(map[string]any); step["@type"] != "HowToStep" || step["text"] != "Heat the milk." {
+		t.Errorf("expected HowToSteps, got %v", steps)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	rcp := &recipe.Recipe{
+		ID:   "65f1c0ffee",
+		Name: "Ganache",
+		Ingredients: []recipe.Ingredient{
+			{Name: "Cacao mass", IsCacao: true, Quantity: recipe.Quantity{Amount: 200, Unit: recipe.Gram}},
+			{Name: "Cream", Quantity: recipe.Quantity{Amount: 0.2, Unit: recipe.Liter}},
+		},
+		Instructions: "Boil the cream.\nPour over the cacao mass.",
+		Nutrition:    &recipe.Nutrition{Energy: 400, Fat: 30, SaturatedFat: 18, Carbohydrate: 25, Sugar: 20, Protein: 5, Fiber: 4, Sodium: 12},
+	}
+
+	data, err := json.Marshal(FromRecipe(rcp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var in Recipe
+	if err := json.Unmarshal(data, &in); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ToRecipe(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.ID != rcp.ID || got.Name != rcp.Name || got.Instructions != rcp.Instructions {
+		t.Errorf("expected %+v, got %+v", rcp, got)
+	}
+	if !reflect.DeepEqual(got.Ingredients, rcp.Ingredients) {
+		t.Errorf("expected ingredients %+v, got %+v", rcp.Ingredients, got.Ingredients)
+	}
+	if !reflect.DeepEqual(got.Nutrition, rcp.Nutrition) {
+		t.Errorf("expected nutrition %+v, got %+v", rcp.Nutrition, got.Nutrition)
+	}
+}
+
+func TestInstructionsUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want Instructions
+	}{
+		{"text", `"Melt.\nTemper."`, Instructions{"Melt.", "Temper."}},
+		{"texts", `["Melt.", "Temper."]`, Instructions{"Melt.", "Temper."}},
+		{"steps", `[{"@type": "HowToStep", "text": "Melt."}, {"@type": "HowToStep", "name": "Temper."}]`, Instructions{"Melt.", "Temper."}},
+		{"sections", `[{"@type": "HowToSection", "name": "Ganache", "itemListElement": [{"@type": "HowToStep", "text": "Boil."}]}, "Pipe."]`, Instructions{"Boil.", "Pipe."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Instructions
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	var in Instructions
+	if err := json.Unmarshal([]byte(`42`), &in); err == nil {
+		t.Error("expected an error for a number")
+	}
+}
+
+func TestToRecipeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Recipe
+	}{
+		{"type", Recipe{Type: "HowTo", Name: "Not a recipe"}},
+		{"ingredient without quantity", Recipe{Name: "Bar", RecipeIngredient: []string{"Cacao mass"}}},
+		{"ingredient with bad amount", Recipe{Name: "Bar", RecipeIngredient: []string{"a handful of nibs"}}},
+		{"serving size", Recipe{Name: "Bar", Nutrition: &NutritionInformation{ServingSize: "1 bar", Calories: "200 calories"}}},
+		{"nutrition value", Recipe{Name: "Bar", Nutrition: &NutritionInformation{FatContent: "lots"}}},
+		{"blank nutrition value", Recipe{Name: "Bar", Nutrition: &NutritionInformation{FatContent: "  "}}},
+		{"nutrition unit", Recipe{Name: "Bar", Nutrition: &NutritionInformation{FatContent: "6 oz"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ToRecipe(&tt.in); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestToNutritionUnits(t *testing.T) {
+	got, err := toNutrition(&NutritionInformation{
+		Calories:       "2510 kJ",
+		FatContent:     "43g",
+		SugarContent:   "24000 mg",
+		ProteinContent: "8",
+		SodiumContent:  "0.5 g",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := recipe.Nutrition{Energy: 2510 / 4.184, Fat: 43, Sugar: 24, Protein: 8, Sodium: 500}
+	if math.Abs(got.Energy-want.Energy) > 1e-9 || got.Fat != want.Fat || math.Abs(got.Sugar-want.Sugar) > 1e-9 ||
+		got.Protein != want.Protein || math.Abs(got.Sodium-want.Sodium) > 1e-9 {
+		t.Errorf("expected %+v, got %+v", want, *got)
+	}
+}
@@ -0,0 +1,216 @@
+// Package tasting models sensory evaluation of chocolate by tasting panels.
+package tasting
+
+import (
+	"math"
+	"time"
+)
+
+// Error constants for tasting sessions
+var (
+	ErrSubjectRequired   = &Error{"subject_required", "A tasting session must be for a batch or a recipe"}
+	ErrPanelistRequired  = &Error{"panelist_required", "Panelist is required"}
+	ErrUnknownAttribute  = &Error{"unknown_attribute", "Unknown flavor attribute"}
+	ErrInvalidRating     = &Error{"invalid_rating", "Ratings must be between 0 and 10"}
+	ErrDuplicatePanelist = &Error{"duplicate_panelist", "Panelist has already scored this session"}
+	ErrSessionNotFound   = &Error{"session_not_found", "Tasting session not found"}
+)
+
+// Error represents a tasting-specific error
+type Error struct {
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// MinRating and MaxRating bound the intensity scale of flavor attributes.
+const (
+	MinRating = 0.0
+	MaxRating = 10.0
+)
+
+// Attribute is a flavor attribute rated by panelists.
+type Attribute string
+
+const (
+	Cocoa       Attribute = "cocoa"
+	Bitterness  Attribute = "bitterness"
+	Astringency Attribute = "astringency"
+	Fruity      Attribute = "fruity"
+	Floral      Attribute = "floral"
+	Nutty       Attribute = "nutty"
+	Roast       Attribute = "roast"
+	OffFlavor   Attribute = "off_flavor"
+)
+
+// Attributes returns all flavor attributes in the order they are presented on a score sheet.
+func Attributes() []Attribute {
+	return []Attribute{
+		Cocoa,
+		Bitterness,
+		Astringency,
+		Fruity,
+		Floral,
+		Nutty,
+		Roast,
+		OffFlavor,
+	}
+}
+
+func isAttribute(a Attribute) bool {
+	for _, known := range Attributes() {
+		if a == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Score is the evaluation of a single panelist.
+type Score struct {
+	Panelist string
+	Ratings  map[Attribute]float64 // Intensity per attribute, attributes that were not rated are omitted
+	Notes    string
+}
+
+// Validate checks that the score has a panelist and only known attributes rated within the scale.
+func (s Score) Validate() error {
+	if s.Panelist == "" {
+		return ErrPanelistRequired
+	}
+	for attribute, rating := range s.Ratings {
+		if !isAttribute(attribute) {
+			return ErrUnknownAttribute
+		}
+		if rating < MinRating || rating > MaxRating {
+			return ErrInvalidRating
+		}
+	}
+	return nil
+}
+
+// Session is a tasting panel evaluating a batch or a recipe.
+type Session struct {
+	ID        string
+	BatchID   string // Batch being tasted, if any
+	RecipeID  string // Recipe being tasted, derived from the batch if a batch is tasted
+	HeldAt    time.Time
+	Panelists []string
+	Scores    []Score
+	CreatedAt time.Time
+}
+
+// NewSession creates a new tasting session for a batch and/or a recipe.
+func NewSession(batchID, recipeID string, heldAt time.Time, panelists []string) (*Session, error) {
+	if batchID == "" && recipeID == "" {
+		return nil, ErrSubjectRequired
+	}
+	if heldAt.IsZero() {
+		heldAt = time.Now()
+	}
+	return &Session{
+		BatchID:   batchID,
+		RecipeID:  recipeID,
+		HeldAt:    heldAt,
+		Panelists: panelists,
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// AddScore records the score of a panelist. Every panelist can score a session once.
+func (s *Session) AddScore(score Score) error {
+	if err := score.Validate(); err != nil {
+		return err
+	}
+	for _, existing := range s.Scores {
+		if existing.Panelist == score.Panelist {
+			return ErrDuplicatePanelist
+		}
+	}
+	s.Scores = append(s.Scores, score)
+	for _, p := range s.Panelists {
+		if p == score.Panelist {
+			return nil
+		}
+	}
+	s.Panelists = append(s.Panelists, score.Panelist)
+	return nil
+}
+
+// AttributeSummary holds the statistics of the ratings of one attribute.
+type AttributeSummary struct {
+	Attribute Attribute
+	Count     int // Number of ratings
+	Mean      float64
+	StdDev    float64 // Sample standard deviation, 0 with fewer than two ratings
+}
+
+// SpiderChart holds the data to draw a spider (radar) chart of the mean ratings.
+type SpiderChart struct {
+	Axes   []Attribute
+	Values []float64 // Mean rating per axis
+	Max    float64   // Maximum value of every axis
+}
+
+// Summary aggregates the scores of one or more tasting sessions.
+type Summary struct {
+	Sessions   int
+	Scores     int
+	Attributes []AttributeSummary
+	Chart      SpiderChart
+	Notes      []string
+}
+
+// Summarize aggregates the scores of the given sessions per attribute.
+func Summarize(sessions []*Session) *Summary {
+	ratings := make(map[Attribute][]float64)
+	summary := &Summary{Sessions: len(sessions)}
+	for _, session := range sessions {
+		for _, score := range session.Scores {
+			summary.Scores++
+			for attribute, rating := range score.Ratings {
+				ratings[attribute] = append(ratings[attribute], rating)
+			}
+			if score.Notes != "" {
+				summary.Notes = append(summary.Notes, score.Notes)
+			}
+		}
+	}
+
+	summary.Chart.Max = MaxRating
+	for _, attribute := range Attributes() {
+		mean, stdDev := meanStdDev(ratings[attribute])
+		summary.Attributes = append(summary.Attributes, AttributeSummary{
+			Attribute: attribute,
+			Count:     len(ratings[attribute]),
+			Mean:      mean,
+			StdDev:    stdDev,
+		})
+		summary.Chart.Axes = append(summary.Chart.Axes, attribute)
+		summary.Chart.Values = append(summary.Chart.Values, mean)
+	}
+	return summary
+}
+
+// meanStdDev returns the mean and sample standard deviation of the values.
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)-1))
+}
@@ -0,0 +1,80 @@
+package tasting
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAddScore(t *testing.T) {
+	if _, err := NewSession("", "", time.Time{}, nil); err != ErrSubjectRequired {
+		t.Errorf("NewSession() error = %v, want %v", err, ErrSubjectRequired)
+	}
+
+	session, err := NewSession("batch-1", "", time.Time{}, []string{"ana"})
+	if err != nil {
+		t.Fatalf("NewSession() error = %v", err)
+	}
+	if session.HeldAt.IsZero() {
+		t.Error("expected HeldAt to default to now")
+	}
+
+	tests := []struct {
+		name    string
+		score   Score
+		wantErr error
+	}{
+		{name: "Valid score", score: Score{Panelist: "ana", Ratings: map[Attribute]float64{Cocoa: 7, Fruity: 4}}},
+		{name: "Unlisted panelist", score: Score{Panelist: "ben", Ratings: map[Attribute]float64{Cocoa: 6}}},
+		{name: "Duplicate panelist", score: Score{Panelist: "ana"}, wantErr: ErrDuplicatePanelist},
+		{name: "Missing panelist", score: Score{}, wantErr: ErrPanelistRequired},
+		{name: "Unknown attribute", score: Score{Panelist: "cy", Ratings: map[Attribute]float64{"sweet": 5}}, wantErr: ErrUnknownAttribute},
+		{name: "Rating out of range", score: Score{Panelist: "cy", Ratings: map[Attribute]float64{Cocoa: 11}}, wantErr: ErrInvalidRating},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := session.AddScore(tt.score); err != tt.wantErr {
+				t.Errorf("AddScore() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+	if len(session.Panelists) != 2 {
+		t.Errorf("expected 2 panelists, got %v", session.Panelists)
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	first := &Session{Scores: []Score{
+		{Panelist: "ana", Ratings: map[Attribute]float64{Cocoa: 8, Bitterness: 5}, Notes: "red fruit"},
+		{Panelist: "ben", Ratings: map[Attribute]float64{Cocoa: 6}},
+	}}
+	second := &Session{Scores: []Score{
+		{Panelist: "cy", Ratings: map[Attribute]float64{Cocoa: 7, Bitterness: 3}},
+	}}
+
+	summary := Summarize([]*Session{first, second})
+	if summary.Sessions != 2 || summary.Scores != 3 {
+		t.Errorf("expected 2 sessions and 3 scores, got %d and %d", summary.Sessions, summary.Scores)
+	}
+	if len(summary.Attributes) != len(Attributes()) || len(summary.Chart.Axes) != len(Attributes()) {
+		t.Fatalf("expected a summary for every attribute, got %d", len(summary.Attributes))
+	}
+
+	cocoa := summary.Attributes[0]
+	if cocoa.Attribute != Cocoa || cocoa.Count != 3 || cocoa.Mean != 7 || cocoa.StdDev != 1 {
+		t.Errorf("unexpected cocoa summary %+v", cocoa)
+	}
+	bitterness := summary.Attributes[1]
+	if bitterness.Mean != 4 || math.Abs(bitterness.StdDev-math.Sqrt2) > 1e-9 {
+		t.Errorf("unexpected bitterness summary %+v", bitterness)
+	}
+	if floral := summary.Attributes[4]; floral.Count != 0 || floral.Mean != 0 {
+		t.Errorf("expected no floral ratings, got %+v", floral)
+	}
+	if summary.Chart.Values[0] != 7 || summary.Chart.Max != MaxRating {
+		t.Errorf("unexpected chart %+v", summary.Chart)
+	}
+	if len(summary.Notes) != 1 {
+		t.Errorf("expected 1 note, got %v", summary.Notes)
+	}
+}